@@ -166,6 +166,7 @@ impl From<&Address> for NodeAddress {
         NodeAddress {
             host: unsafe { ptr_to_str(addr.host) },
             port: addr.port,
+            unix_socket_path: None,
         }
     }
 }
@@ -2,6 +2,7 @@
 
 use glide_core::ConnectionRequest;
 use glide_core::client::Client as GlideClient;
+use glide_core::client::CommandOptions;
 use glide_core::cluster_scan_container::get_cluster_scan_cursor;
 use glide_core::command_request::SimpleRoutes;
 use glide_core::command_request::{Routes, SlotTypes};
@@ -24,6 +25,7 @@ use redis::cluster_routing::{
 use redis::cluster_routing::{ResponsePolicy, Routable};
 use redis::{ClusterScanArgs, RedisError};
 use redis::{Cmd, Pipeline, PipelineRetryStrategy, RedisResult, Value};
+use std::collections::HashMap;
 use std::ffi::CStr;
 use std::future::Future;
 use std::mem::ManuallyDrop;
@@ -31,6 +33,8 @@ use std::slice::from_raw_parts;
 use std::str;
 use std::str::FromStr;
 use std::sync::Arc;
+use std::sync::Mutex;
+use std::time::Duration;
 use std::{
     ffi::{CString, c_void},
     mem,
@@ -38,6 +42,7 @@ use std::{
 };
 use tokio::runtime::Builder;
 use tokio::runtime::Runtime;
+use tokio::sync::oneshot;
 
 #[repr(C)]
 pub struct ScriptHashBuffer {
@@ -269,6 +274,51 @@ pub type PubSubCallback = unsafe extern "C-unwind" fn(
     pattern_len: i64,
 ) -> ();
 
+/// Lifecycle callback that is called on connection lifecycle events, when the `lifecycle_events` field of the connection
+/// request is set.
+///
+/// The lifecycle callback needs to copy the given data synchronously, since it will be dropped by Rust once the callback returns.
+/// The callback should be offloaded to a separate thread in order not to exhaust the client's thread pool.
+///
+/// # Parameters
+/// * `client_ptr`: A baton-pass back to the caller language to uniquely identify the client.
+/// * `kind`: An enum variant representing the kind of the event.
+/// * `address`: A pointer to the raw address bytes of the node, which are empty for cluster-wide events.
+/// * `address_len`: The length of the address in bytes.
+/// * `attempt`: The number of the attempt which led to the event, starting from 1, or 0 when it is not applicable.
+/// * `detail`: A pointer to the raw bytes of the cause of the event, or of the channel of a subscription.
+/// * `detail_len`: The length of the detail in bytes.
+///
+/// # Safety
+/// The pointers are only valid during the callback execution and will be freed
+/// automatically when the callback returns. Any data needed beyond the callback's
+/// execution must be copied.
+pub type LifecycleCallback = unsafe extern "C-unwind" fn(
+    client_ptr: usize,
+    kind: LifecycleEventKind,
+    address: *const u8,
+    address_len: i64,
+    attempt: u64,
+    detail: *const u8,
+    detail_len: i64,
+) -> ();
+
+/// The kinds of the connection lifecycle events passed to the [`LifecycleCallback`].
+#[repr(C)]
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub enum LifecycleEventKind {
+    /// The connection to a node was lost.
+    LifecycleDisconnected,
+    /// The client attempts to reconnect to a node, following the reconnect strategy.
+    LifecycleReconnectAttempt,
+    /// The connection to a node was reestablished.
+    LifecycleReconnected,
+    /// The slot map of the cluster was refreshed.
+    LifecycleTopologyRefreshed,
+    /// The client subscribed to a channel, when connecting or after reconnecting.
+    LifecycleSubscribed,
+}
+
 /// The connection response.
 ///
 /// It contains either a connection or an error. It is represented as a struct instead of a union for ease of use in the wrapper language.
@@ -393,6 +443,8 @@ pub struct ClientAdapter {
 struct CommandExecutionCore {
     client: GlideClient,
     client_type: ClientType,
+    /// The senders cancelling the requests in progress of an async client, by request ID. See [`cancel_request`].
+    cancellations: Mutex<HashMap<usize, oneshot::Sender<()>>>,
 }
 
 impl ClientAdapter {
@@ -410,9 +462,23 @@ impl ClientAdapter {
                 success_callback,
                 failure_callback,
             } => {
-                // Spawn the request for async client
+                // Spawn the request for async client, which can be cancelled until it completes
+                let (cancel_sender, cancel_receiver) = oneshot::channel();
+                self.core
+                    .cancellations
+                    .lock()
+                    .unwrap()
+                    .insert(request_id, cancel_sender);
+                let core = self.core.clone();
                 self.runtime.spawn(async move {
-                    let result = request_future.await;
+                    let result = tokio::select! {
+                        result = request_future => result,
+                        Ok(()) = cancel_receiver => Err(RedisError::from((
+                            ErrorKind::ClientError,
+                            "The request was cancelled",
+                        ))),
+                    };
+                    core.cancellations.lock().unwrap().remove(&request_id);
                     let _ = Self::handle_result(
                         result,
                         Some(success_callback),
@@ -702,10 +768,47 @@ unsafe fn process_push_notification(
     }
 }
 
+/// Converts a push notification into a lifecycle event of kind, address, attempt and detail, or returns `None` if the
+/// notification is not a lifecycle event.
+fn lifecycle_event(push_msg: &redis::PushInfo) -> Option<(LifecycleEventKind, &[u8], u64, &[u8])> {
+    fn bulk_string(data: &[Value], index: usize) -> &[u8] {
+        match data.get(index) {
+            Some(Value::BulkString(bytes)) => bytes,
+            _ => &[],
+        }
+    }
+    let data = push_msg.data.as_slice();
+    let kind = match &push_msg.kind {
+        redis::PushKind::Subscribe | redis::PushKind::PSubscribe | redis::PushKind::SSubscribe => {
+            // the data of a subscription is the channel and the number of subscriptions of the connection
+            return Some((
+                LifecycleEventKind::LifecycleSubscribed,
+                &[],
+                0,
+                bulk_string(data, 0),
+            ));
+        }
+        redis::PushKind::Other(kind) => match kind.as_str() {
+            redis::lifecycle::DISCONNECTED => LifecycleEventKind::LifecycleDisconnected,
+            redis::lifecycle::RECONNECT_ATTEMPT => LifecycleEventKind::LifecycleReconnectAttempt,
+            redis::lifecycle::RECONNECTED => LifecycleEventKind::LifecycleReconnected,
+            redis::lifecycle::TOPOLOGY_REFRESHED => LifecycleEventKind::LifecycleTopologyRefreshed,
+            _ => return None,
+        },
+        _ => return None,
+    };
+    let attempt = std::str::from_utf8(bulk_string(data, 1))
+        .ok()
+        .and_then(|attempt| attempt.parse().ok())
+        .unwrap_or(0);
+    Some((kind, bulk_string(data, 0), attempt, bulk_string(data, 2)))
+}
+
 fn create_client_internal(
     connection_request_bytes: &[u8],
     client_type: ClientType,
     pubsub_callback: PubSubCallback,
+    lifecycle_callback: LifecycleCallback,
 ) -> Result<*const ClientAdapter, String> {
     let request = connection_request::ConnectionRequest::parse_from_bytes(connection_request_bytes)
         .map_err(|err| err.to_string())?;
@@ -721,8 +824,9 @@ fn create_client_internal(
         })?;
 
     let is_subscriber = request.pubsub_subscriptions.is_some() && pubsub_callback as usize != 0;
+    let has_lifecycle_events = request.lifecycle_events && lifecycle_callback as usize != 0;
     let (push_tx, mut push_rx) = tokio::sync::mpsc::unbounded_channel();
-    let tx = match is_subscriber {
+    let tx = match is_subscriber || has_lifecycle_events {
         true => Some(push_tx),
         false => None,
     };
@@ -735,21 +839,42 @@ fn create_client_internal(
     let core = Arc::new(CommandExecutionCore {
         client,
         client_type,
+        cancellations: Mutex::new(HashMap::new()),
     });
     let client_adapter = Arc::new(ClientAdapter { runtime, core });
     // Clone client_adapter before moving it into the async block
     let client_adapter_ptr = Arc::as_ptr(&client_adapter).addr();
 
-    // If pubsub_callback is provided (not null), spawn a task to handle push notifications
-    if is_subscriber {
+    // If pubsub_callback or lifecycle_callback is provided (not null), spawn a task to handle push notifications
+    if is_subscriber || has_lifecycle_events {
         client_adapter.runtime.spawn(async move {
             while let Some(push_msg) = push_rx.recv().await {
                 if push_msg.kind == redis::PushKind::Message
                     || push_msg.kind == redis::PushKind::PMessage
                     || push_msg.kind == redis::PushKind::SMessage
                 {
-                    unsafe {
-                        process_push_notification(push_msg, pubsub_callback, client_adapter_ptr);
+                    if is_subscriber {
+                        unsafe {
+                            process_push_notification(
+                                push_msg,
+                                pubsub_callback,
+                                client_adapter_ptr,
+                            );
+                        }
+                    }
+                } else if has_lifecycle_events {
+                    if let Some((kind, address, attempt, detail)) = lifecycle_event(&push_msg) {
+                        unsafe {
+                            lifecycle_callback(
+                                client_adapter_ptr,
+                                kind,
+                                address.as_ptr(),
+                                address.len() as i64,
+                                attempt,
+                                detail.as_ptr(),
+                                detail.len() as i64,
+                            );
+                        }
                     }
                 }
             }
@@ -767,6 +892,7 @@ fn create_client_internal(
 /// `connection_request_len` is the number of bytes in `connection_request_bytes`.
 /// `success_callback` is the callback that will be called when a command succeeds.
 /// `failure_callback` is the callback that will be called when a command fails.
+/// `lifecycle_callback` is the callback that will be called on connection lifecycle events, when they are enabled by the `lifecycle_events` field of the connection request.
 ///
 /// # Safety
 ///
@@ -782,13 +908,18 @@ pub unsafe extern "C-unwind" fn create_client(
     connection_request_len: usize,
     client_type: *const ClientType,
     pubsub_callback: PubSubCallback,
+    lifecycle_callback: LifecycleCallback,
 ) -> *const ConnectionResponse {
     assert!(!connection_request_bytes.is_null());
     let request_bytes =
         unsafe { std::slice::from_raw_parts(connection_request_bytes, connection_request_len) };
     let client_type = unsafe { &*client_type };
-    let response = match create_client_internal(request_bytes, client_type.clone(), pubsub_callback)
-    {
+    let response = match create_client_internal(
+        request_bytes,
+        client_type.clone(),
+        pubsub_callback,
+        lifecycle_callback,
+    ) {
         Err(err) => ConnectionResponse {
             conn_ptr: std::ptr::null(),
             connection_error_message: CString::into_raw(
@@ -1099,6 +1230,7 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
 /// * `route_bytes_len` is the number of bytes in `route_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
 /// * `route_bytes_len` must be 0 if `route_bytes` is null.
 /// * `span_ptr` is a valid pointer to [`Arc<GlideSpan>`], a span created by [`create_otel_span`] or `0`. The span must be valid until the command is finished.
+/// * `options_ptr` could be `null`, but if it is not `null`, it must be a valid [`CommandOptionsInfo`] pointer, valid until this function returns.
 /// * This function should only be called should with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
 #[unsafe(no_mangle)]
 pub unsafe extern "C-unwind" fn command(
@@ -1111,6 +1243,7 @@ pub unsafe extern "C-unwind" fn command(
     route_bytes: *const u8,
     route_bytes_len: usize,
     span_ptr: u64,
+    options_ptr: *const CommandOptionsInfo,
 ) -> *mut CommandResult {
     let client_adapter = unsafe {
         // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
@@ -1157,13 +1290,79 @@ pub unsafe extern "C-unwind" fn command(
         Routes::default()
     };
 
+    let options = unsafe { get_command_options(options_ptr) };
+
     let mut client = client_adapter.core.client.clone();
     client_adapter.execute_request(request_id, async move {
         let routing_info = get_route(route, Some(&cmd))?;
-        client.send_command(&cmd, routing_info).await
+        client
+            .send_command_with_options(&cmd, routing_info, options)
+            .await
     })
 }
 
+/// Options overriding the configuration of the client for a single command.
+#[repr(C)]
+#[derive(Clone, Debug, Copy)]
+pub struct CommandOptionsInfo {
+    /// Whether `timeout` overrides the request timeout of the client.
+    pub has_timeout: bool,
+    /// The request timeout of the command, in milliseconds.
+    pub timeout: u32,
+    /// Whether `read_from_replica` overrides the read strategy of the client.
+    pub has_read_from: bool,
+    /// Whether a read-only command is sent preferably to a replica, or to the primary.
+    pub read_from_replica: bool,
+}
+
+/// Convert [`CommandOptionsInfo`] to the corresponding [`CommandOptions`].
+///
+/// # Safety
+///
+/// * `ptr` could be `null`, but if it is not `null`, it must be a valid pointer to a [`CommandOptionsInfo`] struct.
+unsafe fn get_command_options(ptr: *const CommandOptionsInfo) -> CommandOptions {
+    if ptr.is_null() {
+        return CommandOptions::default();
+    }
+    let info = unsafe { *ptr };
+    CommandOptions {
+        request_timeout: info
+            .has_timeout
+            .then(|| Duration::from_millis(info.timeout.into())),
+        read_from_replica: info.has_read_from.then_some(info.read_from_replica),
+    }
+}
+
+/// Cancels a request in progress of an async client, whose result is no longer awaited by the caller.
+///
+/// The request is dropped by the core, which stops waiting for its response, and its `failure_callback` is called with
+/// a cancellation error. Requests which already completed are not affected. A command may still have been sent to and
+/// applied by the server.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must not be `null` and must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `request_id` must be the ID of a request of the client, before either `success_callback` or `failure_callback`
+///   was called for it.
+/// * This function should only be called with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn cancel_request(client_adapter_ptr: *const c_void, request_id: usize) {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+    let sender = client_adapter
+        .core
+        .cancellations
+        .lock()
+        .unwrap()
+        .remove(&request_id);
+    if let Some(sender) = sender {
+        let _ = sender.send(());
+    }
+}
+
 /// Creates a heap-allocated `CommandResult` containing a `CommandError`.
 ///
 /// This function is used to construct an error response when a Valkey command fails,
@@ -1588,6 +1787,33 @@ pub unsafe extern "C-unwind" fn update_connection_password(
     })
 }
 
+/// Returns the slot map of a cluster as currently known by the client.
+///
+/// The response is an array of slot ranges in the format of `CLUSTER SLOTS`: each range is an array of its first slot,
+/// its last slot, the address of its primary and an array of the addresses of its replicas. No command is sent to the servers.
+///
+/// `client_adapter_ptr` is a pointer to a valid `GlideClusterClient` returned in the `ConnectionResponse` from [`create_client`].
+/// `request_id` is a unique identifier for a valid payload buffer which is created in the client.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `client_adapter_ptr` must be valid until `close_client` is called.
+/// * `request_id` must be valid until it is passed in a call to [`free_command_response`].
+#[unsafe(no_mangle)]
+pub unsafe extern "C-unwind" fn get_topology(
+    client_adapter_ptr: *const c_void,
+    request_id: usize,
+) -> *mut CommandResult {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+    let mut client = client_adapter.core.client.clone();
+    client_adapter.execute_request(request_id, async move { client.get_topology().await })
+}
+
 /// Executes a Lua script.
 ///
 /// # Parameters
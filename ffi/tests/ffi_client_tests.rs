@@ -177,6 +177,7 @@ fn execute_command(
             route_bytes,
             route_len,
             0,
+            std::ptr::null(),
         )
     };
     if command_res_ptr.is_null() {
@@ -259,6 +260,7 @@ fn test_ffi_client_command_execution(#[values(false, true)] async_client: bool)
                     pattern_len: i64,
                 ),
             >(std::ptr::null_mut()),
+            std::mem::transmute::<*mut c_void, LifecycleCallback>(std::ptr::null_mut()),
         );
 
         assert!(!response_ptr.is_null(), "Failed to create client");
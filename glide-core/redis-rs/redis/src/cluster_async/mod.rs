@@ -83,7 +83,7 @@ use crate::{
         SlotAddr,
     },
     connection::{PubSubSubscriptionInfo, PubSubSubscriptionKind},
-    push_manager::PushInfo,
+    push_manager::{lifecycle, send_lifecycle_event, PushInfo},
     Cmd, ConnectionInfo, ErrorKind, IntoConnectionInfo, RedisError, RedisFuture, RedisResult,
     Value,
 };
@@ -337,6 +337,15 @@ where
         self.route_operation_request(Operation::GetUsername).await
     }
 
+    /// Get the slot map of the cluster as currently known by the client.
+    ///
+    /// The map is returned as an array of slot ranges in ascending order, in the format of `CLUSTER SLOTS`:
+    /// each range is an array of its first slot, its last slot, the address of its primary and an array of the
+    /// addresses of its replicas.
+    pub async fn get_topology(&mut self) -> RedisResult<Value> {
+        self.route_operation_request(Operation::GetTopology).await
+    }
+
     /// Routes an operation request to the appropriate handler.
     async fn route_operation_request(
         &mut self,
@@ -656,6 +665,7 @@ enum CmdArg<C> {
 enum Operation {
     UpdateConnectionPassword(Option<String>),
     GetUsername,
+    GetTopology,
 }
 
 fn boxed_sleep(duration: Duration) -> BoxFuture<'static, ()> {
@@ -1481,7 +1491,23 @@ where
                     "No attempts performed",
                 )));
                 let mut first_attempt = true;
+                // Lifecycle events are only sent for user connections which failed to be refreshed at the first attempt
+                let push_sender = match conn_type {
+                    RefreshConnectionType::OnlyManagementConnection => None,
+                    _ => inner_clone.glide_connection_options.push_sender.clone(),
+                };
+                let mut attempt = 0;
                 for backoff_duration in infinite_backoff_iter {
+                    attempt += 1;
+                    if let (false, Err(err)) = (first_attempt, &node_result) {
+                        send_lifecycle_event(
+                            &push_sender,
+                            lifecycle::RECONNECT_ATTEMPT,
+                            &address_clone_for_task,
+                            attempt,
+                            &err.to_string(),
+                        );
+                    }
                     let mut cluster_params = inner_clone
                         .cluster_params
                         .read()
@@ -1519,6 +1545,13 @@ where
                                 }
 
                                 first_attempt = false;
+                                send_lifecycle_event(
+                                    &push_sender,
+                                    lifecycle::DISCONNECTED,
+                                    &address_clone_for_task,
+                                    attempt,
+                                    &err.to_string(),
+                                );
                             }
                             debug!(
                                 "Failed to refresh connection for node {}. Error: `{:?}`. Retrying in {:?}",
@@ -1535,6 +1568,15 @@ where
                             "Succeeded to refresh connection for node {}.",
                             address_clone_for_task
                         );
+                        if !first_attempt {
+                            send_lifecycle_event(
+                                &push_sender,
+                                lifecycle::RECONNECTED,
+                                &address_clone_for_task,
+                                attempt,
+                                "",
+                            );
+                        }
                         inner_clone
                             .conn_lock
                             .read()
@@ -1997,6 +2039,7 @@ where
         // Create a new connection vector of the found nodes
         let nodes = new_slots.all_node_addresses();
         let nodes_len = nodes.len();
+        let node_addresses: Vec<String> = nodes.iter().map(|addr| addr.to_string()).collect();
         let addresses_and_connections_iter = stream::iter(nodes)
             .fold(
                 Vec::with_capacity(nodes_len),
@@ -2065,6 +2108,12 @@ where
             .await;
 
         info!("refresh_slots found nodes:\n{new_connections}");
+        // The nodes of the new topology which could not be connected to are reported with the refresh event
+        let unreachable = node_addresses
+            .into_iter()
+            .filter(|addr| !new_connections.0.contains_key(addr))
+            .collect::<Vec<_>>()
+            .join(",");
         // Reset the current slot map and connection vector with the new ones
         let mut write_guard = inner.conn_lock.write().expect(MUTEX_WRITE_ERR);
         // Clear the refresh tasks of the prev instance
@@ -2079,6 +2128,14 @@ where
             read_from_replicas,
             topology_hash,
         );
+        drop(write_guard);
+        send_lifecycle_event(
+            &inner.glide_connection_options.push_sender,
+            lifecycle::TOPOLOGY_REFRESHED,
+            "",
+            curr_retry + 1,
+            &unreachable,
+        );
         Ok(())
     }
 
@@ -2376,6 +2433,28 @@ where
                     };
                     Ok(Response::Single(username))
                 }
+                Operation::GetTopology => {
+                    let slot_ranges = core
+                        .conn_lock
+                        .read()
+                        .expect(MUTEX_READ_ERR)
+                        .slot_map
+                        .slot_ranges();
+                    let address =
+                        |address: &Arc<String>| Value::BulkString(address.as_bytes().to_vec());
+                    let ranges = slot_ranges
+                        .into_iter()
+                        .map(|(start, end, primary, replicas)| {
+                            Value::Array(vec![
+                                Value::Int(start.into()),
+                                Value::Int(end.into()),
+                                address(&primary),
+                                Value::Array(replicas.iter().map(address).collect()),
+                            ])
+                        })
+                        .collect();
+                    Ok(Response::Single(Value::Array(ranges)))
+                }
             },
         }
     }
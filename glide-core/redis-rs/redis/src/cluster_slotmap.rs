@@ -206,6 +206,22 @@ impl SlotMap {
             .collect()
     }
 
+    /// Returns the slot ranges of the map in ascending order, with the primary and the replicas serving each range.
+    pub(crate) fn slot_ranges(&self) -> Vec<(u16, u16, Arc<String>, Vec<Arc<String>>)> {
+        self.slots
+            .iter()
+            .map(|(end, slot_value)| {
+                let addrs = &slot_value.addrs;
+                (
+                    slot_value.start,
+                    *end,
+                    addrs.primary(),
+                    addrs.replicas().clone(),
+                )
+            })
+            .collect()
+    }
+
     pub(crate) fn node_address_for_slot(
         &self,
         slot: u16,
@@ -755,6 +771,46 @@ mod tests_cluster_slotmap {
         );
     }
 
+    #[test]
+    fn test_slot_ranges() {
+        let slot_map = SlotMap::new(
+            vec![
+                create_slot(1001, 2000, "node2:6379", vec![]),
+                create_slot(
+                    0,
+                    1000,
+                    "node1:6379",
+                    vec!["replica1:6379", "replica2:6379"],
+                ),
+            ],
+            ReadFromReplicaStrategy::AlwaysFromPrimary,
+        );
+        let ranges: Vec<(u16, u16, String, Vec<String>)> = slot_map
+            .slot_ranges()
+            .into_iter()
+            .map(|(start, end, primary, replicas)| {
+                (
+                    start,
+                    end,
+                    primary.to_string(),
+                    replicas.iter().map(|replica| replica.to_string()).collect(),
+                )
+            })
+            .collect();
+        assert_eq!(
+            ranges,
+            vec![
+                (
+                    0,
+                    1000,
+                    "node1:6379".to_string(),
+                    vec!["replica1:6379".to_string(), "replica2:6379".to_string()]
+                ),
+                (1001, 2000, "node2:6379".to_string(), vec![]),
+            ]
+        );
+    }
+
     fn create_slot(start: u16, end: u16, master: &str, replicas: Vec<&str>) -> Slot {
         Slot::new(
             start,
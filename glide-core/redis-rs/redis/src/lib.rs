@@ -349,7 +349,7 @@ pub use crate::connection::{
 };
 pub use crate::parser::{parse_redis_value, Parser};
 pub use crate::pipeline::{Pipeline, PipelineRetryStrategy};
-pub use push_manager::{PushInfo, PushManager};
+pub use push_manager::{lifecycle, send_lifecycle_event, PushInfo, PushManager};
 pub use retry_strategies::RetryStrategy;
 
 // preserve grouping and order
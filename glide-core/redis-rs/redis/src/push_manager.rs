@@ -12,6 +12,40 @@ pub struct PushInfo {
     pub data: Vec<Value>,
 }
 
+/// Kinds of the [`PushKind::Other`] messages which are sent from the **library** on connection lifecycle events. The data
+/// of these messages is the address of the node, the attempt number and the cause of the event.
+pub mod lifecycle {
+    /// Sent when the connection to a node is lost.
+    pub const DISCONNECTED: &str = "glide-disconnected";
+    /// Sent before every attempt to reconnect to a node.
+    pub const RECONNECT_ATTEMPT: &str = "glide-reconnect-attempt";
+    /// Sent when the connection to a node is reestablished.
+    pub const RECONNECTED: &str = "glide-reconnected";
+    /// Sent when the slot map of a cluster is refreshed, with the comma-separated addresses of the nodes of the new
+    /// topology which could not be connected to.
+    pub const TOPOLOGY_REFRESHED: &str = "glide-topology-refreshed";
+}
+
+/// Sends a connection lifecycle event, whose kind is one of the [`lifecycle`] constants, if a sender is provided.
+pub fn send_lifecycle_event(
+    sender: &Option<mpsc::UnboundedSender<PushInfo>>,
+    kind: &str,
+    address: &str,
+    attempt: usize,
+    cause: &str,
+) {
+    if let Some(sender) = sender {
+        let _ = sender.send(PushInfo {
+            kind: PushKind::Other(kind.to_string()),
+            data: vec![
+                Value::BulkString(address.as_bytes().to_vec()),
+                Value::BulkString(attempt.to_string().into_bytes()),
+                Value::BulkString(cause.as_bytes().to_vec()),
+            ],
+        });
+    }
+}
+
 /// Manages Push messages for single tokio channel
 #[derive(Clone, Default)]
 pub struct PushManager {
@@ -80,6 +114,34 @@ mod tests {
         );
     }
     #[test]
+    fn test_send_lifecycle_event() {
+        let (tx, mut rx) = mpsc::unbounded_channel();
+
+        send_lifecycle_event(
+            &Some(tx),
+            lifecycle::RECONNECT_ATTEMPT,
+            "host:6379",
+            2,
+            "refused",
+        );
+
+        let push_info = rx.try_recv().unwrap();
+        assert_eq!(
+            push_info.kind,
+            PushKind::Other(lifecycle::RECONNECT_ATTEMPT.to_string())
+        );
+        assert_eq!(
+            push_info.data,
+            vec![
+                Value::BulkString(b"host:6379".to_vec()),
+                Value::BulkString(b"2".to_vec()),
+                Value::BulkString(b"refused".to_vec()),
+            ]
+        );
+        // nothing happens without a sender
+        send_lifecycle_event(&None, lifecycle::RECONNECTED, "host:6379", 2, "");
+    }
+    #[test]
     fn test_push_manager_receiver_dropped() {
         let push_manager = PushManager::new();
         let (tx, rx) = mpsc::unbounded_channel();
@@ -10,7 +10,8 @@ use once_cell::sync::OnceCell;
 use redis::aio::ConnectionLike;
 use redis::cluster_async::ClusterConnection;
 use redis::cluster_routing::{
-    MultipleNodeRoutingInfo, ResponsePolicy, Routable, RoutingInfo, SingleNodeRoutingInfo,
+    MultipleNodeRoutingInfo, ResponsePolicy, Routable, Route, RoutingInfo, SingleNodeRoutingInfo,
+    SlotAddr,
 };
 use redis::cluster_slotmap::ReadFromReplicaStrategy;
 use redis::{
@@ -163,7 +164,9 @@ pub(super) fn get_connection_info(
     tls_mode: TlsMode,
     redis_connection_info: redis::RedisConnectionInfo,
 ) -> redis::ConnectionInfo {
-    let addr = if tls_mode != TlsMode::NoTls {
+    let addr = if let Some(path) = &address.unix_socket_path {
+        redis::ConnectionAddr::Unix(path.into())
+    } else if tls_mode != TlsMode::NoTls {
         redis::ConnectionAddr::TcpTls {
             host: address.host.to_string(),
             port: get_port(address),
@@ -297,6 +300,50 @@ fn get_timeout_from_cmd_arg(
     }
 }
 
+/// Options overriding the configuration of the client for a single command.
+#[derive(Clone, Copy, Debug, Default)]
+pub struct CommandOptions {
+    /// The request timeout of the command, instead of the request timeout of the client. Blocking commands keep the
+    /// timeout derived from their block timeout.
+    pub request_timeout: Option<Duration>,
+    /// Whether a read-only command is sent preferably to a replica (true) or to the primary (false), instead of following
+    /// the read strategy of the client.
+    pub read_from_replica: Option<bool>,
+}
+
+/// Overrides the read strategy of the client in the routing of a read-only command, which is routed to replicas of its
+/// slots, or to their primaries. The routes of the other commands and the explicit routes are kept.
+fn override_read_from(routing: RoutingInfo, read_from_replica: bool) -> RoutingInfo {
+    let slot_addr = if read_from_replica {
+        SlotAddr::ReplicaRequired
+    } else {
+        SlotAddr::Master
+    };
+    let override_route = |route: Route| {
+        if route.slot_addr() == SlotAddr::ReplicaOptional {
+            Route::new(route.slot(), slot_addr)
+        } else {
+            route
+        }
+    };
+    match routing {
+        RoutingInfo::SingleNode(SingleNodeRoutingInfo::SpecificNode(route)) => {
+            RoutingInfo::SingleNode(SingleNodeRoutingInfo::SpecificNode(override_route(route)))
+        }
+        RoutingInfo::MultiNode((MultipleNodeRoutingInfo::MultiSlot((routes, pattern)), policy)) => {
+            let routes = routes
+                .into_iter()
+                .map(|(route, indices)| (override_route(route), indices))
+                .collect();
+            RoutingInfo::MultiNode((
+                MultipleNodeRoutingInfo::MultiSlot((routes, pattern)),
+                policy,
+            ))
+        }
+        routing => routing,
+    }
+}
+
 fn get_request_timeout(cmd: &Cmd, default_timeout: Duration) -> RedisResult<Option<Duration>> {
     let command = cmd.command().unwrap_or_default();
     let timeout = match command.as_slice() {
@@ -326,9 +373,20 @@ impl Client {
         &'a mut self,
         cmd: &'a Cmd,
         routing: Option<RoutingInfo>,
+    ) -> redis::RedisFuture<'a, Value> {
+        self.send_command_with_options(cmd, routing, CommandOptions::default())
+    }
+
+    /// Sends a command with options overriding the configuration of the client for this command only.
+    pub fn send_command_with_options<'a>(
+        &'a mut self,
+        cmd: &'a Cmd,
+        routing: Option<RoutingInfo>,
+        options: CommandOptions,
     ) -> redis::RedisFuture<'a, Value> {
         let expected_type = expected_type_for_cmd(cmd);
-        let request_timeout = match get_request_timeout(cmd, self.request_timeout) {
+        let default_timeout = options.request_timeout.unwrap_or(self.request_timeout);
+        let request_timeout = match get_request_timeout(cmd, default_timeout) {
             Ok(request_timeout) => request_timeout,
             Err(err) => {
                 return async { Err(err) }.boxed();
@@ -336,7 +394,11 @@ impl Client {
         };
         run_with_timeout(request_timeout, async move {
             match self.internal_client {
-                ClientWrapper::Standalone(ref mut client) => client.send_command(cmd).await,
+                ClientWrapper::Standalone(ref mut client) => {
+                    client
+                        .send_command_with_read_from(cmd, options.read_from_replica)
+                        .await
+                }
                 ClientWrapper::Cluster { ref mut client } => {
                     let routing =
                         if let Some(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random)) =
@@ -363,6 +425,10 @@ impl Client {
                                 .or_else(|| RoutingInfo::for_routable(cmd))
                                 .unwrap_or(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random))
                         };
+                    let routing = match options.read_from_replica {
+                        Some(read_from_replica) => override_read_from(routing, read_from_replica),
+                        None => routing,
+                    };
                     client.route_command(cmd, routing).await
                 }
             }
@@ -722,6 +788,19 @@ impl Client {
         }
     }
 
+    /// Returns the slot map of the cluster as currently known by the client, as an array of slot ranges in the format of
+    /// `CLUSTER SLOTS`. The map is read from the state of the client, and no command is sent to the servers.
+    /// Returns an error for standalone clients.
+    pub async fn get_topology(&mut self) -> RedisResult<Value> {
+        match self.internal_client {
+            ClientWrapper::Cluster { ref mut client } => client.get_topology().await,
+            ClientWrapper::Standalone(_) => Err(RedisError::from((
+                ErrorKind::UserOperationError,
+                "The topology is only available in cluster mode",
+            ))),
+        }
+    }
+
     /// Returns the username if one was configured during client creation. Otherwise, returns None.
     async fn get_username(&mut self) -> RedisResult<Option<String>> {
         match &mut self.internal_client {
@@ -929,7 +1008,10 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
     let addresses = request
         .addresses
         .iter()
-        .map(|address| format!("{}:{}", address.host, address.port))
+        .map(|address| match &address.unix_socket_path {
+            Some(path) => path.clone(),
+            None => format!("{}:{}", address.host, address.port),
+        })
         .collect::<Vec<_>>()
         .join(", ");
     let tls_mode = request
@@ -1095,6 +1177,11 @@ mod tests {
 
     use crate::client::{
         BLOCKING_CMD_TIMEOUT_EXTENSION, RequestTimeoutOption, TimeUnit, get_request_timeout,
+        override_read_from,
+    };
+    use redis::cluster_routing::{
+        MultiSlotArgPattern, MultipleNodeRoutingInfo, Route, RoutingInfo, SingleNodeRoutingInfo,
+        SlotAddr,
     };
 
     use super::get_timeout_from_cmd_arg;
@@ -1246,4 +1333,48 @@ mod tests {
         assert!(result.is_ok());
         assert_eq!(result.unwrap(), Some(Duration::from_millis(100)));
     }
+
+    #[test]
+    fn test_override_read_from() {
+        let specific_node = |slot_addr| {
+            RoutingInfo::SingleNode(SingleNodeRoutingInfo::SpecificNode(Route::new(
+                100, slot_addr,
+            )))
+        };
+        assert_eq!(
+            override_read_from(specific_node(SlotAddr::ReplicaOptional), true),
+            specific_node(SlotAddr::ReplicaRequired)
+        );
+        assert_eq!(
+            override_read_from(specific_node(SlotAddr::ReplicaOptional), false),
+            specific_node(SlotAddr::Master)
+        );
+        // the routes of write commands and the explicit replica routes are kept
+        assert_eq!(
+            override_read_from(specific_node(SlotAddr::Master), true),
+            specific_node(SlotAddr::Master)
+        );
+        assert_eq!(
+            override_read_from(specific_node(SlotAddr::ReplicaRequired), false),
+            specific_node(SlotAddr::ReplicaRequired)
+        );
+
+        let multi_slot = RoutingInfo::MultiNode((
+            MultipleNodeRoutingInfo::MultiSlot((
+                vec![(Route::new(1, SlotAddr::ReplicaOptional), vec![0])],
+                MultiSlotArgPattern::KeysOnly,
+            )),
+            None,
+        ));
+        assert_eq!(
+            override_read_from(multi_slot, false),
+            RoutingInfo::MultiNode((
+                MultipleNodeRoutingInfo::MultiSlot((
+                    vec![(Route::new(1, SlotAddr::Master), vec![0])],
+                    MultiSlotArgPattern::KeysOnly,
+                )),
+                None,
+            ))
+        );
+    }
 }
@@ -7,6 +7,7 @@ use logger_core::{log_debug, log_error, log_trace, log_warn};
 use redis::aio::{DisconnectNotifier, MultiplexedConnection};
 use redis::{
     GlideConnectionOptions, PushInfo, RedisConnectionInfo, RedisError, RedisResult, RetryStrategy,
+    lifecycle, send_lifecycle_event,
 };
 use std::fmt;
 use std::sync::Arc;
@@ -34,6 +35,15 @@ pub enum ReconnectReason {
     CreateError,
 }
 
+impl ReconnectReason {
+    fn description(&self) -> &'static str {
+        match self {
+            ReconnectReason::ConnectionDropped => "connection dropped",
+            ReconnectReason::CreateError => "connection creation failed",
+        }
+    }
+}
+
 /// The object that is used in order to recreate a connection after a disconnect.
 struct ConnectionBackend {
     /// This signal is reset when a connection disconnects, and set when a new `ConnectionState` has been set with a `Connected` state.
@@ -305,12 +315,21 @@ impl ReconnectingConnection {
         log_debug("reconnect", "starting");
 
         let connection_clone = self.clone();
+        let address = self.node_address();
+        let push_sender = self.connection_options.push_sender.clone();
 
         if reason.eq(&ReconnectReason::ConnectionDropped) {
             // Attempting to reconnect a connection that was dropped (for any reason) - update the telemetry by reducing
             // the number of opened connections by 1, it will be incremented by 1 after a successful re-connect
             Telemetry::decr_total_connections(1);
         }
+        send_lifecycle_event(
+            &push_sender,
+            lifecycle::DISCONNECTED,
+            &address,
+            0,
+            reason.description(),
+        );
 
         // The reconnect task is spawned instead of awaited here, so that the reconnect attempt will continue in the
         // background, regardless of whether the calling task is dropped or not.
@@ -325,7 +344,8 @@ impl ReconnectingConnection {
                 .connection_retry_strategy
                 .unwrap()
                 .get_infinite_backoff_dur_iterator();
-            for sleep_duration in infinite_backoff_dur_iterator {
+            let mut cause = String::new();
+            for (attempt, sleep_duration) in (1..).zip(infinite_backoff_dur_iterator) {
                 if connection_clone.is_dropped() {
                     log_debug(
                         "ReconnectingConnection",
@@ -334,15 +354,20 @@ impl ReconnectingConnection {
                     // Client was dropped, reconnection attempts can stop
                     return;
                 }
+                send_lifecycle_event(
+                    &push_sender,
+                    lifecycle::RECONNECT_ATTEMPT,
+                    &address,
+                    attempt,
+                    &cause,
+                );
                 match get_multiplexed_connection(&client, &connection_clone.connection_options)
                     .await
                 {
                     Ok(mut connection) => {
-                        if connection
-                            .send_packed_command(&redis::cmd("PING"))
-                            .await
-                            .is_err()
+                        if let Err(err) = connection.send_packed_command(&redis::cmd("PING")).await
                         {
+                            cause = err.to_string();
                             tokio::time::sleep(sleep_duration).await;
                             continue;
                         }
@@ -357,9 +382,19 @@ impl ReconnectingConnection {
                             *guard = ConnectionState::Connected(connection);
                         }
                         Telemetry::incr_total_connections(1);
+                        send_lifecycle_event(
+                            &push_sender,
+                            lifecycle::RECONNECTED,
+                            &address,
+                            attempt,
+                            "",
+                        );
                         return;
                     }
-                    Err(_) => tokio::time::sleep(sleep_duration).await,
+                    Err(err) => {
+                        cause = err.to_string();
+                        tokio::time::sleep(sleep_duration).await
+                    }
                 }
             }
         });
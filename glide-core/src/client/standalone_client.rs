@@ -42,6 +42,8 @@ struct DropWrapper {
     primary_index: usize,
     nodes: Vec<ReconnectingConnection>,
     read_from: ReadFrom,
+    /// The index of the last replica read by the requests which prefer replicas, when the client reads from the primary.
+    override_read_replica_index: Arc<AtomicUsize>,
 }
 
 impl Drop for DropWrapper {
@@ -165,7 +167,13 @@ impl StandaloneClient {
                     connection_timeout,
                 )
                 .await
-                .map_err(|err| (format!("{}:{}", address.host, address.port), err))
+                .map_err(|err| {
+                    let address = match &address.unix_socket_path {
+                        Some(path) => path.clone(),
+                        None => format!("{}:{}", address.host, address.port),
+                    };
+                    (address, err)
+                })
             })
             .buffer_unordered(node_count);
 
@@ -240,6 +248,7 @@ impl StandaloneClient {
                 primary_index,
                 nodes,
                 read_from,
+                override_read_replica_index: Arc::new(AtomicUsize::new(0)),
             }),
         })
     }
@@ -489,26 +498,65 @@ impl StandaloneClient {
         }
     }
 
+    /// Returns the connection of a request, following the read strategy of the client unless `read_from_replica` is set.
+    /// Read-only requests with `read_from_replica` set to true are sent to a replica, or to the primary when no replica
+    /// is connected, and the other requests with `read_from_replica` set are sent to the primary.
+    async fn get_connection_with_read_from(
+        &self,
+        readonly: bool,
+        read_from_replica: Option<bool>,
+    ) -> &ReconnectingConnection {
+        match read_from_replica {
+            None => self.get_connection(readonly).await,
+            Some(true) if readonly && self.inner.nodes.len() > 1 => match self.inner.read_from {
+                ReadFrom::Primary => {
+                    self.round_robin_read_from_replica(&self.inner.override_read_replica_index)
+                }
+                _ => self.get_connection(readonly).await,
+            },
+            Some(_) => self.get_primary_connection(),
+        }
+    }
+
     async fn send_request_to_single_node(
         &mut self,
         cmd: &redis::Cmd,
         readonly: bool,
+        read_from_replica: Option<bool>,
     ) -> RedisResult<Value> {
-        let reconnecting_connection = self.get_connection(readonly).await;
+        let reconnecting_connection = self
+            .get_connection_with_read_from(readonly, read_from_replica)
+            .await;
         Self::send_request(cmd, reconnecting_connection).await
     }
 
     pub async fn send_command(&mut self, cmd: &redis::Cmd) -> RedisResult<Value> {
+        self.send_command_with_read_from(cmd, None).await
+    }
+
+    /// Sends a command, reading from a replica or from the primary instead of following the read strategy of the client
+    /// when `read_from_replica` is set.
+    pub async fn send_command_with_read_from(
+        &mut self,
+        cmd: &redis::Cmd,
+        read_from_replica: Option<bool>,
+    ) -> RedisResult<Value> {
         let Some(cmd_bytes) = Routable::command(cmd) else {
-            return self.send_request_to_single_node(cmd, false).await;
+            return self
+                .send_request_to_single_node(cmd, false, read_from_replica)
+                .await;
         };
 
         if RoutingInfo::is_all_nodes(cmd_bytes.as_slice()) {
             let response_policy = ResponsePolicy::for_command(cmd_bytes.as_slice());
             return self.send_request_to_all_nodes(cmd, response_policy).await;
         }
-        self.send_request_to_single_node(cmd, is_readonly_cmd(cmd_bytes.as_slice()))
-            .await
+        self.send_request_to_single_node(
+            cmd,
+            is_readonly_cmd(cmd_bytes.as_slice()),
+            read_from_replica,
+        )
+        .await
     }
 
     pub async fn send_pipeline(
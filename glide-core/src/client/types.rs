@@ -45,11 +45,17 @@ pub enum PeriodicCheck {
 pub struct NodeAddress {
     pub host: String,
     pub port: u16,
+    /// When set, the node is connected to over the Unix domain socket at this path, and the host and
+    /// port are ignored.
+    pub unix_socket_path: Option<String>,
 }
 
 impl ::std::fmt::Display for NodeAddress {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
-        write!(f, "Host: `{}`, Port: {}", self.host, self.port)
+        match &self.unix_socket_path {
+            Some(path) => write!(f, "Unix socket: `{path}`"),
+            None => write!(f, "Host: `{}`, Port: {}", self.host, self.port),
+        }
     }
 }
 
@@ -160,6 +166,8 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
             .map(|addr| NodeAddress {
                 host: addr.host.to_string(),
                 port: addr.port as u16,
+                unix_socket_path: (!addr.unix_socket_path.is_empty())
+                    .then(|| addr.unix_socket_path.to_string()),
             })
             .collect();
         let cluster_mode_enabled = value.cluster_mode_enabled;
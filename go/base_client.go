@@ -18,6 +18,10 @@ package glide
 //                     const uint8_t *message, int64_t message_len,
 //                     const uint8_t *channel, int64_t channel_len,
 //                     const uint8_t *pattern, int64_t pattern_len);
+// void lifecycleCallback(void *clientPtr, enum LifecycleEventKind kind,
+//                        const uint8_t *address, int64_t address_len,
+//                        uint64_t attempt,
+//                        const uint8_t *detail, int64_t detail_len);
 import "C"
 
 import (
@@ -27,6 +31,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/itayporezky/valkey-glide/go/v4/constants"
@@ -51,13 +56,53 @@ type payload struct {
 
 type clientConfiguration interface {
 	ToProtobuf() (*protobuf.ConnectionRequest, error)
+	GetMiddlewares() []*config.Middleware
+	GetCommandPolicy() *config.CommandPolicy
+	GetBlockingConnections() int
+	GetEventListener() config.ConnectionEventListener
+	GetHedgedReads() *config.HedgingConfiguration
+	GetRetryPolicy() *config.RetryPolicy
+	GetCircuitBreaker() *config.CircuitBreakerConfiguration
 }
 
-type baseClient struct {
+// clientConnection holds the connection to the core, which is shared by a client and its views.
+type clientConnection struct {
 	pending        map[unsafe.Pointer]struct{}
 	coreClient     unsafe.Pointer
 	mu             sync.Mutex
 	messageHandler *MessageHandler
+	// blocking holds the dedicated connections of blocking commands and transactions, or is nil for dedicated connections.
+	blocking *blockingPool
+	// requestTimeout is the request timeout of a dedicated connection, which is enforced by the client rather than by the
+	// core, or 0 for shared connections.
+	requestTimeout time.Duration
+	// sentinel follows the failovers of a client configured with sentinels, or is nil.
+	sentinel *sentinelMonitor
+	// closing is true once CloseWithContext was called, after which new requests are rejected.
+	closing bool
+	// drained is closed once no request is pending after closing, or is nil if the client is not closing.
+	drained chan struct{}
+	// lifecycle tracks the connection lifecycle events of the client, or is nil for dedicated connections.
+	lifecycle *lifecycleTracker
+}
+
+type baseClient struct {
+	*clientConnection
+	middlewares []*config.Middleware
+	// commandPolicy restricts the commands of the client, or is nil if all the commands are allowed.
+	commandPolicy *config.CommandPolicy
+	// namespace is the prefix of the keys of a view created by WithNamespace.
+	namespace string
+	// dedicated is true for views created by Blocking, which send all their commands over dedicated connections.
+	dedicated bool
+	// node is the route of the views created by Node, which send all their commands to a single node, or nil.
+	node config.Route
+	// hedging sends the hedged reads of the client, or is nil if its reads are not hedged.
+	hedging *hedgedReads
+	// retries retries the failed commands of the client, or is nil if its commands are not retried.
+	retries *commandRetries
+	// breakers guards the nodes of the client, or is nil if its nodes are not guarded.
+	breakers *circuitBreakers
 }
 
 // setMessageHandler assigns a message handler to the client for processing pub/sub messages
@@ -130,15 +175,44 @@ func buildAsyncClientType(successCb C.SuccessCallback, failureCb C.FailureCallba
 	return clientType, nil
 }
 
-// Creates a connection by invoking the `create_client` function from Rust library via FFI.
-// Passes the pointers to callback functions which will be invoked when the command succeeds or fails.
-// Once the connection is established, this function invokes `free_connection_response` exposed by rust library to free the
-// connection_response to avoid any memory leaks.
+// Creates a client by connecting to the servers of the configuration.
 func createClient(config clientConfiguration) (*baseClient, error) {
 	request, err := config.ToProtobuf()
 	if err != nil {
 		return nil, err
 	}
+	return createClientWithRequest(config, request)
+}
+
+// Creates a client by connecting to the servers of the connection request, which was built from the configuration.
+func createClientWithRequest(config clientConfiguration, request *protobuf.ConnectionRequest) (*baseClient, error) {
+	request.LifecycleEvents = true
+	connection, err := connect(request)
+	if err != nil {
+		return nil, err
+	}
+	connection.blocking = newBlockingPool(request, config.GetBlockingConnections())
+	connection.lifecycle = newLifecycleTracker(config.GetEventListener())
+	client := &baseClient{
+		clientConnection: connection,
+		middlewares:      config.GetMiddlewares(),
+		commandPolicy:    config.GetCommandPolicy(),
+		hedging:          newHedgedReads(config.GetHedgedReads(), readFromProtobuf(request.ReadFrom)),
+		retries:          newCommandRetries(config.GetRetryPolicy()),
+		breakers:         newCircuitBreakers(config.GetCircuitBreaker(), request, connection.lifecycle),
+	}
+
+	// Register the client in our registry using the pointer value from C
+	registerClient(client, uintptr(connection.coreClient))
+
+	return client, nil
+}
+
+// Creates a connection by invoking the `create_client` function from Rust library via FFI.
+// Passes the pointers to callback functions which will be invoked when the command succeeds or fails.
+// Once the connection is established, this function invokes `free_connection_response` exposed by rust library to free the
+// connection_response to avoid any memory leaks.
+func connect(request *protobuf.ConnectionRequest) (*clientConnection, error) {
 	msg, err := proto.Marshal(request)
 	if err != nil {
 		return nil, err
@@ -154,7 +228,6 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 	if err != nil {
 		return nil, &errors.ClosingError{Msg: err.Error()}
 	}
-	client := &baseClient{pending: make(map[unsafe.Pointer]struct{})}
 
 	cResponse := (*C.struct_ConnectionResponse)(
 		C.create_client(
@@ -162,6 +235,7 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 			C.uintptr_t(byteCount),
 			&clientType,
 			(C.PubSubCallback)(unsafe.Pointer(C.pubSubCallback)),
+			(C.LifecycleCallback)(unsafe.Pointer(C.lifecycleCallback)),
 		),
 	)
 	defer C.free_connection_response(cResponse)
@@ -171,35 +245,75 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 		return nil, &errors.ConnectionError{Msg: message}
 	}
 
-	client.coreClient = cResponse.conn_ptr
-
-	// Register the client in our registry using the pointer value from C
-	registerClient(client, uintptr(cResponse.conn_ptr))
-
-	return client, nil
+	return &clientConnection{pending: make(map[unsafe.Pointer]struct{}), coreClient: cResponse.conn_ptr}, nil
 }
 
-// Close terminates the client by closing all associated resources.
-func (client *baseClient) Close() {
-	client.mu.Lock()
-	defer client.mu.Unlock()
+// Close terminates the client by closing all associated resources, including the dedicated connections of blocking
+// commands and the connection to the sentinels. The requests in progress fail with a closing error, see CloseWithContext
+// to wait for them instead.
+func (connection *clientConnection) Close() {
+	if connection.sentinel != nil {
+		connection.sentinel.close()
+	}
+	if connection.blocking != nil {
+		connection.blocking.close()
+	}
+	connection.lifecycle.close()
+
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
 
-	if client.coreClient == nil {
+	if connection.coreClient == nil {
 		return
 	}
 
-	unregisterClient(uintptr(client.coreClient))
+	unregisterClient(uintptr(connection.coreClient))
 
-	C.close_client(client.coreClient)
-	client.coreClient = nil
+	C.close_client(connection.coreClient)
+	connection.coreClient = nil
 
 	// iterating the channel map while holding the lock guarantees those unsafe.Pointers is still valid
 	// because holding the lock guarantees the owner of the unsafe.Pointer hasn't exit.
-	for channelPtr := range client.pending {
+	for channelPtr := range connection.pending {
 		resultChannel := *(*chan payload)(channelPtr)
 		resultChannel <- payload{value: nil, error: &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}}
 	}
-	client.pending = nil
+	connection.pending = nil
+	connection.signalDrained()
+}
+
+// removePending removes a request which completed or was canceled from the pending requests.
+func (connection *clientConnection) removePending(resultChannelPtr unsafe.Pointer) {
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	if connection.pending != nil {
+		delete(connection.pending, resultChannelPtr)
+	}
+	if len(connection.pending) == 0 {
+		connection.signalDrained()
+	}
+}
+
+// cancelRequest cancels a request in the core once its caller stopped waiting for it, so that the core does not keep
+// waiting for its response until the request timeout. The request then completes with a cancellation error.
+func (connection *clientConnection) cancelRequest(requestID uintptr) {
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	if connection.coreClient != nil {
+		C.cancel_request(connection.coreClient, C.uintptr_t(requestID))
+	}
+}
+
+// signalDrained wakes up CloseWithContext once no request is pending. It must be called while holding the lock.
+func (connection *clientConnection) signalDrained() {
+	if connection.drained == nil {
+		return
+	}
+	select {
+	case <-connection.drained:
+	default:
+		close(connection.drained)
+	}
 }
 
 func (client *baseClient) executeCommand(
@@ -289,6 +403,28 @@ func (client *baseClient) executeCommandWithRoute(
 	requestType C.RequestType,
 	args []string,
 	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if route == nil {
+		route = client.node
+	}
+	if client.commandPolicy != nil {
+		request := config.CommandRequest{Name: commandName(requestType, args), Args: args, Custom: requestType == C.CustomCommand}
+		if err := client.commandPolicy.Check(&request); err != nil {
+			return nil, err
+		}
+	}
+	if len(client.middlewares) > 0 {
+		return client.interceptCommand(ctx, requestType, args, route)
+	}
+	return client.dispatchCommand(ctx, requestType, args, route)
+}
+
+// submitCommand sends a command to the core and waits for its response.
+func (connection *clientConnection) submitCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
 ) (*C.struct_CommandResponse, error) {
 	// Check if context is already done
 	select {
@@ -328,7 +464,7 @@ func (client *baseClient) executeCommandWithRoute(
 		routeBytesCount = C.uintptr_t(len(msg))
 		routeBytesPtr = (*C.uchar)(C.CBytes(msg))
 	}
-	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
+	// make the channel buffered, so that we don't need to acquire the connection.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
 	resultChannelPtr := unsafe.Pointer(&resultChannel)
 
@@ -336,14 +472,14 @@ func (client *baseClient) executeCommandWithRoute(
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
 	defer pinner.Unpin()
 
-	client.mu.Lock()
-	if client.coreClient == nil {
-		client.mu.Unlock()
+	connection.mu.Lock()
+	if connection.coreClient == nil || connection.closing {
+		connection.mu.Unlock()
 		return nil, &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	connection.pending[resultChannelPtr] = struct{}{}
 	C.command(
-		client.coreClient,
+		connection.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
 		uint32(requestType),
 		C.size_t(len(args)),
@@ -352,17 +488,15 @@ func (client *baseClient) executeCommandWithRoute(
 		routeBytesPtr,
 		routeBytesCount,
 		C.uint64_t(spanPtr),
+		getRequestOptions(ctx).commandOptionsInfo(),
 	)
-	client.mu.Unlock()
+	connection.mu.Unlock()
 	// Wait for result or context cancellation
 	var payload payload
 	select {
 	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
+		connection.removePending(resultChannelPtr)
+		connection.cancelRequest(pinnedChannelPtr)
 		// Start cleanup goroutine
 		go func() {
 			// Wait for payload on separate channel
@@ -375,11 +509,7 @@ func (client *baseClient) executeCommandWithRoute(
 		// Continue with normal processing
 	}
 
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
+	connection.removePending(resultChannelPtr)
 
 	if payload.error != nil {
 		return nil, payload.error
@@ -408,6 +538,40 @@ func (client *baseClient) executeBatch(
 	batch pipeline.Batch,
 	raiseOnError bool,
 	options *pipeline.BatchOptions,
+) ([]any, error) {
+	if client.node != nil && (options == nil || options.Route == nil) {
+		pinned := pipeline.BatchOptions{}
+		if options != nil {
+			pinned = *options
+		}
+		pinned.Route = &client.node
+		options = &pinned
+	}
+	if client.commandPolicy != nil {
+		for _, cmd := range batch.Commands {
+			requestType := C.RequestType(cmd.RequestType)
+			request := config.CommandRequest{
+				Name:   commandName(requestType, cmd.Args),
+				Args:   cmd.Args,
+				Custom: requestType == C.CustomCommand,
+			}
+			if err := client.commandPolicy.Check(&request); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(client.middlewares) > 0 {
+		return client.interceptBatch(ctx, batch, raiseOnError, options)
+	}
+	return client.submitBatch(ctx, batch, raiseOnError, options)
+}
+
+// submitBatch sends a batch to the core, waits for its response and converts it.
+func (client *baseClient) submitBatch(
+	ctx context.Context,
+	batch pipeline.Batch,
+	raiseOnError bool,
+	options *pipeline.BatchOptions,
 ) ([]any, error) {
 	// Check if context is already done
 	select {
@@ -442,7 +606,7 @@ func (client *baseClient) executeBatch(
 	defer pinner.Unpin()
 
 	client.mu.Lock()
-	if client.coreClient == nil {
+	if client.coreClient == nil || client.closing {
 		client.mu.Unlock()
 		return nil, &errors.ClosingError{Msg: "ExecuteBatch failed. The client is closed."}
 	}
@@ -469,11 +633,8 @@ func (client *baseClient) executeBatch(
 	var payload payload
 	select {
 	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
+		client.removePending(resultChannelPtr)
+		client.cancelRequest(pinnedChannelPtr)
 		// Start cleanup goroutine
 		go func() {
 			// Wait for payload on separate channel
@@ -486,11 +647,7 @@ func (client *baseClient) executeBatch(
 		// Continue with normal processing
 	}
 
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
+	client.removePending(resultChannelPtr)
 
 	if payload.error != nil {
 		return nil, payload.error
@@ -603,10 +760,26 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 	password string,
 	immediateAuth bool,
 ) (string, error) {
+	response, err := client.submitOperation(ctx, "UpdatePassword", func(channel C.uintptr_t) {
+		C.update_connection_password(client.coreClient, channel, C.CString(password), C._Bool(immediateAuth))
+	})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// submitOperation submits an operation on the state of the core client, which is not sent as a command to the servers,
+// and waits for its response. The submit function is called with the client locked.
+func (client *baseClient) submitOperation(
+	ctx context.Context,
+	name string,
+	submit func(channel C.uintptr_t),
+) (*C.struct_CommandResponse, error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
-		return models.DefaultStringResponse, ctx.Err()
+		return nil, ctx.Err()
 	default:
 		// Continue with execution
 	}
@@ -620,29 +793,20 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 	defer pinner.Unpin()
 
 	client.mu.Lock()
-	if client.coreClient == nil {
+	if client.coreClient == nil || client.closing {
 		client.mu.Unlock()
-		return models.DefaultStringResponse, &errors.ClosingError{Msg: "UpdatePassword failed. The client is closed."}
+		return nil, &errors.ClosingError{Msg: name + " failed. The client is closed."}
 	}
 	client.pending[resultChannelPtr] = struct{}{}
-
-	C.update_connection_password(
-		client.coreClient,
-		C.uintptr_t(pinnedChannelPtr),
-		C.CString(password),
-		C._Bool(immediateAuth),
-	)
+	submit(C.uintptr_t(pinnedChannelPtr))
 	client.mu.Unlock()
 
 	// Wait for result or context cancellation
 	var payload payload
 	select {
 	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
+		client.removePending(resultChannelPtr)
+		client.cancelRequest(pinnedChannelPtr)
 		// Start cleanup goroutine
 		go func() {
 			// Wait for payload on separate channel
@@ -650,22 +814,17 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 				C.free_command_response(payload.value)
 			}
 		}()
-		return models.DefaultStringResponse, ctx.Err()
+		return nil, ctx.Err()
 	case payload = <-resultChannel:
 		// Continue with normal processing
 	}
 
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
+	client.removePending(resultChannelPtr)
 
 	if payload.error != nil {
-		return models.DefaultStringResponse, payload.error
+		return nil, payload.error
 	}
-
-	return handleOkResponse(payload.value)
+	return payload.value, nil
 }
 
 // Update the current connection with a new password.
@@ -8831,6 +8990,15 @@ func (client *baseClient) executeScriptWithRoute(
 	default:
 		// Continue with execution
 	}
+	if client.commandPolicy != nil {
+		if err := client.commandPolicy.Check(&config.CommandRequest{Name: "EVALSHA"}); err != nil {
+			return nil, err
+		}
+	}
+	if route == nil {
+		route = client.node
+	}
+	keys = client.namespacedKeys(keys)
 	var cKeysPtr *C.uintptr_t = nil
 	var keysLengthsPtr *C.ulong = nil
 	if len(keys) > 0 {
@@ -8872,7 +9040,7 @@ func (client *baseClient) executeScriptWithRoute(
 	defer pinner.Unpin()
 
 	client.mu.Lock()
-	if client.coreClient == nil {
+	if client.coreClient == nil || client.closing {
 		client.mu.Unlock()
 		return nil, &errors.ClosingError{Msg: "ExecuteScript failed. The client is closed."}
 	}
@@ -8896,11 +9064,8 @@ func (client *baseClient) executeScriptWithRoute(
 	var payload payload
 	select {
 	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
+		client.removePending(resultChannelPtr)
+		client.cancelRequest(pinnedChannelPtr)
 		// Start cleanup goroutine
 		go func() {
 			// Wait for payload on separate channel
@@ -8913,11 +9078,7 @@ func (client *baseClient) executeScriptWithRoute(
 		// Continue with normal processing
 	}
 
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
+	client.removePending(resultChannelPtr)
 
 	if payload.error != nil {
 		return nil, payload.error
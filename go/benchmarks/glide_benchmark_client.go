@@ -7,7 +7,7 @@ import (
 
 	glide "github.com/itayporezky/valkey-glide/go/v2"
 	"github.com/itayporezky/valkey-glide/go/v4/config"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 )
 
 type glideBenchmarkClient struct {
@@ -0,0 +1,330 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultBlockingConnections is the size of the pool of dedicated connections of clients which do not configure it, and
+// use Blocking.
+const defaultBlockingConnections = 4
+
+// defaultRequestTimeout is the request timeout of the core, when the configuration does not set one.
+const defaultRequestTimeout = 250 * time.Millisecond
+
+// Blocking returns a view of the client which sends all its commands over a pool of dedicated connections, so that
+// blocking commands, such as BLPOP or XREAD with BLOCK, do not delay the commands of the client. The request timeout of a
+// blocking command is extended by its block timeout.
+//
+// The pool holds at most the number of connections set by [config.ClientConfiguration.WithBlockingConnections], or 4
+// connections, which are opened when needed and closed with the client. Commands wait for a connection when all the
+// connections are in use. Batches and scripts are sent over the shared connection.
+func (client *Client) Blocking() *Client {
+	return &Client{client.dedicatedView()}
+}
+
+// Blocking returns a view of the client which sends all its commands over a pool of dedicated connections, so that
+// blocking commands, such as BLPOP or XREAD with BLOCK, do not delay the commands of the client. The request timeout of a
+// blocking command is extended by its block timeout.
+//
+// The pool holds at most the number of connections set by [config.ClusterClientConfiguration.WithBlockingConnections],
+// or 4 connections, which are opened when needed and closed with the client. Each connection connects to the whole
+// cluster. Commands wait for a connection when all the connections are in use. Batches, scripts and cluster scans are
+// sent over the shared connection.
+func (client *ClusterClient) Blocking() *ClusterClient {
+	return &ClusterClient{client.dedicatedView()}
+}
+
+func (client *baseClient) dedicatedView() *baseClient {
+	view := *client
+	view.dedicated = true
+	return &view
+}
+
+// sendCommand sends a command over a dedicated connection if the client is a dedicated view, or if the command blocks
+// and the client is configured with blocking connections, and over the shared connection otherwise.
+func (client *baseClient) sendCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if client.hedges(requestType, args, route) {
+		return client.submitHedged(ctx, requestType, args)
+	}
+	if client.blocking == nil && client.requestTimeout == 0 {
+		return client.submitCommand(ctx, requestType, args, route)
+	}
+	commandArgs := args
+	if requestType == C.CustomCommand && len(args) > 0 {
+		commandArgs = args[1:]
+	}
+	timeout, blocks := blockTimeout(commandName(requestType, args), commandArgs)
+	switch {
+	case client.requestTimeout > 0:
+		// the client is bound to a dedicated connection, such as the connection of a transaction
+		return client.submitWithTimeout(transactionContext(ctx), requestType, args, route, timeout, blocks)
+	case client.dedicated || (blocks && client.blocking.automatic):
+		return client.blocking.submitCommand(ctx, requestType, args, route, timeout, blocks)
+	}
+	return client.submitCommand(ctx, requestType, args, route)
+}
+
+// blockTimeout returns the block timeout of a command, given its arguments excluding its name, and whether the command
+// blocks. A zero timeout blocks indefinitely.
+func blockTimeout(name string, args []string) (time.Duration, bool) {
+	if len(args) == 0 {
+		return 0, false
+	}
+	switch name {
+	case "BLPOP", "BRPOP", "BZPOPMIN", "BZPOPMAX", "BLMOVE", "BRPOPLPUSH":
+		return parseSeconds(args[len(args)-1])
+	case "BLMPOP", "BZMPOP":
+		return parseSeconds(args[0])
+	case "XREAD", "XREADGROUP":
+		for i := 0; i+1 < len(args) && !strings.EqualFold(args[i], "STREAMS"); i++ {
+			if strings.EqualFold(args[i], "BLOCK") {
+				milliseconds, err := strconv.ParseInt(args[i+1], 10, 64)
+				return time.Duration(milliseconds) * time.Millisecond, err == nil && milliseconds >= 0
+			}
+		}
+	}
+	return 0, false
+}
+
+func parseSeconds(arg string) (time.Duration, bool) {
+	seconds, err := strconv.ParseFloat(arg, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
+
+// blockingPool holds the dedicated connections of a client, which run its blocking commands and its transactions.
+type blockingPool struct {
+	// request is the connection request of the dedicated connections, without subscriptions and request timeout.
+	request        *protobuf.ConnectionRequest
+	requestTimeout time.Duration
+	// automatic is true if blocking commands are sent over the pool.
+	automatic bool
+	// slots limits the number of connections in use.
+	slots chan struct{}
+
+	mu   sync.Mutex
+	idle []*clientConnection
+	// active maps the connections in use to whether they were opened with a previous connection request.
+	active map[*clientConnection]bool
+	closed bool
+}
+
+func newBlockingPool(request *protobuf.ConnectionRequest, size int) *blockingPool {
+	automatic := size > 0
+	if !automatic {
+		size = defaultBlockingConnections
+	}
+	pool := &blockingPool{
+		request:        proto.Clone(request).(*protobuf.ConnectionRequest),
+		requestTimeout: defaultRequestTimeout,
+		automatic:      automatic,
+		slots:          make(chan struct{}, size),
+		active:         make(map[*clientConnection]bool),
+	}
+	if request.RequestTimeout != 0 {
+		pool.requestTimeout = time.Duration(request.RequestTimeout) * time.Millisecond
+	}
+	// the pool enforces the request timeouts, since the core can not extend them by the block timeouts
+	pool.request.RequestTimeout = math.MaxUint32
+	pool.request.PubsubSubscriptions = nil
+	pool.request.LifecycleEvents = false
+	return pool
+}
+
+// submitCommand sends a command over a dedicated connection of the pool.
+func (pool *blockingPool) submitCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	blockTimeout time.Duration,
+	blocks bool,
+) (*C.struct_CommandResponse, error) {
+	// the command waits for a connection within its own timeout, since blocking commands may be in use for long
+	connection, err := pool.acquire(ctx, commandTimeout(ctx, pool.requestTimeout, blockTimeout, blocks))
+	if err != nil {
+		return nil, err
+	}
+	response, err := connection.submitWithTimeout(ctx, requestType, args, route, blockTimeout, blocks)
+	pool.release(connection, reusable(err))
+	return response, err
+}
+
+// submitWithTimeout sends a command over a dedicated connection, within its request timeout extended by the block timeout
+// of the command. The request timeout of the connection is overridden by the request timeout of the context, if any.
+func (connection *clientConnection) submitWithTimeout(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	blockTimeout time.Duration,
+	blocks bool,
+) (*C.struct_CommandResponse, error) {
+	timeout := commandTimeout(ctx, connection.requestTimeout, blockTimeout, blocks)
+	if timeout == 0 {
+		return connection.submitCommand(ctx, requestType, args, route)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	response, err := connection.submitCommand(timeoutCtx, requestType, args, route)
+	if err != nil && timeoutCtx.Err() != nil && ctx.Err() == nil {
+		return nil, timeoutError(timeout)
+	}
+	return response, err
+}
+
+// commandTimeout returns the timeout of a command sent over a dedicated connection, which is the request timeout, or the
+// request timeout of the context if any, extended by the block timeout of the command. It returns zero for the commands
+// which block indefinitely.
+func commandTimeout(ctx context.Context, requestTimeout time.Duration, blockTimeout time.Duration, blocks bool) time.Duration {
+	if blocks && blockTimeout == 0 {
+		return 0
+	}
+	if options := getRequestOptions(ctx); options.timeout > 0 {
+		requestTimeout = options.timeout
+	}
+	return requestTimeout + blockTimeout
+}
+
+func timeoutError(timeout time.Duration) error {
+	return errors.GoError(uint32(C.Timeout), fmt.Sprintf("Request timed out after %v on a dedicated connection", timeout))
+}
+
+// reusable returns whether a dedicated connection can be reused after a command failed with the given error. Commands
+// which were abandoned, because they timed out or were canceled, may still occupy their connection.
+func reusable(err error) bool {
+	switch err.(type) {
+	case nil, *errors.RequestError, *errors.ExecAbortError:
+		return true
+	}
+	return false
+}
+
+// acquire returns an idle connection or a new one, waiting for a connection to be released when all are in use, for at
+// most the given timeout. A zero timeout waits until the context is done.
+func (pool *blockingPool) acquire(ctx context.Context, timeout time.Duration) (*clientConnection, error) {
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	select {
+	case pool.slots <- struct{}{}:
+	case <-expired:
+		return nil, timeoutError(timeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	pool.mu.Lock()
+	if pool.closed {
+		pool.mu.Unlock()
+		<-pool.slots
+		return nil, &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
+	}
+	if count := len(pool.idle); count > 0 {
+		connection := pool.idle[count-1]
+		pool.idle = pool.idle[:count-1]
+		pool.active[connection] = false
+		pool.mu.Unlock()
+		return connection, nil
+	}
+	request := pool.request
+	pool.mu.Unlock()
+
+	connection, err := connect(request)
+	if err != nil {
+		<-pool.slots
+		return nil, err
+	}
+	connection.requestTimeout = pool.requestTimeout
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.closed {
+		connection.Close()
+		<-pool.slots
+		return nil, &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
+	}
+	pool.active[connection] = request != pool.request
+	return connection, nil
+}
+
+// release returns a connection to the pool, or closes it if it can not be reused.
+func (pool *blockingPool) release(connection *clientConnection, reusable bool) {
+	pool.mu.Lock()
+	stale := pool.active[connection]
+	delete(pool.active, connection)
+	reusable = reusable && !stale && !pool.closed
+	if reusable {
+		pool.idle = append(pool.idle, connection)
+	}
+	pool.mu.Unlock()
+
+	if !reusable {
+		connection.Close()
+	}
+	<-pool.slots
+}
+
+// reset replaces the addresses of the connections of the pool. The connections to the previous addresses are closed once
+// they are not in use.
+func (pool *blockingPool) reset(addresses []*protobuf.NodeAddress) {
+	pool.mu.Lock()
+	request := proto.Clone(pool.request).(*protobuf.ConnectionRequest)
+	request.Addresses = addresses
+	pool.request = request
+	idle := pool.idle
+	pool.idle = nil
+	for connection := range pool.active {
+		pool.active[connection] = true
+	}
+	pool.mu.Unlock()
+
+	for _, connection := range idle {
+		connection.Close()
+	}
+}
+
+// close closes the connections of the pool. The commands in progress fail with a closing error.
+func (pool *blockingPool) close() {
+	for _, connection := range pool.stop() {
+		connection.Close()
+	}
+}
+
+// stop prevents new connections from being acquired, and returns the connections of the pool, which are idle or in use.
+func (pool *blockingPool) stop() []*clientConnection {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	connections := pool.idle
+	for connection := range pool.active {
+		connections = append(connections, connection)
+	}
+	pool.idle = nil
+	pool.closed = true
+	return connections
+}
@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockTimeout(t *testing.T) {
+	commands := []struct {
+		name     string
+		args     []string
+		expected time.Duration
+		blocks   bool
+	}{
+		{"BLPOP", []string{"a", "b", "1.5"}, 1500 * time.Millisecond, true},
+		{"BRPOP", []string{"a", "0"}, 0, true},
+		{"BZPOPMIN", []string{"a", "2"}, 2 * time.Second, true},
+		{"BLMOVE", []string{"a", "b", "LEFT", "RIGHT", "0.1"}, 100 * time.Millisecond, true},
+		{"BLMPOP", []string{"3", "1", "a", "LEFT"}, 3 * time.Second, true},
+		{"BZMPOP", []string{"0", "1", "a", "MIN", "COUNT", "2"}, 0, true},
+		{"XREAD", []string{"COUNT", "1", "BLOCK", "500", "STREAMS", "a", "$"}, 500 * time.Millisecond, true},
+		{"XREADGROUP", []string{"GROUP", "g", "c", "block", "0", "STREAMS", "a", ">"}, 0, true},
+		{"XREAD", []string{"STREAMS", "BLOCK", "100"}, 0, false},
+		{"XREAD", []string{"COUNT", "1", "STREAMS", "a", "0"}, 0, false},
+		{"BLPOP", []string{"a", "forever"}, 0, false},
+		{"BLPOP", nil, 0, false},
+		{"GET", []string{"a"}, 0, false},
+	}
+	for _, command := range commands {
+		timeout, blocks := blockTimeout(command.name, command.args)
+		assert.Equal(t, command.expected, timeout, command.name, command.args)
+		assert.Equal(t, command.blocks, blocks, command.name, command.args)
+	}
+}
+
+func TestNewBlockingPool(t *testing.T) {
+	request := &protobuf.ConnectionRequest{
+		RequestTimeout:      100,
+		PubsubSubscriptions: &protobuf.PubSubSubscriptions{},
+		LifecycleEvents:     true,
+	}
+
+	pool := newBlockingPool(request, 2)
+	assert.True(t, pool.automatic)
+	assert.Equal(t, 2, cap(pool.slots))
+	assert.Equal(t, 100*time.Millisecond, pool.requestTimeout)
+	assert.Nil(t, pool.request.PubsubSubscriptions)
+	assert.False(t, pool.request.LifecycleEvents)
+	// the connection request of the client is not modified
+	assert.Equal(t, uint32(100), request.RequestTimeout)
+	assert.NotNil(t, request.PubsubSubscriptions)
+
+	pool = newBlockingPool(&protobuf.ConnectionRequest{}, 0)
+	assert.False(t, pool.automatic)
+	assert.Equal(t, defaultBlockingConnections, cap(pool.slots))
+	assert.Equal(t, defaultRequestTimeout, pool.requestTimeout)
+}
+
+func TestCommandTimeout(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, 100*time.Millisecond, commandTimeout(ctx, 100*time.Millisecond, 0, false))
+	assert.Equal(t, 10100*time.Millisecond, commandTimeout(ctx, 100*time.Millisecond, 10*time.Second, true))
+	assert.Equal(t, time.Duration(0), commandTimeout(ctx, 100*time.Millisecond, 0, true))
+	ctx = WithRequestOptions(ctx, WithRequestTimeout(time.Second))
+	assert.Equal(t, 3*time.Second, commandTimeout(ctx, 100*time.Millisecond, 2*time.Second, true))
+}
+
+func TestAcquireBusyPool(t *testing.T) {
+	pool := newBlockingPool(&protobuf.ConnectionRequest{RequestTimeout: 10}, 1)
+	pool.slots <- struct{}{}
+
+	// the transactions fail once the timeout elapsed
+	_, err := pool.acquire(context.Background(), pool.requestTimeout)
+	assert.IsType(t, &errors.TimeoutError{}, err)
+
+	// the blocking commands wait for a connection until their context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 5*pool.requestTimeout)
+	defer cancel()
+	start := time.Now()
+	_, err = pool.acquire(ctx, 0)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.GreaterOrEqual(t, time.Since(start), 5*pool.requestTimeout)
+}
@@ -0,0 +1,285 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package cache implements the cache-aside pattern on top of the Valkey GLIDE clients.
+//
+// [Cache.GetOrLoad] returns the cached value of a key, or computes it with a loader and caches it on a miss:
+//
+//	users := cache.New[User](client)
+//	user, err := users.GetOrLoad(ctx, "user:42", time.Minute, func(ctx context.Context) (User, error) {
+//		return db.LoadUser(ctx, 42)
+//	})
+//
+// Concurrent misses of a key in the same process are coalesced into a single call of the loader. To protect the source of
+// the values from the misses of many processes at once, a cache may take a lease on the key before loading it with
+// [Cache.WithLease], so that other processes wait for the value instead of loading it too. Values can also be refreshed
+// before they expire with [Cache.WithEarlyRefresh], and served after they expire while being refreshed in the
+// background with [Cache.WithStaleWhileRevalidate].
+package cache
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/codec"
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/lock"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// Loader computes the value of a key on a cache miss.
+type Loader[T any] func(ctx context.Context) (T, error)
+
+// Cache caches values of type T in Valkey.
+type Cache[T any] struct {
+	client    interfaces.BaseClientCommands
+	codec     codec.Codec[T]
+	keyPrefix string
+	lease     time.Duration
+	timeout   time.Duration
+	beta      float64
+	stale     time.Duration
+	now       func() time.Time
+	// decodeErrorHandler is called for the cached values which could not be decoded.
+	decodeErrorHandler func(key string, err error)
+
+	mu    sync.Mutex
+	calls map[string]*call[T]
+}
+
+// call is an in-flight load of a key, shared by the concurrent misses of the key.
+type call[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// New creates a cache which stores values encoded with [codec.JSON], and whose loads time out after 10 seconds.
+func New[T any](client interfaces.BaseClientCommands) *Cache[T] {
+	return &Cache[T]{
+		client:  client,
+		codec:   codec.JSON[T]{},
+		timeout: 10 * time.Second,
+		now:     time.Now,
+		calls:   make(map[string]*call[T]),
+	}
+}
+
+// WithCodec sets the codec used to encode the values.
+func (cache *Cache[T]) WithCodec(valueCodec codec.Codec[T]) *Cache[T] {
+	cache.codec = valueCodec
+	return cache
+}
+
+// WithKeyPrefix sets a prefix added to all the keys of the cache.
+func (cache *Cache[T]) WithKeyPrefix(prefix string) *Cache[T] {
+	cache.keyPrefix = prefix
+	return cache
+}
+
+// WithLease makes the cache take a lease on a key before loading it on a miss, using the [lock] package. While a process
+// holds the lease, the other processes wait up to the given duration for the value to be cached, and load it themselves
+// if it is not.
+func (cache *Cache[T]) WithLease(duration time.Duration) *Cache[T] {
+	cache.lease = duration
+	return cache
+}
+
+// WithLoadTimeout sets the timeout of a load, which includes the lease, the loader and the caching of its value. A load is
+// shared by the concurrent misses of the key, so it is not canceled with the context of any of them.
+func (cache *Cache[T]) WithLoadTimeout(timeout time.Duration) *Cache[T] {
+	cache.timeout = timeout
+	return cache
+}
+
+// WithEarlyRefresh enables probabilistic early refresh, which prevents the misses of many callers at once when a popular
+// value expires. Each read refreshes the value in the background with a probability which increases as it gets closer to
+// its expiry, and with the time it took to compute. A beta of 1 is the usual choice, and higher values refresh earlier.
+func (cache *Cache[T]) WithEarlyRefresh(beta float64) *Cache[T] {
+	cache.beta = beta
+	return cache
+}
+
+// WithStaleWhileRevalidate keeps values for the given duration after their TTL. A read of such a stale value returns it
+// immediately, and refreshes it in the background.
+func (cache *Cache[T]) WithStaleWhileRevalidate(duration time.Duration) *Cache[T] {
+	cache.stale = duration
+	return cache
+}
+
+// WithDecodeErrorHandler sets a handler called with the key and the error of the cached values which could not be
+// decoded, for example values stored by other applications or with another codec. Such values are treated as misses,
+// and overwritten by the value of the loader.
+func (cache *Cache[T]) WithDecodeErrorHandler(handler func(key string, err error)) *Cache[T] {
+	cache.decodeErrorHandler = handler
+	return cache
+}
+
+// GetOrLoad returns the value cached at the key. On a miss, it calls the loader and caches its value for the given TTL.
+// The errors of the loader are returned and not cached. Cached values which could not be decoded are treated as misses.
+func (cache *Cache[T]) GetOrLoad(ctx context.Context, key string, ttl time.Duration, loader Loader[T]) (T, error) {
+	cached, found, err := cache.get(ctx, key)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if !found {
+		return cache.load(ctx, key, ttl, loader, true)
+	}
+
+	value, err := cache.codec.Decode(cached.data)
+	if err != nil {
+		cache.undecodable(key, err)
+		return cache.load(ctx, key, ttl, loader, true)
+	}
+	now := cache.now().UnixMilli()
+	if now >= cached.freshUntil || cache.refreshEarly(cached, now) {
+		// the stale or soon to expire value is returned while it is refreshed
+		cache.load(ctx, key, ttl, loader, false)
+	}
+	return value, nil
+}
+
+// refreshEarly decides whether to refresh a fresh value, following the XFetch algorithm.
+func (cache *Cache[T]) refreshEarly(cached envelope, now int64) bool {
+	if cache.beta <= 0 {
+		return false
+	}
+	gap := float64(cached.delta) * cache.beta * -math.Log(1-rand.Float64())
+	return float64(now)+gap >= float64(cached.freshUntil)
+}
+
+// Set caches the value at the key for the given TTL.
+func (cache *Cache[T]) Set(ctx context.Context, key string, value T, ttl time.Duration) error {
+	return cache.set(ctx, key, value, ttl, 0)
+}
+
+// Delete removes the value cached at the key.
+func (cache *Cache[T]) Delete(ctx context.Context, key string) error {
+	_, err := cache.client.Del(ctx, []string{cache.keyPrefix + key})
+	return err
+}
+
+func (cache *Cache[T]) get(ctx context.Context, key string) (envelope, bool, error) {
+	result, err := cache.client.Get(ctx, cache.keyPrefix+key)
+	if err != nil || result.IsNil() {
+		return envelope{}, false, err
+	}
+	cached, err := parseEnvelope(result.Value())
+	if err != nil {
+		cache.undecodable(key, err)
+		return envelope{}, false, nil
+	}
+	return cached, true, nil
+}
+
+// undecodable reports a cached value which could not be decoded, and which is treated as a miss.
+func (cache *Cache[T]) undecodable(key string, err error) {
+	if cache.decodeErrorHandler != nil {
+		cache.decodeErrorHandler(key, err)
+	}
+}
+
+func (cache *Cache[T]) set(ctx context.Context, key string, value T, ttl time.Duration, delta time.Duration) error {
+	data, err := cache.codec.Encode(value)
+	if err != nil {
+		return err
+	}
+	stored := envelope{
+		freshUntil: cache.now().Add(ttl).UnixMilli(),
+		delta:      delta.Milliseconds(),
+		data:       data,
+	}
+	expiry := options.NewExpiry().SetType(constants.Milliseconds).SetCount(uint64((ttl + cache.stale).Milliseconds()))
+	_, err = cache.client.SetWithOptions(ctx, cache.keyPrefix+key, stored.String(), *options.NewSetOptions().SetExpiry(expiry))
+	return err
+}
+
+// load calls the loader and caches its value, coalescing the concurrent loads of the key. The load runs in the
+// background with the timeout of the cache, and each caller waits for it until its own context is done. A caller which
+// does not wait for the value only starts a load if none is in flight.
+func (cache *Cache[T]) load(ctx context.Context, key string, ttl time.Duration, loader Loader[T], wait bool) (T, error) {
+	cache.mu.Lock()
+	current, found := cache.calls[key]
+	if !found {
+		current = &call[T]{done: make(chan struct{})}
+		cache.calls[key] = current
+		go cache.run(context.WithoutCancel(ctx), key, ttl, loader, current)
+	}
+	cache.mu.Unlock()
+
+	var zero T
+	if !wait {
+		return zero, nil
+	}
+	select {
+	case <-current.done:
+		return current.value, current.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// run performs a load shared by the callers of [Cache.load], and wakes them up once it is done.
+func (cache *Cache[T]) run(ctx context.Context, key string, ttl time.Duration, loader Loader[T], current *call[T]) {
+	if cache.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cache.timeout)
+		defer cancel()
+	}
+	current.value, current.err = cache.loadWithLease(ctx, key, ttl, loader)
+
+	cache.mu.Lock()
+	delete(cache.calls, key)
+	cache.mu.Unlock()
+	close(current.done)
+}
+
+func (cache *Cache[T]) loadWithLease(ctx context.Context, key string, ttl time.Duration, loader Loader[T]) (T, error) {
+	if cache.lease > 0 {
+		// the fencing counters would outlive the keys of the cache
+		locker := lock.NewLocker(cache.client).
+			WithKeyPrefix(cache.keyPrefix + "lease:").
+			WithAutoRenewal(false).
+			WithFencing(false)
+		lease, err := locker.Acquire(ctx, key, cache.lease)
+		if err == nil {
+			defer lease.Release(context.WithoutCancel(ctx))
+		} else if value, found := cache.awaitValue(ctx, key); found {
+			return value, nil
+		}
+	}
+
+	start := time.Now()
+	value, err := loader(ctx)
+	if err != nil {
+		return value, err
+	}
+	return value, cache.set(ctx, key, value, ttl, time.Since(start))
+}
+
+// awaitValue polls the key until another process caches a fresh value, or until the lease duration elapses.
+func (cache *Cache[T]) awaitValue(ctx context.Context, key string) (T, bool) {
+	var zero T
+	deadline := time.Now().Add(cache.lease)
+	interval := min(cache.lease/10, 100*time.Millisecond)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return zero, false
+		case <-time.After(interval):
+		}
+		cached, found, err := cache.get(ctx, key)
+		if err != nil {
+			return zero, false
+		}
+		if found && cache.now().UnixMilli() < cached.freshUntil {
+			value, err := cache.codec.Decode(cached.data)
+			return value, err == nil
+		}
+	}
+	return zero, false
+}
@@ -0,0 +1,270 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/codec"
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type user struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+// countingLoader returns a loader which returns the value and counts its calls.
+func countingLoader[T any](value T, calls *atomic.Int32) Loader[T] {
+	return func(ctx context.Context) (T, error) {
+		calls.Add(1)
+		return value, nil
+	}
+}
+
+func TestGetOrLoad(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+	users := New[user](client).WithKeyPrefix("users:")
+	var calls atomic.Int32
+
+	value, err := users.GetOrLoad(ctx, "42", time.Minute, countingLoader(user{"alice", 30}, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, user{"alice", 30}, value)
+
+	value, err = users.GetOrLoad(ctx, "42", time.Minute, countingLoader(user{"bob", 40}, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, user{"alice", 30}, value)
+	assert.Equal(t, int32(1), calls.Load())
+
+	ttl, err := client.TTL(ctx, "users:42")
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), ttl)
+
+	require.NoError(t, users.Delete(ctx, "42"))
+	value, err = users.GetOrLoad(ctx, "42", time.Minute, countingLoader(user{"bob", 40}, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, user{"bob", 40}, value)
+}
+
+func TestGetOrLoadError(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+	strings := New[string](client).WithCodec(codec.String{})
+	loaderErr := errors.New("unavailable")
+
+	_, err := strings.GetOrLoad(ctx, "key", time.Minute, func(ctx context.Context) (string, error) {
+		return "", loaderErr
+	})
+	assert.ErrorIs(t, err, loaderErr)
+
+	exists, err := client.Exists(ctx, []string{"key"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+
+}
+
+func TestGetOrLoadUndecodable(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+	var reported []string
+	users := New[user](client).WithDecodeErrorHandler(func(key string, err error) {
+		assert.Error(t, err)
+		reported = append(reported, key)
+	})
+	var calls atomic.Int32
+
+	// values which were not stored by the cache, or not with its codec, are reloaded and overwritten
+	_, err := client.Set(ctx, "foreign", "value")
+	require.NoError(t, err)
+	value, err := users.GetOrLoad(ctx, "foreign", time.Minute, countingLoader(user{"alice", 30}, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, user{"alice", 30}, value)
+
+	require.NoError(t, New[string](client).WithCodec(codec.String{}).Set(ctx, "string", "value", time.Minute))
+	value, err = users.GetOrLoad(ctx, "string", time.Minute, countingLoader(user{"bob", 40}, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, user{"bob", 40}, value)
+
+	value, err = users.GetOrLoad(ctx, "foreign", time.Minute, countingLoader(user{"carol", 50}, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, user{"alice", 30}, value)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, []string{"foreign", "string"}, reported)
+}
+
+func TestGetOrLoadCoalescesMisses(t *testing.T) {
+	ctx := context.Background()
+	strings := New[string](glidetest.NewClient()).WithCodec(codec.String{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	loader := func(ctx context.Context) (string, error) {
+		calls.Add(1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = strings.GetOrLoad(ctx, "key", time.Minute, loader)
+		}()
+	}
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, result := range results {
+		assert.Equal(t, "value", result)
+	}
+}
+
+func TestGetOrLoadCanceled(t *testing.T) {
+	strings := New[string](glidetest.NewClient()).WithCodec(codec.String{})
+	started, release := make(chan struct{}), make(chan struct{})
+	loader := func(ctx context.Context) (string, error) {
+		close(started)
+		select {
+		case <-release:
+			return "value", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	// the caller which started the load gives up, while the other caller still gets the value
+	canceled, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := strings.GetOrLoad(canceled, "key", time.Minute, loader)
+		errs <- err
+	}()
+	<-started
+	results := make(chan string)
+	go func() {
+		value, _ := strings.GetOrLoad(context.Background(), "key", time.Minute, loader)
+		results <- value
+	}()
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	close(release)
+	assert.Equal(t, "value", <-results)
+
+	// the loads time out with the timeout of the cache
+	strings.WithLoadTimeout(10 * time.Millisecond)
+	_, err := strings.GetOrLoad(context.Background(), "other", time.Minute, func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	ctx := context.Background()
+	server := glidetest.NewServer()
+	strings := New[string](server.NewClient()).WithCodec(codec.String{}).WithStaleWhileRevalidate(time.Minute)
+	strings.now = server.Now
+
+	_, err := strings.GetOrLoad(ctx, "key", time.Second, countingLoader("old", &atomic.Int32{}))
+	require.NoError(t, err)
+
+	server.FastForward(2 * time.Second)
+	var calls atomic.Int32
+	value, err := strings.GetOrLoad(ctx, "key", time.Second, countingLoader("new", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "old", value)
+
+	assert.Eventually(t, func() bool {
+		value, err := strings.GetOrLoad(ctx, "key", time.Second, countingLoader("newer", &calls))
+		return err == nil && value == "new"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// values are removed after the stale period
+	server.FastForward(2 * time.Minute)
+	value, err = strings.GetOrLoad(ctx, "key", time.Second, countingLoader("newest", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "newest", value)
+}
+
+func TestRefreshEarly(t *testing.T) {
+	cache := New[string](glidetest.NewClient()).WithEarlyRefresh(1)
+	now := int64(1_000_000)
+
+	assert.False(t, New[string](glidetest.NewClient()).refreshEarly(envelope{freshUntil: now + 1, delta: 1000}, now))
+	assert.False(t, cache.refreshEarly(envelope{freshUntil: now + 1000, delta: 0}, now))
+
+	refreshed := 0
+	for i := 0; i < 1000; i++ {
+		if cache.refreshEarly(envelope{freshUntil: now + 1000, delta: 1000}, now) {
+			refreshed++
+		}
+	}
+	// the probability of a refresh is exp(-1)
+	assert.InDelta(t, 368, refreshed, 100)
+}
+
+func TestLease(t *testing.T) {
+	ctx := context.Background()
+	server := glidetest.NewServer()
+	client := server.NewClient()
+	strings := New[string](client).WithCodec(codec.String{}).WithLease(200 * time.Millisecond)
+
+	// another process holds the lease and caches the value
+	_, err := client.Set(ctx, "lease:{key}", "other")
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		other := New[string](server.NewClient()).WithCodec(codec.String{})
+		_ = other.Set(ctx, "key", "cached", time.Minute)
+	}()
+
+	var calls atomic.Int32
+	value, err := strings.GetOrLoad(ctx, "key", time.Minute, countingLoader("loaded", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "cached", value)
+	assert.Equal(t, int32(0), calls.Load())
+
+	// the value is loaded when the lease holder does not cache it in time
+	value, err = strings.GetOrLoad(ctx, "missing", time.Minute, countingLoader("loaded", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "loaded", value)
+	_, err = client.Set(ctx, "lease:{other}", "other")
+	require.NoError(t, err)
+	value, err = strings.GetOrLoad(ctx, "other", time.Minute, countingLoader("loaded", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "loaded", value)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// only the cached values remain once the leases expired
+	_, err = client.Del(ctx, []string{"lease:{key}", "lease:{other}"})
+	require.NoError(t, err)
+	server.FastForward(200 * time.Millisecond)
+	_, keys, err := client.Scan(ctx, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"key", "missing", "other"}, keys)
+}
+
+func TestEnvelope(t *testing.T) {
+	stored := envelope{freshUntil: 1700000000000, delta: 12, data: "a:b:c"}
+	parsed, err := parseEnvelope(stored.String())
+	require.NoError(t, err)
+	assert.Equal(t, stored, parsed)
+
+	_, err = parseEnvelope("value")
+	assert.Error(t, err)
+	_, err = parseEnvelope("a:1:value")
+	assert.Error(t, err)
+}
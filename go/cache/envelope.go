@@ -0,0 +1,39 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// envelope is the stored form of a cached value, with the metadata used for early refresh and stale-while-revalidate.
+type envelope struct {
+	// freshUntil is the time, in Unix milliseconds, at which the value becomes stale.
+	freshUntil int64
+	// delta is how long the loader took to compute the value, in milliseconds.
+	delta int64
+	data  string
+}
+
+func (e envelope) String() string {
+	return fmt.Sprintf("%d:%d:%s", e.freshUntil, e.delta, e.data)
+}
+
+func parseEnvelope(stored string) (envelope, error) {
+	parts := strings.SplitN(stored, ":", 3)
+	if len(parts) != 3 {
+		return envelope{}, errors.New("cache: invalid stored value")
+	}
+	freshUntil, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return envelope{}, fmt.Errorf("cache: invalid stored value: %w", err)
+	}
+	delta, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return envelope{}, fmt.Errorf("cache: invalid stored value: %w", err)
+	}
+	return envelope{freshUntil: freshUntil, delta: delta, data: parts[2]}, nil
+}
@@ -98,3 +98,27 @@ func pubSubCallback(
 		}
 	}()
 }
+
+//export lifecycleCallback
+func lifecycleCallback(
+	clientPtr unsafe.Pointer,
+	kind C.LifecycleEventKind,
+	address unsafe.Pointer,
+	addressLen C.int64_t,
+	attempt C.uint64_t,
+	detail unsafe.Pointer,
+	detailLen C.int64_t,
+) {
+	client := getClientByPtr(uintptr(clientPtr))
+	if client == nil {
+		// the events of the dedicated connections are not tracked
+		return
+	}
+	event := lifecycleEvent(
+		kind,
+		string(C.GoBytes(address, C.int(addressLen))),
+		uint64(attempt),
+		string(C.GoBytes(detail, C.int(detailLen))),
+	)
+	client.lifecycle.record(event)
+}
@@ -0,0 +1,237 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+)
+
+// breakerTopologyTTL is the age after which the slot map used to find the nodes of the commands is fetched again, even
+// if no topology refresh was reported.
+const breakerTopologyTTL = 10 * time.Second
+
+// breakerState is the state of a circuit breaker.
+type breakerState int
+
+const (
+	// breakerClosed - Commands are sent to the node.
+	breakerClosed breakerState = iota
+	// breakerOpen - Commands fail fast, until the open duration elapsed.
+	breakerOpen
+	// breakerHalfOpen - A single command probes the node.
+	breakerHalfOpen
+)
+
+// circuitBreaker guards a node, by failing the commands sent to it fast after consecutive failures.
+type circuitBreaker struct {
+	address string
+	config  *config.CircuitBreakerConfiguration
+
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openUntil time.Time
+	// probing is true while the command probing a half-open node is in progress.
+	probing bool
+}
+
+// allow returns a [config.CircuitOpenError] if a command may not be sent to the node. A nil breaker allows all the
+// commands.
+func (breaker *circuitBreaker) allow(now time.Time) error {
+	if breaker == nil {
+		return nil
+	}
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	switch breaker.state {
+	case breakerOpen:
+		if now.Before(breaker.openUntil) {
+			return &config.CircuitOpenError{Address: breaker.address, RetryAfter: breaker.openUntil.Sub(now)}
+		}
+		breaker.state = breakerHalfOpen
+		breaker.probing = true
+	case breakerHalfOpen:
+		if breaker.probing {
+			return &config.CircuitOpenError{Address: breaker.address}
+		}
+		breaker.probing = true
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of a command sent to the node. Transient errors are failures, and the other
+// errors of the server show that the node is reachable. Commands which were canceled or rejected by the client tell
+// nothing about the node.
+func (breaker *circuitBreaker) record(err error, now time.Time) {
+	if breaker == nil {
+		return
+	}
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	breaker.probing = false
+	if !transientError(err) {
+		switch err.(type) {
+		case nil, *errors.RequestError, *errors.ExecAbortError:
+			breaker.state = breakerClosed
+			breaker.failures = 0
+		}
+		return
+	}
+	breaker.failures++
+	if breaker.state == breakerHalfOpen || breaker.failures >= breaker.config.GetFailureThreshold() {
+		breaker.state = breakerOpen
+		breaker.openUntil = now.Add(breaker.config.GetOpenDuration())
+	}
+}
+
+// circuitBreakers holds the circuit breakers of the nodes of a client, and finds the node of the commands. It is shared
+// by the client and its views.
+type circuitBreakers struct {
+	config *config.CircuitBreakerConfiguration
+	// address is the address of the primary of a standalone client.
+	address   string
+	lifecycle *lifecycleTracker
+	// topology fetches the slot map of a cluster client, or is nil for a standalone client.
+	topology func(ctx context.Context) (models.ClusterTopology, error)
+
+	mu    sync.Mutex
+	nodes map[string]*circuitBreaker
+	// slots is the slot map of a cluster client, fetched at slotsFetched, after the topology refresh of slotsRefreshed.
+	slots          *models.ClusterTopology
+	slotsFetched   time.Time
+	slotsRefreshed time.Time
+	// fetching serializes the fetches of the slot map.
+	fetching sync.Mutex
+}
+
+func newCircuitBreakers(
+	breaker *config.CircuitBreakerConfiguration,
+	request *protobuf.ConnectionRequest,
+	lifecycle *lifecycleTracker,
+) *circuitBreakers {
+	if breaker == nil {
+		return nil
+	}
+	breakers := &circuitBreakers{config: breaker, lifecycle: lifecycle, nodes: make(map[string]*circuitBreaker)}
+	if len(request.Addresses) > 0 {
+		address := request.Addresses[0]
+		breakers.address = address.UnixSocketPath
+		if address.UnixSocketPath == "" {
+			breakers.address = fmt.Sprintf("%s:%d", address.Host, address.Port)
+		}
+	}
+	return breakers
+}
+
+// node returns the circuit breaker of a node.
+func (breakers *circuitBreakers) node(address string) *circuitBreaker {
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+	breaker, found := breakers.nodes[address]
+	if !found {
+		breaker = &circuitBreaker{address: address, config: breakers.config}
+		breakers.nodes[address] = breaker
+	}
+	return breaker
+}
+
+// forCommand returns the circuit breaker of the node of a command, or nil if the command is not guarded.
+func (breakers *circuitBreakers) forCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) *circuitBreaker {
+	if breakers == nil {
+		return nil
+	}
+	if breakers.topology == nil {
+		return breakers.node(breakers.address)
+	}
+	var slot int32
+	switch route := route.(type) {
+	case nil:
+		key, found := commandKey(requestType, args)
+		if !found {
+			return nil
+		}
+		slot = KeySlot(key)
+	case *config.SlotKeyRoute:
+		slot = KeySlot(route.SlotKey)
+	case *config.SlotIdRoute:
+		slot = route.SlotID
+	case *config.ByAddressRoute:
+		return breakers.node(fmt.Sprintf("%s:%d", route.Host, route.Port))
+	default:
+		return nil
+	}
+	topology := breakers.clusterTopology(ctx)
+	if topology == nil {
+		return nil
+	}
+	shard, found := topology.ShardForSlot(slot)
+	if !found {
+		return nil
+	}
+	return breakers.node(shard.Primary)
+}
+
+// clusterTopology returns the slot map of a cluster client, which is fetched again after a topology refresh, or nil if
+// it could not be fetched.
+func (breakers *circuitBreakers) clusterTopology(ctx context.Context) *models.ClusterTopology {
+	fresh := func() *models.ClusterTopology {
+		breakers.mu.Lock()
+		defer breakers.mu.Unlock()
+		if breakers.slots == nil || breakers.lifecycle.topologyRefreshed().After(breakers.slotsRefreshed) ||
+			time.Since(breakers.slotsFetched) >= breakerTopologyTTL {
+			return nil
+		}
+		return breakers.slots
+	}
+	if topology := fresh(); topology != nil {
+		return topology
+	}
+	breakers.fetching.Lock()
+	defer breakers.fetching.Unlock()
+	if topology := fresh(); topology != nil {
+		return topology
+	}
+	refreshed := breakers.lifecycle.topologyRefreshed()
+	topology, err := breakers.topology(ctx)
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+	if err == nil {
+		breakers.slots = &topology
+		breakers.slotsFetched = time.Now()
+		breakers.slotsRefreshed = refreshed
+	}
+	return breakers.slots
+}
+
+// commandKey returns the first key of a command, if any.
+func commandKey(requestType C.RequestType, args []string) (string, bool) {
+	name := commandName(requestType, args)
+	if requestType == C.CustomCommand {
+		args = args[min(1, len(args)):]
+	}
+	spec, found := keySpecs[name]
+	if !found {
+		return "", false
+	}
+	indexes := spec(args)
+	if len(indexes) == 0 {
+		return "", false
+	}
+	return args[indexes[0]], true
+}
@@ -0,0 +1,81 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := &circuitBreaker{
+		address: "node1:6379",
+		config:  config.NewCircuitBreakerConfiguration().WithFailureThreshold(2).WithOpenDuration(time.Second),
+	}
+	now := time.Unix(1000, 0)
+	timeout := errors.GoError(2, "Request timed out")
+
+	// the failures must be consecutive
+	breaker.record(timeout, now)
+	breaker.record(&errors.RequestError{Msg: "WRONGTYPE Operation against a key"}, now)
+	breaker.record(timeout, now)
+	require.NoError(t, breaker.allow(now))
+	// canceled commands tell nothing about the node
+	breaker.record(context.Canceled, now)
+	require.NoError(t, breaker.allow(now))
+
+	breaker.record(timeout, now)
+	err := breaker.allow(now.Add(400 * time.Millisecond))
+	assert.Equal(t, &config.CircuitOpenError{Address: "node1:6379", RetryAfter: 600 * time.Millisecond}, err)
+
+	// a single command probes the node once the open duration elapsed, and a failed probe opens the breaker again
+	require.NoError(t, breaker.allow(now.Add(time.Second)))
+	assert.IsType(t, &config.CircuitOpenError{}, breaker.allow(now.Add(time.Second)))
+	breaker.record(timeout, now.Add(time.Second))
+	assert.IsType(t, &config.CircuitOpenError{}, breaker.allow(now.Add(1500*time.Millisecond)))
+
+	// a successful probe closes the breaker
+	require.NoError(t, breaker.allow(now.Add(2*time.Second)))
+	breaker.record(nil, now.Add(2*time.Second))
+	require.NoError(t, breaker.allow(now.Add(2*time.Second)))
+	require.NoError(t, breaker.allow(now.Add(2*time.Second)))
+
+	// a nil breaker guards nothing
+	var unguarded *circuitBreaker
+	require.NoError(t, unguarded.allow(now))
+	unguarded.record(timeout, now)
+}
+
+func TestCircuitBreakers_ForCommand(t *testing.T) {
+	request := &protobuf.ConnectionRequest{Addresses: []*protobuf.NodeAddress{{Host: "primary", Port: 6379}}}
+	standalone := newCircuitBreakers(config.NewCircuitBreakerConfiguration(), request, nil)
+	assert.Equal(t, "primary:6379", standalone.forCommand(context.Background(), 0, nil, nil).address)
+	assert.Nil(t, newCircuitBreakers(nil, request, nil))
+
+	fetches := 0
+	cluster := newCircuitBreakers(config.NewCircuitBreakerConfiguration(), request, nil)
+	cluster.topology = func(ctx context.Context) (models.ClusterTopology, error) {
+		fetches++
+		return models.ClusterTopology{Shards: []models.ClusterShard{
+			{Primary: "node1:6379", SlotRanges: []models.SlotRange{{Start: 0, End: 8191}}},
+			{Primary: "node2:6379", SlotRanges: []models.SlotRange{{Start: 8192, End: 16383}}},
+		}}, nil
+	}
+	ctx := context.Background()
+	assert.Equal(t, "node1:6379", cluster.forCommand(ctx, 0, nil, config.NewSlotIdRoute(config.SlotTypePrimary, 100)).address)
+	// 12182 is the slot of "foo"
+	assert.Equal(t, "node2:6379", cluster.forCommand(ctx, 0, nil, config.NewSlotKeyRoute(config.SlotTypeReplica, "foo")).address)
+	assert.Equal(t, "node3:6379", cluster.forCommand(ctx, 0, nil, config.NewByAddressRoute("node3", 6379)).address)
+	assert.Nil(t, cluster.forCommand(ctx, 0, nil, config.AllPrimaries))
+	// the breakers of a node are shared, and the slot map is cached
+	assert.Same(t, cluster.node("node1:6379"), cluster.forCommand(ctx, 0, nil, config.NewSlotIdRoute(config.SlotTypePrimary, 1)))
+	assert.Equal(t, 1, fetches)
+}
@@ -0,0 +1,105 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package codec converts typed values to and from the strings stored in Valkey.
+//
+// A [Codec] encodes values of one type. The package provides codecs for JSON, gob and protocol buffers, and [Funcs]
+// adapts any library with Marshal and Unmarshal functions, such as the msgpack libraries:
+//
+//	msgpackCodec := codec.Funcs[User]{Marshal: msgpack.Marshal, Unmarshal: msgpack.Unmarshal}
+//
+// [TypedKey] and [TypedHash] wrap a client to read and write typed values at a key, so the values are encoded and
+// decoded in a single place:
+//
+//	user := codec.NewTypedKey(client, "user:42", codec.JSON[User]{})
+//	_, err := user.Set(ctx, User{Name: "alice"}, nil)
+//	value, found, err := user.Get(ctx)
+package codec
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec encodes values of type T to strings, and decodes them back.
+type Codec[T any] interface {
+	Encode(value T) (string, error)
+	Decode(data string) (T, error)
+}
+
+// JSON encodes values with encoding/json.
+type JSON[T any] struct{}
+
+func (JSON[T]) Encode(value T) (string, error) {
+	data, err := json.Marshal(value)
+	return string(data), err
+}
+
+func (JSON[T]) Decode(data string) (T, error) {
+	var value T
+	err := json.Unmarshal([]byte(data), &value)
+	return value, err
+}
+
+// Gob encodes values with encoding/gob. Each value is encoded as a separate gob stream, which includes its type
+// information.
+type Gob[T any] struct{}
+
+func (Gob[T]) Encode(value T) (string, error) {
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(value)
+	return buffer.String(), err
+}
+
+func (Gob[T]) Decode(data string) (T, error) {
+	var value T
+	err := gob.NewDecoder(bytes.NewBufferString(data)).Decode(&value)
+	return value, err
+}
+
+// Protobuf encodes protocol buffer messages in their binary wire format. T is the pointer type of a generated message,
+// such as `*pb.User`.
+type Protobuf[T proto.Message] struct{}
+
+func (Protobuf[T]) Encode(value T) (string, error) {
+	data, err := proto.Marshal(value)
+	return string(data), err
+}
+
+func (Protobuf[T]) Decode(data string) (T, error) {
+	var zero T
+	value := zero.ProtoReflect().New().Interface().(T)
+	err := proto.Unmarshal([]byte(data), value)
+	return value, err
+}
+
+// String stores strings as they are.
+type String struct{}
+
+func (String) Encode(value string) (string, error) {
+	return value, nil
+}
+
+func (String) Decode(data string) (string, error) {
+	return data, nil
+}
+
+// Funcs is a codec which encodes values with a pair of Marshal and Unmarshal functions, with the signatures of
+// encoding/json.
+type Funcs[T any] struct {
+	Marshal   func(value any) ([]byte, error)
+	Unmarshal func(data []byte, value any) error
+}
+
+func (funcs Funcs[T]) Encode(value T) (string, error) {
+	data, err := funcs.Marshal(value)
+	return string(data), err
+}
+
+func (funcs Funcs[T]) Decode(data string) (T, error) {
+	var value T
+	err := funcs.Unmarshal([]byte(data), &value)
+	return value, err
+}
@@ -0,0 +1,129 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package codec
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type record struct {
+	Name    string
+	Count   int
+	Created time.Time
+}
+
+func verifyRoundTrip[T any](t *testing.T, codec Codec[T], value T) {
+	encoded, err := codec.Encode(value)
+	require.NoError(t, err)
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, value, decoded)
+}
+
+func TestCodecs(t *testing.T) {
+	value := record{Name: "alice", Count: 3, Created: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+
+	verifyRoundTrip[record](t, JSON[record]{}, value)
+	verifyRoundTrip[record](t, Gob[record]{}, value)
+	verifyRoundTrip[record](t, Funcs[record]{Marshal: json.Marshal, Unmarshal: json.Unmarshal}, value)
+	verifyRoundTrip[string](t, String{}, "plain value")
+
+	encoded, err := JSON[record]{}.Encode(value)
+	require.NoError(t, err)
+	assert.Equal(t, `{"Name":"alice","Count":3,"Created":"2024-05-01T00:00:00Z"}`, encoded)
+
+	_, err = JSON[record]{}.Decode("not json")
+	assert.Error(t, err)
+	_, err = Gob[record]{}.Decode("not gob")
+	assert.Error(t, err)
+}
+
+func TestProtobufCodec(t *testing.T) {
+	codec := Protobuf[*wrapperspb.StringValue]{}
+	encoded, err := codec.Encode(wrapperspb.String("value"))
+	require.NoError(t, err)
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(wrapperspb.String("value"), decoded))
+}
+
+func TestTypedKey(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+	key := NewTypedKey(client, "record", JSON[record]{})
+	assert.Equal(t, "record", key.Key())
+
+	_, found, err := key.Get(ctx)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	set, err := key.Set(ctx, record{Name: "alice"}, nil)
+	require.NoError(t, err)
+	assert.True(t, set)
+
+	value, found, err := key.Get(ctx)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, record{Name: "alice"}, value)
+
+	onlyIfMissing := options.NewSetOptions().SetConditionalSet(constants.OnlyIfDoesNotExist).SetReturnOldValue(true)
+	set, err = key.Set(ctx, record{Name: "bob"}, onlyIfMissing)
+	require.NoError(t, err)
+	assert.False(t, set)
+	assert.True(t, onlyIfMissing.ReturnOldValue)
+
+	expiry := options.NewSetOptions().SetExpiry(options.NewExpiry().SetType(constants.Seconds).SetCount(60))
+	set, err = key.Set(ctx, record{Name: "bob"}, expiry)
+	require.NoError(t, err)
+	assert.True(t, set)
+	ttl, err := client.TTL(ctx, "record")
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), ttl)
+
+	deleted, err := key.Delete(ctx)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	_, err = client.Set(ctx, "record", "not json")
+	require.NoError(t, err)
+	_, _, err = key.Get(ctx)
+	assert.Error(t, err)
+}
+
+func TestTypedHash(t *testing.T) {
+	ctx := context.Background()
+	hash := NewTypedHash(glidetest.NewClusterClient(), "records", Gob[record]{})
+	assert.Equal(t, "records", hash.Key())
+
+	added, err := hash.Set(ctx, map[string]record{"a": {Name: "alice"}, "b": {Name: "bob", Count: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), added)
+
+	value, found, err := hash.Get(ctx, "b")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, record{Name: "bob", Count: 2}, value)
+
+	_, found, err = hash.Get(ctx, "c")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	removed, err := hash.Delete(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), removed)
+
+	all, err := hash.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]record{"b": {Name: "bob", Count: 2}}, all)
+}
@@ -0,0 +1,159 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package codec
+
+import (
+	"context"
+
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// TypedKey reads and writes a value of type T stored as a string at a key.
+type TypedKey[T any] struct {
+	client interfaces.BaseClientCommands
+	key    string
+	codec  Codec[T]
+}
+
+// NewTypedKey creates a typed key. The client may be a standalone or a cluster client.
+func NewTypedKey[T any](client interfaces.BaseClientCommands, key string, codec Codec[T]) *TypedKey[T] {
+	return &TypedKey[T]{client: client, key: key, codec: codec}
+}
+
+// Key returns the name of the key.
+func (typed *TypedKey[T]) Key() string {
+	return typed.key
+}
+
+// Get returns the value stored at the key.
+//
+// Return value:
+//
+//	The decoded value, and false if the key does not exist.
+func (typed *TypedKey[T]) Get(ctx context.Context) (T, bool, error) {
+	var zero T
+	result, err := typed.client.Get(ctx, typed.key)
+	if err != nil || result.IsNil() {
+		return zero, false, err
+	}
+	value, err := typed.codec.Decode(result.Value())
+	if err != nil {
+		return zero, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores the value at the key, with the given options. The options may be nil.
+//
+// Return value:
+//
+//	false if the value was not set because of the conditional set option of the options.
+func (typed *TypedKey[T]) Set(ctx context.Context, value T, opts *options.SetOptions) (bool, error) {
+	data, err := typed.codec.Encode(value)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	if opts == nil {
+		_, err := typed.client.Set(ctx, typed.key, data)
+		return err == nil, err
+	}
+	if opts.ReturnOldValue {
+		// the reply of GET is the old value, which does not tell whether the value was set
+		copied := *opts
+		copied.ReturnOldValue = false
+		opts = &copied
+	}
+	result, err := typed.client.SetWithOptions(ctx, typed.key, data, *opts)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return !result.IsNil(), nil
+}
+
+// Delete removes the key.
+//
+// Return value:
+//
+//	false if the key did not exist.
+func (typed *TypedKey[T]) Delete(ctx context.Context) (bool, error) {
+	deleted, err := typed.client.Del(ctx, []string{typed.key})
+	return deleted > 0, err
+}
+
+// TypedHash reads and writes values of type T stored in the fields of a hash.
+type TypedHash[T any] struct {
+	client interfaces.BaseClientCommands
+	key    string
+	codec  Codec[T]
+}
+
+// NewTypedHash creates a typed hash. The client may be a standalone or a cluster client.
+func NewTypedHash[T any](client interfaces.BaseClientCommands, key string, codec Codec[T]) *TypedHash[T] {
+	return &TypedHash[T]{client: client, key: key, codec: codec}
+}
+
+// Key returns the name of the key of the hash.
+func (typed *TypedHash[T]) Key() string {
+	return typed.key
+}
+
+// Get returns the value of a field of the hash.
+//
+// Return value:
+//
+//	The decoded value, and false if the field or the hash does not exist.
+func (typed *TypedHash[T]) Get(ctx context.Context, field string) (T, bool, error) {
+	var zero T
+	result, err := typed.client.HGet(ctx, typed.key, field)
+	if err != nil || result.IsNil() {
+		return zero, false, err
+	}
+	value, err := typed.codec.Decode(result.Value())
+	if err != nil {
+		return zero, false, err
+	}
+	return value, true, nil
+}
+
+// GetAll returns the values of all the fields of the hash.
+func (typed *TypedHash[T]) GetAll(ctx context.Context) (map[string]T, error) {
+	stored, err := typed.client.HGetAll(ctx, typed.key)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]T, len(stored))
+	for field, data := range stored {
+		if values[field], err = typed.codec.Decode(data); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// Set stores the values in the fields of the hash.
+//
+// Return value:
+//
+//	The number of fields that were added to the hash.
+func (typed *TypedHash[T]) Set(ctx context.Context, values map[string]T) (int64, error) {
+	stored := make(map[string]string, len(values))
+	for field, value := range values {
+		data, err := typed.codec.Encode(value)
+		if err != nil {
+			return models.DefaultIntResponse, err
+		}
+		stored[field] = data
+	}
+	return typed.client.HSet(ctx, typed.key, stored)
+}
+
+// Delete removes fields from the hash.
+//
+// Return value:
+//
+//	The number of fields that were removed from the hash.
+func (typed *TypedHash[T]) Delete(ctx context.Context, fields ...string) (int64, error) {
+	return typed.client.HDel(ctx, typed.key, fields)
+}
@@ -0,0 +1,71 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// CircuitOpenError is returned by the commands sent to a node whose circuit breaker is open. Such commands are not sent to
+// the server.
+type CircuitOpenError struct {
+	// Address is the address of the node, in the "host:port" format.
+	Address string
+	// RetryAfter is the time after which a command is sent to the node again, to probe whether it recovered.
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("the circuit breaker of the node %s is open, retry after %v", e.Address, e.RetryAfter)
+}
+
+// CircuitBreakerConfiguration enables the circuit breakers of a client, which fail the commands sent to a persistently
+// unhealthy node fast with a [CircuitOpenError], instead of letting each of them wait for the request timeout.
+//
+// The circuit breaker of a node opens after consecutive commands sent to it failed with a timeout, a disconnection, or a
+// LOADING or TRYAGAIN error of the server. Once the open duration elapsed, a single command is sent to the node to probe
+// it: the circuit breaker closes if it succeeds, and opens again otherwise. Other errors of the server show that the node
+// is reachable, and close the circuit breaker.
+//
+// In a cluster, the node of a command is the primary serving the slot of its first key, or of its slot route, or the node
+// of its address route, as known by the slot map of the client. Commands without a key or with a route to several or to a
+// random node are not guarded. A standalone client has a single circuit breaker, for its primary. Commands of batches and
+// scripts are not guarded.
+type CircuitBreakerConfiguration struct {
+	failureThreshold int
+	openDuration     time.Duration
+}
+
+// NewCircuitBreakerConfiguration returns a [CircuitBreakerConfiguration] which opens after 5 consecutive failures, for 5
+// seconds.
+func NewCircuitBreakerConfiguration() *CircuitBreakerConfiguration {
+	return &CircuitBreakerConfiguration{failureThreshold: 5, openDuration: 5 * time.Second}
+}
+
+// WithFailureThreshold sets the number of consecutive failures of a node after which its circuit breaker opens.
+func (breaker *CircuitBreakerConfiguration) WithFailureThreshold(failures int) *CircuitBreakerConfiguration {
+	breaker.failureThreshold = failures
+	return breaker
+}
+
+// WithOpenDuration sets the duration for which the commands sent to an unhealthy node fail fast, before it is probed.
+func (breaker *CircuitBreakerConfiguration) WithOpenDuration(duration time.Duration) *CircuitBreakerConfiguration {
+	breaker.openDuration = duration
+	return breaker
+}
+
+// GetFailureThreshold returns the number of consecutive failures of a node after which its circuit breaker opens.
+func (breaker *CircuitBreakerConfiguration) GetFailureThreshold() int {
+	return breaker.failureThreshold
+}
+
+// GetOpenDuration returns the duration for which the commands sent to an unhealthy node fail fast.
+func (breaker *CircuitBreakerConfiguration) GetOpenDuration() time.Duration {
+	return breaker.openDuration
+}
+
+// GetCircuitBreaker returns the circuit breaker configuration of the client, or nil if its nodes are not guarded.
+func (config *baseClientConfiguration) GetCircuitBreaker() *CircuitBreakerConfiguration {
+	return config.circuitBreaker
+}
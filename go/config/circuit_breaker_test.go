@@ -0,0 +1,27 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerConfiguration(t *testing.T) {
+	breaker := NewCircuitBreakerConfiguration()
+	assert.Equal(t, 5, breaker.GetFailureThreshold())
+	assert.Equal(t, 5*time.Second, breaker.GetOpenDuration())
+
+	breaker.WithFailureThreshold(2).WithOpenDuration(time.Second)
+	assert.Equal(t, 2, breaker.GetFailureThreshold())
+	assert.Equal(t, time.Second, breaker.GetOpenDuration())
+
+	assert.Nil(t, NewClientConfiguration().GetCircuitBreaker())
+	assert.Same(t, breaker, NewClientConfiguration().WithCircuitBreaker(breaker).GetCircuitBreaker())
+	assert.Same(t, breaker, NewClusterClientConfiguration().WithCircuitBreaker(breaker).GetCircuitBreaker())
+
+	err := &CircuitOpenError{Address: "node1:6379", RetryAfter: time.Second}
+	assert.Equal(t, "the circuit breaker of the node node1:6379 is open, retry after 1s", err.Error())
+}
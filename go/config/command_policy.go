@@ -0,0 +1,383 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CommandRejectedError is returned by commands and batches rejected by the [CommandPolicy] of a client. Rejected commands
+// are not sent to the server.
+type CommandRejectedError struct {
+	// Command is the name of the rejected command, including the container command for subcommands, for example
+	// "CONFIG SET".
+	Command string
+	// Reason describes the rule which rejected the command.
+	Reason string
+}
+
+func (e *CommandRejectedError) Error() string {
+	return fmt.Sprintf("command %s rejected by the client command policy: %s", e.Command, e.Reason)
+}
+
+// CommandPolicy restricts the commands a client may execute. It is enforced by the client before the commands reach the
+// server, for commands sent via CustomCommand, batches and scripts as well.
+//
+// Commands are identified by their upper-case names, including the container command for subcommands, for example
+// "FLUSHALL" or "CONFIG SET", and categorized by the ACL categories of the server, for example "@write", "@admin" or
+// "@dangerous". Unknown commands have no categories, and are considered as write commands.
+//
+// A command is rejected when:
+//   - the policy is read-only and the command may write, which includes the scripts, except EVAL_RO, EVALSHA_RO and
+//     FCALL_RO,
+//   - the command or one of its categories is denied,
+//   - or commands or categories are allowed, and neither the command nor any of its categories is allowed.
+//
+// Note that read-only mode only rejects commands which write data. Commands such as CONFIG SET or SCRIPT FLUSH should be
+// denied explicitly, for example by denying the "@admin" and "@dangerous" categories.
+type CommandPolicy struct {
+	readOnly          bool
+	allowedCommands   map[string]bool
+	deniedCommands    map[string]bool
+	allowedCategories map[string]bool
+	deniedCategories  map[string]bool
+}
+
+// NewCommandPolicy returns a [CommandPolicy] which allows all the commands.
+func NewCommandPolicy() *CommandPolicy {
+	return &CommandPolicy{
+		allowedCommands:   make(map[string]bool),
+		deniedCommands:    make(map[string]bool),
+		allowedCategories: make(map[string]bool),
+		deniedCategories:  make(map[string]bool),
+	}
+}
+
+// WithReadOnly sets whether commands which may write are rejected.
+func (policy *CommandPolicy) WithReadOnly(readOnly bool) *CommandPolicy {
+	policy.readOnly = readOnly
+	return policy
+}
+
+// WithAllowedCommands adds commands to the allow list, for example "GET" or "CONFIG GET".
+func (policy *CommandPolicy) WithAllowedCommands(commands ...string) *CommandPolicy {
+	for _, command := range commands {
+		policy.allowedCommands[strings.ToUpper(command)] = true
+	}
+	return policy
+}
+
+// WithDeniedCommands adds commands to the deny list, for example "FLUSHALL" or "CONFIG SET".
+func (policy *CommandPolicy) WithDeniedCommands(commands ...string) *CommandPolicy {
+	for _, command := range commands {
+		policy.deniedCommands[strings.ToUpper(command)] = true
+	}
+	return policy
+}
+
+// WithAllowedCategories adds ACL categories to the allow list, for example "@read". The leading "@" is optional.
+func (policy *CommandPolicy) WithAllowedCategories(categories ...string) *CommandPolicy {
+	for _, category := range categories {
+		policy.allowedCategories[normalizeCategory(category)] = true
+	}
+	return policy
+}
+
+// WithDeniedCategories adds ACL categories to the deny list, for example "@dangerous". The leading "@" is optional.
+func (policy *CommandPolicy) WithDeniedCategories(categories ...string) *CommandPolicy {
+	for _, category := range categories {
+		policy.deniedCategories[normalizeCategory(category)] = true
+	}
+	return policy
+}
+
+func normalizeCategory(category string) string {
+	return strings.ToLower(strings.TrimPrefix(category, "@"))
+}
+
+// Check returns a [CommandRejectedError] if the policy rejects the command.
+func (policy *CommandPolicy) Check(request *CommandRequest) error {
+	name := strings.ToUpper(request.Name)
+	if request.Custom && containerCommands[name] && len(request.Args) > 1 {
+		name += " " + strings.ToUpper(request.Args[1])
+	}
+	list, known := commandCategories[name]
+	categories := strings.Fields(list)
+
+	if policy.readOnly && (!known || slices.Contains(categories, "write")) {
+		return &CommandRejectedError{Command: name, Reason: "the client is read-only"}
+	}
+	if policy.deniedCommands[name] {
+		return &CommandRejectedError{Command: name, Reason: "the command is denied"}
+	}
+	for _, category := range categories {
+		if policy.deniedCategories[category] {
+			return &CommandRejectedError{Command: name, Reason: "the category @" + category + " is denied"}
+		}
+	}
+	if (len(policy.allowedCommands) == 0 && len(policy.allowedCategories) == 0) || policy.allowedCommands[name] {
+		return nil
+	}
+	for _, category := range categories {
+		if policy.allowedCategories[category] {
+			return nil
+		}
+	}
+	return &CommandRejectedError{Command: name, Reason: "the command is not allowed"}
+}
+
+// GetCommandPolicy returns the command policy of the configuration, or nil if all the commands are allowed.
+func (config *baseClientConfiguration) GetCommandPolicy() *CommandPolicy {
+	return config.commandPolicy
+}
+
+// containerCommands are the commands whose first argument is a subcommand.
+var containerCommands = map[string]bool{
+	"ACL": true, "CLIENT": true, "CLUSTER": true, "COMMAND": true, "CONFIG": true, "FUNCTION": true, "LATENCY": true,
+	"MEMORY": true, "MODULE": true, "OBJECT": true, "PUBSUB": true, "SCRIPT": true, "SLOWLOG": true, "XGROUP": true,
+	"XINFO": true,
+}
+
+// commandCategories maps the commands to their ACL categories, separated by spaces. Commands with the "write" category
+// may write data.
+var commandCategories = map[string]string{
+	"APPEND":                "write string fast",
+	"BGREWRITEAOF":          "admin slow dangerous",
+	"BGSAVE":                "admin slow dangerous",
+	"BITCOUNT":              "read bitmap slow",
+	"BITFIELD":              "write bitmap slow",
+	"BITFIELD_RO":           "read bitmap fast",
+	"BITOP":                 "write bitmap slow",
+	"BITPOS":                "read bitmap slow",
+	"BLMOVE":                "write list slow blocking",
+	"BLMPOP":                "write list slow blocking",
+	"BLPOP":                 "write list slow blocking",
+	"BRPOP":                 "write list slow blocking",
+	"BRPOPLPUSH":            "write list slow blocking",
+	"BZMPOP":                "write sortedset slow blocking",
+	"BZPOPMAX":              "write sortedset fast blocking",
+	"BZPOPMIN":              "write sortedset fast blocking",
+	"CLIENT GETNAME":        "slow connection",
+	"CLIENT ID":             "slow connection",
+	"CLIENT INFO":           "slow connection",
+	"CLIENT KILL":           "admin slow dangerous connection",
+	"CLIENT LIST":           "admin slow dangerous connection",
+	"CLIENT PAUSE":          "admin slow dangerous connection",
+	"CLIENT SETNAME":        "slow connection",
+	"CLUSTER INFO":          "slow",
+	"CLUSTER NODES":         "slow",
+	"CLUSTER SHARDS":        "slow",
+	"CLUSTER SLOTS":         "slow",
+	"CONFIG GET":            "admin slow dangerous",
+	"CONFIG RESETSTAT":      "admin slow dangerous",
+	"CONFIG REWRITE":        "admin slow dangerous",
+	"CONFIG SET":            "admin slow dangerous",
+	"COPY":                  "keyspace write slow",
+	"DBSIZE":                "keyspace read fast",
+	"DEBUG":                 "admin slow dangerous",
+	"DECR":                  "write string fast",
+	"DECRBY":                "write string fast",
+	"DEL":                   "keyspace write slow",
+	"DISCARD":               "fast transaction",
+	"DUMP":                  "keyspace read slow",
+	"ECHO":                  "fast connection",
+	"EVAL":                  "write slow scripting",
+	"EVAL_RO":               "slow scripting",
+	"EVALSHA":               "write slow scripting",
+	"EVALSHA_RO":            "slow scripting",
+	"EXEC":                  "slow transaction",
+	"EXISTS":                "keyspace read fast",
+	"EXPIRE":                "keyspace write fast",
+	"EXPIREAT":              "keyspace write fast",
+	"EXPIRETIME":            "keyspace read fast",
+	"FAILOVER":              "admin slow dangerous",
+	"FCALL":                 "write slow scripting",
+	"FCALL_RO":              "slow scripting",
+	"FLUSHALL":              "keyspace write slow dangerous",
+	"FLUSHDB":               "keyspace write slow dangerous",
+	"FUNCTION DELETE":       "write slow scripting",
+	"FUNCTION DUMP":         "slow scripting",
+	"FUNCTION FLUSH":        "write slow scripting",
+	"FUNCTION KILL":         "slow scripting",
+	"FUNCTION LIST":         "slow scripting",
+	"FUNCTION LOAD":         "write slow scripting",
+	"FUNCTION RESTORE":      "write slow scripting",
+	"FUNCTION STATS":        "slow scripting",
+	"GEOADD":                "write geo slow",
+	"GEODIST":               "read geo slow",
+	"GEOHASH":               "read geo slow",
+	"GEOPOS":                "read geo slow",
+	"GEOSEARCH":             "read geo slow",
+	"GEOSEARCHSTORE":        "write geo slow",
+	"GET":                   "read string fast",
+	"GETBIT":                "read bitmap fast",
+	"GETDEL":                "write string fast",
+	"GETEX":                 "write string fast",
+	"GETRANGE":              "read string slow",
+	"HDEL":                  "write hash fast",
+	"HEXISTS":               "read hash fast",
+	"HGET":                  "read hash fast",
+	"HGETALL":               "read hash slow",
+	"HINCRBY":               "write hash fast",
+	"HINCRBYFLOAT":          "write hash fast",
+	"HKEYS":                 "read hash slow",
+	"HLEN":                  "read hash fast",
+	"HMGET":                 "read hash fast",
+	"HRANDFIELD":            "read hash slow",
+	"HSCAN":                 "read hash slow",
+	"HSET":                  "write hash fast",
+	"HSETNX":                "write hash fast",
+	"HSTRLEN":               "read hash fast",
+	"HVALS":                 "read hash slow",
+	"INCR":                  "write string fast",
+	"INCRBY":                "write string fast",
+	"INCRBYFLOAT":           "write string fast",
+	"INFO":                  "slow dangerous",
+	"KEYS":                  "keyspace read slow dangerous",
+	"LASTSAVE":              "fast dangerous",
+	"LCS":                   "read string slow",
+	"LINDEX":                "read list slow",
+	"LINSERT":               "write list slow",
+	"LLEN":                  "read list fast",
+	"LMOVE":                 "write list slow",
+	"LMPOP":                 "write list slow",
+	"LOLWUT":                "read fast",
+	"LPOP":                  "write list fast",
+	"LPOS":                  "read list slow",
+	"LPUSH":                 "write list fast",
+	"LPUSHX":                "write list fast",
+	"LRANGE":                "read list slow",
+	"LREM":                  "write list slow",
+	"LSET":                  "write list slow",
+	"LTRIM":                 "write list slow",
+	"MGET":                  "read string fast",
+	"MIGRATE":               "keyspace write slow dangerous",
+	"MONITOR":               "admin slow dangerous",
+	"MOVE":                  "keyspace write fast",
+	"MSET":                  "write string slow",
+	"MSETNX":                "write string slow",
+	"MULTI":                 "fast transaction",
+	"OBJECT ENCODING":       "keyspace read slow",
+	"OBJECT FREQ":           "keyspace read slow",
+	"OBJECT IDLETIME":       "keyspace read slow",
+	"OBJECT REFCOUNT":       "keyspace read slow",
+	"PERSIST":               "keyspace write fast",
+	"PEXPIRE":               "keyspace write fast",
+	"PEXPIREAT":             "keyspace write fast",
+	"PEXPIRETIME":           "keyspace read fast",
+	"PFADD":                 "write hyperloglog fast",
+	"PFCOUNT":               "read hyperloglog slow",
+	"PFMERGE":               "write hyperloglog slow",
+	"PING":                  "fast connection",
+	"PSETEX":                "write string slow",
+	"PTTL":                  "keyspace read fast",
+	"PUBLISH":               "pubsub fast",
+	"PUBSUB CHANNELS":       "pubsub slow",
+	"PUBSUB NUMPAT":         "pubsub slow",
+	"PUBSUB NUMSUB":         "pubsub slow",
+	"PUBSUB SHARDCHANNELS":  "pubsub slow",
+	"PUBSUB SHARDNUMSUB":    "pubsub slow",
+	"RANDOMKEY":             "keyspace read slow",
+	"RENAME":                "keyspace write slow",
+	"RENAMENX":              "keyspace write fast",
+	"REPLICAOF":             "admin slow dangerous",
+	"RESTORE":               "keyspace write slow dangerous",
+	"RPOP":                  "write list fast",
+	"RPOPLPUSH":             "write list slow",
+	"RPUSH":                 "write list fast",
+	"RPUSHX":                "write list fast",
+	"SADD":                  "write set fast",
+	"SAVE":                  "admin slow dangerous",
+	"SCAN":                  "keyspace read slow",
+	"SCARD":                 "read set fast",
+	"SCRIPT EXISTS":         "slow scripting",
+	"SCRIPT FLUSH":          "slow scripting",
+	"SCRIPT KILL":           "slow scripting",
+	"SCRIPT LOAD":           "slow scripting",
+	"SCRIPT SHOW":           "slow scripting",
+	"SDIFF":                 "read set slow",
+	"SDIFFSTORE":            "write set slow",
+	"SELECT":                "fast connection",
+	"SET":                   "write string slow",
+	"SETBIT":                "write bitmap slow",
+	"SETEX":                 "write string slow",
+	"SETNX":                 "write string fast",
+	"SETRANGE":              "write string slow",
+	"SHUTDOWN":              "admin slow dangerous",
+	"SINTER":                "read set slow",
+	"SINTERCARD":            "read set slow",
+	"SINTERSTORE":           "write set slow",
+	"SISMEMBER":             "read set fast",
+	"SLAVEOF":               "admin slow dangerous",
+	"SMEMBERS":              "read set slow",
+	"SMISMEMBER":            "read set fast",
+	"SMOVE":                 "write set fast",
+	"SORT":                  "write set sortedset list slow dangerous",
+	"SORT_RO":               "read set sortedset list slow dangerous",
+	"SPOP":                  "write set fast",
+	"SPUBLISH":              "pubsub fast",
+	"SRANDMEMBER":           "read set slow",
+	"SREM":                  "write set fast",
+	"SSCAN":                 "read set slow",
+	"STRLEN":                "read string fast",
+	"SUNION":                "read set slow",
+	"SUNIONSTORE":           "write set slow",
+	"SWAPDB":                "keyspace write fast dangerous",
+	"TIME":                  "fast",
+	"TOUCH":                 "keyspace read fast",
+	"TTL":                   "keyspace read fast",
+	"TYPE":                  "keyspace read fast",
+	"UNLINK":                "keyspace write fast",
+	"UNWATCH":               "fast transaction",
+	"WAIT":                  "slow connection",
+	"WATCH":                 "fast transaction",
+	"XACK":                  "write stream fast",
+	"XADD":                  "write stream fast",
+	"XAUTOCLAIM":            "write stream fast",
+	"XCLAIM":                "write stream fast",
+	"XDEL":                  "write stream fast",
+	"XGROUP CREATE":         "write stream slow",
+	"XGROUP CREATECONSUMER": "write stream slow",
+	"XGROUP DELCONSUMER":    "write stream slow",
+	"XGROUP DESTROY":        "write stream slow",
+	"XGROUP SETID":          "write stream slow",
+	"XINFO CONSUMERS":       "read stream slow",
+	"XINFO GROUPS":          "read stream slow",
+	"XINFO STREAM":          "read stream slow",
+	"XLEN":                  "read stream fast",
+	"XPENDING":              "read stream slow",
+	"XRANGE":                "read stream slow",
+	"XREAD":                 "read stream slow blocking",
+	"XREADGROUP":            "write stream slow blocking",
+	"XREVRANGE":             "read stream slow",
+	"XTRIM":                 "write stream slow",
+	"ZADD":                  "write sortedset fast",
+	"ZCARD":                 "read sortedset fast",
+	"ZCOUNT":                "read sortedset fast",
+	"ZDIFF":                 "read sortedset slow",
+	"ZDIFFSTORE":            "write sortedset slow",
+	"ZINCRBY":               "write sortedset fast",
+	"ZINTER":                "read sortedset slow",
+	"ZINTERCARD":            "read sortedset slow",
+	"ZINTERSTORE":           "write sortedset slow",
+	"ZLEXCOUNT":             "read sortedset fast",
+	"ZMPOP":                 "write sortedset slow",
+	"ZMSCORE":               "read sortedset fast",
+	"ZPOPMAX":               "write sortedset fast",
+	"ZPOPMIN":               "write sortedset fast",
+	"ZRANDMEMBER":           "read sortedset slow",
+	"ZRANGE":                "read sortedset slow",
+	"ZRANGESTORE":           "write sortedset slow",
+	"ZRANK":                 "read sortedset fast",
+	"ZREM":                  "write sortedset fast",
+	"ZREMRANGEBYLEX":        "write sortedset slow",
+	"ZREMRANGEBYRANK":       "write sortedset slow",
+	"ZREMRANGEBYSCORE":      "write sortedset slow",
+	"ZREVRANK":              "read sortedset fast",
+	"ZSCAN":                 "read sortedset slow",
+	"ZSCORE":                "read sortedset fast",
+	"ZUNION":                "read sortedset slow",
+	"ZUNIONSTORE":           "write sortedset slow",
+}
@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkCommand(policy *CommandPolicy, name string, args ...string) error {
+	return policy.Check(&CommandRequest{Name: name, Args: args})
+}
+
+func checkCustomCommand(policy *CommandPolicy, args ...string) error {
+	return policy.Check(&CommandRequest{Name: args[0], Args: args, Custom: true})
+}
+
+func TestReadOnlyPolicy(t *testing.T) {
+	policy := NewCommandPolicy().WithReadOnly(true)
+
+	assert.NoError(t, checkCommand(policy, "GET", "key"))
+	assert.NoError(t, checkCommand(policy, "CONFIG GET", "maxmemory"))
+	assert.NoError(t, checkCommand(policy, "FCALL_RO", "fn", "0"))
+	assert.NoError(t, checkCustomCommand(policy, "GET", "key"))
+	assert.NoError(t, checkCustomCommand(policy, "object", "encoding", "key"))
+
+	for _, name := range []string{"SET", "FLUSHALL", "FLUSHDB", "FUNCTION FLUSH", "EVALSHA", "XREADGROUP"} {
+		err := checkCommand(policy, name)
+		var rejected *CommandRejectedError
+		require.ErrorAs(t, err, &rejected, name)
+		assert.Equal(t, name, rejected.Command)
+	}
+	assert.Error(t, checkCustomCommand(policy, "FUNCTION", "flush"))
+	// unknown commands are considered as write commands
+	assert.Error(t, checkCustomCommand(policy, "MODULE.COMMAND", "key"))
+}
+
+func TestDeniedCommandsAndCategories(t *testing.T) {
+	policy := NewCommandPolicy().WithDeniedCommands("flushall", "CONFIG SET").WithDeniedCategories("@scripting", "admin")
+
+	assert.NoError(t, checkCommand(policy, "SET", "key", "value"))
+	assert.NoError(t, checkCommand(policy, "FLUSHDB"))
+	assert.NoError(t, checkCustomCommand(policy, "MODULE.COMMAND"))
+
+	err := checkCommand(policy, "FLUSHALL")
+	var rejected *CommandRejectedError
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, "FLUSHALL", rejected.Command)
+	assert.Equal(t, "command FLUSHALL rejected by the client command policy: the command is denied", err.Error())
+
+	err = checkCustomCommand(policy, "CONFIG", "set", "maxmemory", "0")
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, "CONFIG SET", rejected.Command)
+
+	err = checkCommand(policy, "FUNCTION FLUSH")
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, "the category @scripting is denied", rejected.Reason)
+	assert.Error(t, checkCommand(policy, "SHUTDOWN"))
+}
+
+func TestAllowedCommandsAndCategories(t *testing.T) {
+	policy := NewCommandPolicy().WithAllowedCategories("read").WithAllowedCommands("SET", "PING")
+
+	assert.NoError(t, checkCommand(policy, "GET", "key"))
+	assert.NoError(t, checkCommand(policy, "SET", "key", "value"))
+	assert.NoError(t, checkCustomCommand(policy, "ping"))
+	assert.Error(t, checkCommand(policy, "DEL", "key"))
+	assert.Error(t, checkCustomCommand(policy, "MODULE.COMMAND"))
+
+	// denials take precedence over allowances
+	policy.WithDeniedCommands("GET")
+	assert.Error(t, checkCommand(policy, "GET", "key"))
+}
+
+func TestWithCommandPolicy(t *testing.T) {
+	policy := NewCommandPolicy()
+	assert.Nil(t, NewClientConfiguration().GetCommandPolicy())
+	assert.Same(t, policy, NewClientConfiguration().WithCommandPolicy(policy).GetCommandPolicy())
+	assert.Same(t, policy, NewClusterClientConfiguration().WithCommandPolicy(policy).GetCommandPolicy())
+}
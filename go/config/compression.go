@@ -0,0 +1,287 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"cmp"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"sync/atomic"
+)
+
+// compressionMagic starts every compressed value, followed by the ID of the [Compressor]. Values which do not start with
+// it, such as values written before compression was enabled, are returned as they are.
+const compressionMagic = "\x00\xc7"
+
+// Compressor is a compression algorithm used by [CompressionConfiguration].
+type Compressor interface {
+	// ID identifies the algorithm in the header of the compressed values. IDs below 16 are reserved for the compressors of
+	// this package.
+	ID() byte
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+// GzipCompressor compresses values with gzip. A zero Level uses the default compression level.
+type GzipCompressor struct {
+	Level int
+}
+
+func (GzipCompressor) ID() byte {
+	return 1
+}
+
+func (compressor GzipCompressor) Compress(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buffer, cmp.Or(compressor.Level, gzip.DefaultCompression))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	return buffer.Bytes(), err
+}
+
+func (GzipCompressor) Decompress(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// FlateCompressor compresses values with raw DEFLATE, which has a smaller header than gzip. A zero Level uses the default
+// compression level.
+type FlateCompressor struct {
+	Level int
+}
+
+func (FlateCompressor) ID() byte {
+	return 2
+}
+
+func (compressor FlateCompressor) Compress(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer, err := flate.NewWriter(&buffer, cmp.Or(compressor.Level, flate.DefaultCompression))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	return buffer.Bytes(), err
+}
+
+func (FlateCompressor) Decompress(data []byte) ([]byte, error) {
+	reader := flate.NewReader(bytes.NewReader(data))
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// CompressionStatistics reports the activity of a [CompressionConfiguration].
+type CompressionStatistics struct {
+	// CompressedValues is the number of values stored compressed.
+	CompressedValues int64
+	// SkippedValues is the number of values above the threshold which were stored uncompressed, because compressing them
+	// did not reduce their size.
+	SkippedValues int64
+	// UncompressedBytes is the original size of the values stored compressed.
+	UncompressedBytes int64
+	// CompressedBytes is the stored size of the values stored compressed, including their header.
+	CompressedBytes int64
+	// DecompressedValues is the number of compressed values read.
+	DecompressedValues int64
+	// DecompressionErrors is the number of values with a compression header which could not be decompressed. Such values
+	// are returned as they are.
+	DecompressionErrors int64
+}
+
+// SavedBytes returns the number of bytes saved by compression.
+func (statistics CompressionStatistics) SavedBytes() int64 {
+	return statistics.UncompressedBytes - statistics.CompressedBytes
+}
+
+// CompressionConfiguration enables the transparent compression of values. Values larger than the threshold are
+// compressed when written by SET, MSET, MSETNX, HSET and HSETNX, and decompressed when read by GET, GETEX, GETDEL, MGET,
+// HGET, HMGET, HGETALL and HVALS, including in batches. Compressed values start with a header which identifies the
+// algorithm, so values written uncompressed still read correctly, and values compressed with [GzipCompressor] or
+// [FlateCompressor] can be read whichever compressor is configured.
+//
+// Compression is implemented as a [Middleware], registered in the order of the call to WithCompression. Commands sent via
+// CustomCommand are not affected.
+type CompressionConfiguration struct {
+	compressor    Compressor
+	decompressors map[byte]Compressor
+	threshold     int
+
+	compressedValues    atomic.Int64
+	skippedValues       atomic.Int64
+	uncompressedBytes   atomic.Int64
+	compressedBytes     atomic.Int64
+	decompressedValues  atomic.Int64
+	decompressionErrors atomic.Int64
+}
+
+// NewCompressionConfiguration returns a [CompressionConfiguration] which compresses values of 1024 bytes or more with
+// gzip.
+func NewCompressionConfiguration() *CompressionConfiguration {
+	config := &CompressionConfiguration{threshold: 1024, decompressors: make(map[byte]Compressor)}
+	config.WithCompressor(FlateCompressor{})
+	config.WithCompressor(GzipCompressor{})
+	return config
+}
+
+// WithCompressor sets the algorithm used to compress values. Values compressed by previously set compressors can still be
+// decompressed.
+func (config *CompressionConfiguration) WithCompressor(compressor Compressor) *CompressionConfiguration {
+	config.compressor = compressor
+	config.decompressors[compressor.ID()] = compressor
+	return config
+}
+
+// WithThreshold sets the size, in bytes, from which values are compressed.
+func (config *CompressionConfiguration) WithThreshold(threshold int) *CompressionConfiguration {
+	config.threshold = threshold
+	return config
+}
+
+// Statistics returns the statistics of the compression of the clients configured with this configuration.
+func (config *CompressionConfiguration) Statistics() CompressionStatistics {
+	return CompressionStatistics{
+		CompressedValues:    config.compressedValues.Load(),
+		SkippedValues:       config.skippedValues.Load(),
+		UncompressedBytes:   config.uncompressedBytes.Load(),
+		CompressedBytes:     config.compressedBytes.Load(),
+		DecompressedValues:  config.decompressedValues.Load(),
+		DecompressionErrors: config.decompressionErrors.Load(),
+	}
+}
+
+// compress returns the stored form of a value. Values below the threshold, or which do not shrink, are stored as they
+// are.
+func (config *CompressionConfiguration) compress(value string) string {
+	if len(value) < config.threshold {
+		return value
+	}
+	compressed, err := config.compressor.Compress([]byte(value))
+	if err != nil || len(compressed)+len(compressionMagic)+1 >= len(value) {
+		config.skippedValues.Add(1)
+		return value
+	}
+	stored := compressionMagic + string(config.compressor.ID()) + string(compressed)
+	config.compressedValues.Add(1)
+	config.uncompressedBytes.Add(int64(len(value)))
+	config.compressedBytes.Add(int64(len(stored)))
+	return stored
+}
+
+// decompress returns the original form of a stored value.
+func (config *CompressionConfiguration) decompress(stored string) string {
+	if len(stored) <= len(compressionMagic) || !strings.HasPrefix(stored, compressionMagic) {
+		return stored
+	}
+	compressor, found := config.decompressors[stored[len(compressionMagic)]]
+	if !found {
+		config.decompressionErrors.Add(1)
+		return stored
+	}
+	value, err := compressor.Decompress([]byte(stored[len(compressionMagic)+1:]))
+	if err != nil {
+		config.decompressionErrors.Add(1)
+		return stored
+	}
+	config.decompressedValues.Add(1)
+	return string(value)
+}
+
+// compressedArgs returns the indexes of the arguments holding values, for the commands which write values.
+func compressedArgs(request *CommandRequest) (first int, step int) {
+	if request.Custom {
+		return -1, 0
+	}
+	switch request.Name {
+	case "SET":
+		return 1, len(request.Args)
+	case "MSET", "MSETNX":
+		return 1, 2
+	case "HSET":
+		return 2, 2
+	case "HSETNX":
+		return 2, len(request.Args)
+	}
+	return -1, 0
+}
+
+// decompressedResult reports whether the result of the command holds values.
+func decompressedResult(request *CommandRequest) bool {
+	switch request.Name {
+	case "SET", "GET", "GETEX", "GETDEL", "MGET", "HGET", "HMGET", "HGETALL", "HVALS":
+		return !request.Custom
+	}
+	return false
+}
+
+func (config *CompressionConfiguration) compressArgs(request *CommandRequest) {
+	first, step := compressedArgs(request)
+	if first < 0 {
+		return
+	}
+	for i := first; i < len(request.Args); i += step {
+		request.Args[i] = config.compress(request.Args[i])
+	}
+}
+
+func (config *CompressionConfiguration) decompressValue(value any) any {
+	switch value := value.(type) {
+	case string:
+		return config.decompress(value)
+	case []any:
+		for i, item := range value {
+			if text, ok := item.(string); ok {
+				value[i] = config.decompress(text)
+			}
+		}
+	case map[string]any:
+		for key, item := range value {
+			if text, ok := item.(string); ok {
+				value[key] = config.decompress(text)
+			}
+		}
+	}
+	return value
+}
+
+func (config *CompressionConfiguration) middleware() *Middleware {
+	return &Middleware{
+		BeforeCommand: func(ctx context.Context, request *CommandRequest) *CommandResult {
+			config.compressArgs(request)
+			return nil
+		},
+		AfterCommand: func(ctx context.Context, request *CommandRequest, result *CommandResult) {
+			if result.Err == nil && decompressedResult(request) {
+				result.Value = config.decompressValue(result.Value)
+			}
+		},
+		BeforeBatch: func(ctx context.Context, request *BatchRequest) *BatchResult {
+			for i := range request.Commands {
+				config.compressArgs(&request.Commands[i])
+			}
+			return nil
+		},
+		AfterBatch: func(ctx context.Context, request *BatchRequest, result *BatchResult) {
+			for i, value := range result.Values {
+				if i < len(request.Commands) && decompressedResult(&request.Commands[i]) {
+					result.Values[i] = config.decompressValue(value)
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	value := strings.Repeat(`{"name":"value"}`, 100)
+	for _, compressor := range []Compressor{GzipCompressor{}, FlateCompressor{Level: 9}} {
+		config := NewCompressionConfiguration().WithCompressor(compressor)
+
+		stored := config.compress(value)
+		assert.True(t, strings.HasPrefix(stored, compressionMagic+string(compressor.ID())))
+		assert.Less(t, len(stored), len(value))
+		assert.Equal(t, value, config.decompress(stored))
+	}
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	config := NewCompressionConfiguration().WithThreshold(100)
+
+	assert.Equal(t, strings.Repeat("a", 99), config.compress(strings.Repeat("a", 99)))
+	assert.NotEqual(t, strings.Repeat("a", 100), config.compress(strings.Repeat("a", 100)))
+
+	// values which do not shrink are stored uncompressed
+	random := make([]byte, 200)
+	rand.New(rand.NewSource(1)).Read(random)
+	incompressible := string(random)
+	assert.Equal(t, incompressible, config.compress(incompressible))
+	assert.Equal(t, int64(1), config.Statistics().SkippedValues)
+}
+
+func TestDecompressionOfOtherValues(t *testing.T) {
+	config := NewCompressionConfiguration().WithCompressor(FlateCompressor{})
+	gzipped := NewCompressionConfiguration().compress(strings.Repeat("value", 1000))
+
+	// values compressed with another known compressor
+	assert.Equal(t, strings.Repeat("value", 1000), config.decompress(gzipped))
+
+	// legacy and corrupted values are returned as they are
+	assert.Equal(t, "legacy", config.decompress("legacy"))
+	assert.Equal(t, compressionMagic, config.decompress(compressionMagic))
+	assert.Equal(t, compressionMagic+"\x01corrupted", config.decompress(compressionMagic+"\x01corrupted"))
+	assert.Equal(t, compressionMagic+"\x7funknown", config.decompress(compressionMagic+"\x7funknown"))
+	assert.Equal(t, int64(2), config.Statistics().DecompressionErrors)
+}
+
+func TestCompressionStatistics(t *testing.T) {
+	config := NewCompressionConfiguration()
+	value := strings.Repeat("value", 1000)
+
+	stored := config.compress(value)
+	config.decompress(stored)
+	config.decompress("small")
+
+	statistics := config.Statistics()
+	assert.Equal(t, int64(1), statistics.CompressedValues)
+	assert.Equal(t, int64(len(value)), statistics.UncompressedBytes)
+	assert.Equal(t, int64(len(stored)), statistics.CompressedBytes)
+	assert.Equal(t, int64(len(value)-len(stored)), statistics.SavedBytes())
+	assert.Equal(t, int64(1), statistics.DecompressedValues)
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	ctx := context.Background()
+	config := NewCompressionConfiguration().WithThreshold(10)
+	middleware := config.middleware()
+	value := strings.Repeat("value", 100)
+	stored := config.compress(value)
+
+	commands := []struct {
+		request  CommandRequest
+		expected []string
+	}{
+		{CommandRequest{Name: "SET", Args: []string{"key", value, "EX", "10"}}, []string{"key", stored, "EX", "10"}},
+		{CommandRequest{Name: "MSET", Args: []string{"k1", value, "k2", "short"}}, []string{"k1", stored, "k2", "short"}},
+		{CommandRequest{Name: "HSET", Args: []string{"key", "f1", value, "f2", value}}, []string{"key", "f1", stored, "f2", stored}},
+		{CommandRequest{Name: "HSETNX", Args: []string{"key", "field", value}}, []string{"key", "field", stored}},
+		{CommandRequest{Name: "LPUSH", Args: []string{"key", value}}, []string{"key", value}},
+		{CommandRequest{Name: "SET", Args: []string{"SET", "key", value}, Custom: true}, []string{"SET", "key", value}},
+	}
+	for _, command := range commands {
+		assert.Nil(t, middleware.BeforeCommand(ctx, &command.request))
+		assert.Equal(t, command.expected, command.request.Args, command.request.Name)
+	}
+
+	results := []struct {
+		request  CommandRequest
+		value    any
+		expected any
+	}{
+		{CommandRequest{Name: "GET"}, stored, value},
+		{CommandRequest{Name: "SET"}, "OK", "OK"},
+		{CommandRequest{Name: "MGET"}, []any{stored, nil, "plain"}, []any{value, nil, "plain"}},
+		{CommandRequest{Name: "HGETALL"}, map[string]any{"field": stored}, map[string]any{"field": value}},
+		{CommandRequest{Name: "LRANGE"}, []any{stored}, []any{stored}},
+	}
+	for _, command := range results {
+		result := &CommandResult{Value: command.value}
+		middleware.AfterCommand(ctx, &command.request, result)
+		assert.Equal(t, command.expected, result.Value, command.request.Name)
+	}
+
+	batch := &BatchRequest{Commands: []CommandRequest{
+		{Name: "SET", Args: []string{"key", value}},
+		{Name: "GET", Args: []string{"key"}},
+	}}
+	assert.Nil(t, middleware.BeforeBatch(ctx, batch))
+	assert.Equal(t, []string{"key", stored}, batch.Commands[0].Args)
+	batchResult := &BatchResult{Values: []any{"OK", stored}}
+	middleware.AfterBatch(ctx, batch, batchResult)
+	assert.Equal(t, []any{"OK", value}, batchResult.Values)
+}
+
+func TestWithCompression(t *testing.T) {
+	compression := NewCompressionConfiguration()
+	assert.Len(t, NewClientConfiguration().WithCompression(compression).GetMiddlewares(), 1)
+	clusterConfig := NewClusterClientConfiguration().WithMiddleware(&Middleware{}).WithCompression(compression)
+	require.Len(t, clusterConfig.GetMiddlewares(), 2)
+	assert.NotNil(t, clusterConfig.GetMiddlewares()[1].BeforeCommand)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/utils"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
 )
 
 const (
@@ -16,13 +17,34 @@ const (
 	DefaultPort = 6379
 )
 
-// NodeAddress represents the host address and port of a node in the cluster.
+// NodeAddress represents the host address and port of a node in the cluster, or the path of the Unix domain socket of a
+// standalone server.
 type NodeAddress struct {
 	Host string // If not supplied, api.DefaultHost will be used.
 	Port int    // If not supplied, api.DefaultPort will be used.
+	// The path of the Unix domain socket of the server, as set by its unixsocket setting. When supplied, Host and Port are
+	// ignored.
+	UnixSocketPath string
+}
+
+// NewUnixSocketAddress creates the address of a standalone server listening on a Unix domain socket.
+//
+// Parameters:
+//
+//	path - The path of the socket, as set by the unixsocket setting of the server.
+//
+// Returns:
+//
+//	A new NodeAddress instance.
+func NewUnixSocketAddress(path string) *NodeAddress {
+	return &NodeAddress{UnixSocketPath: path}
 }
 
 func (addr *NodeAddress) toProtobuf() *protobuf.NodeAddress {
+	if addr.UnixSocketPath != "" {
+		return &protobuf.NodeAddress{UnixSocketPath: addr.UnixSocketPath}
+	}
+
 	if addr.Host == "" {
 		addr.Host = DefaultHost
 	}
@@ -92,6 +114,19 @@ func mapReadFrom(readFrom ReadFrom) protobuf.ReadFrom {
 	return protobuf.ReadFrom_Primary
 }
 
+// ConnectionEventListener is called on the connection lifecycle events of a client.
+type ConnectionEventListener func(event models.ConnectionEvent)
+
+// ProtocolVersion represents the serialization protocol used to communicate with the servers.
+type ProtocolVersion int
+
+const (
+	// RESP3 - The RESP3 protocol, which is used by default.
+	RESP3 ProtocolVersion = iota
+	// RESP2 - The RESP2 protocol, for servers or proxies which do not support RESP3.
+	RESP2
+)
+
 type baseClientConfiguration struct {
 	addresses         []NodeAddress
 	useTLS            bool
@@ -100,12 +135,34 @@ type baseClientConfiguration struct {
 	requestTimeout    time.Duration
 	clientName        string
 	clientAZ          string
+	protocol          ProtocolVersion
 	reconnectStrategy *BackoffStrategy
+	middlewares       []*Middleware
+	commandPolicy     *CommandPolicy
+	blockingConns     int
+	eventListener     ConnectionEventListener
+	hedging           *HedgingConfiguration
+	retryPolicy       *RetryPolicy
+	circuitBreaker    *CircuitBreakerConfiguration
+}
+
+// GetEventListener returns the listener of the connection lifecycle events of the client, or nil.
+func (config *baseClientConfiguration) GetEventListener() ConnectionEventListener {
+	return config.eventListener
+}
+
+// GetBlockingConnections returns the maximum number of dedicated connections of blocking commands, or 0 if blocking
+// commands are sent over the shared connection.
+func (config *baseClientConfiguration) GetBlockingConnections() int {
+	return config.blockingConns
 }
 
 func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest, error) {
 	request := protobuf.ConnectionRequest{}
 	for _, address := range config.addresses {
+		if address.UnixSocketPath != "" && config.useTLS {
+			return nil, errors.New("TLS is not supported over Unix domain sockets")
+		}
 		request.Addresses = append(request.Addresses, address.toProtobuf())
 	}
 
@@ -136,6 +193,10 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.ClientAz = config.clientAZ
 	}
 
+	if config.protocol == RESP2 {
+		request.Protocol = protobuf.ProtocolVersion_RESP2
+	}
+
 	if request.ReadFrom == protobuf.ReadFrom_AZAffinity ||
 		request.ReadFrom == protobuf.ReadFrom_AZAffinityReplicasAndPrimary {
 		if config.clientAZ == "" {
@@ -210,6 +271,7 @@ type ClientConfiguration struct {
 	baseClientConfiguration
 	databaseId         int
 	subscriptionConfig *StandaloneSubscriptionConfig
+	sentinel           *SentinelConfiguration
 	AdvancedClientConfiguration
 }
 
@@ -249,6 +311,9 @@ func (config *ClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequest, er
 // the client will attempt to map out the cluster and find all nodes. If the server is in standalone mode, only nodes whose
 // addresses were provided will be used by the client.
 //
+// A server listening on a Unix domain socket is addressed with [NewUnixSocketAddress], over which the client reconnects
+// with the same backoff strategy as over TCP. TLS is not supported over Unix domain sockets.
+//
 // For example:
 //
 //	config := NewGlideClientConfiguration().
@@ -307,6 +372,12 @@ func (config *ClientConfiguration) WithClientAZ(clientAZ string) *ClientConfigur
 	return config
 }
 
+// WithProtocol sets the [ProtocolVersion] used to communicate with the servers. If not set, [RESP3] will be used.
+func (config *ClientConfiguration) WithProtocol(protocol ProtocolVersion) *ClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithReconnectStrategy sets the [BackoffStrategy] used to determine how and when to reconnect, in case of connection
 // failures. If not set, a default backoff strategy will be used.
 func (config *ClientConfiguration) WithReconnectStrategy(strategy *BackoffStrategy) *ClientConfiguration {
@@ -314,6 +385,85 @@ func (config *ClientConfiguration) WithReconnectStrategy(strategy *BackoffStrate
 	return config
 }
 
+// WithMiddleware registers a [Middleware] which intercepts the commands and batches executed by the client. WithMiddleware
+// can be called multiple times to register multiple middlewares; they are applied in registration order.
+func (config *ClientConfiguration) WithMiddleware(middleware *Middleware) *ClientConfiguration {
+	config.middlewares = append(config.middlewares, middleware)
+	return config
+}
+
+// WithCompression enables the transparent compression of values with the given [CompressionConfiguration]. Compression
+// is applied as a middleware, in registration order with the middlewares registered with WithMiddleware.
+func (config *ClientConfiguration) WithCompression(compression *CompressionConfiguration) *ClientConfiguration {
+	config.middlewares = append(config.middlewares, compression.middleware())
+	return config
+}
+
+// WithEncryption enables the client-side encryption of values with the given [EncryptionConfiguration]. Encryption is
+// applied as a middleware, in registration order with the middlewares registered with WithMiddleware and WithCompression.
+func (config *ClientConfiguration) WithEncryption(encryption *EncryptionConfiguration) *ClientConfiguration {
+	config.middlewares = append(config.middlewares, encryption.middleware())
+	return config
+}
+
+// WithCommandPolicy restricts the commands the client may execute with the given [CommandPolicy]. The policy is enforced
+// before the middlewares, so it applies to the commands as issued by the caller.
+func (config *ClientConfiguration) WithCommandPolicy(policy *CommandPolicy) *ClientConfiguration {
+	config.commandPolicy = policy
+	return config
+}
+
+// WithHedgedReads hedges the read-only commands of the client with the given [HedgingConfiguration], which sends a
+// duplicate of the slow reads to another node.
+func (config *ClientConfiguration) WithHedgedReads(hedging *HedgingConfiguration) *ClientConfiguration {
+	config.hedging = hedging
+	return config
+}
+
+// WithRetryPolicy retries the commands of the client which failed with a transient error, according to the given
+// [RetryPolicy].
+func (config *ClientConfiguration) WithRetryPolicy(policy *RetryPolicy) *ClientConfiguration {
+	config.retryPolicy = policy
+	return config
+}
+
+// WithCircuitBreaker fails the commands sent to persistently unhealthy nodes fast, according to the given
+// [CircuitBreakerConfiguration].
+func (config *ClientConfiguration) WithCircuitBreaker(breaker *CircuitBreakerConfiguration) *ClientConfiguration {
+	config.circuitBreaker = breaker
+	return config
+}
+
+// WithBlockingConnections runs the blocking commands of the client, such as BLPOP or XREAD with BLOCK, over a pool of at
+// most size dedicated connections, so that they do not delay the other commands. The request timeout of a blocking
+// command is extended by its block timeout. A size of 0 sends blocking commands over the shared connection.
+func (config *ClientConfiguration) WithBlockingConnections(size int) *ClientConfiguration {
+	config.blockingConns = size
+	return config
+}
+
+// WithEventListener registers a listener of the connection lifecycle events of the client, such as disconnections,
+// reconnect attempts and successful reconnections. The listener is called from a single goroutine, in the order of the
+// events, and must not block.
+func (config *ClientConfiguration) WithEventListener(listener ConnectionEventListener) *ClientConfiguration {
+	config.eventListener = listener
+	return config
+}
+
+// WithSentinel discovers the primary and the replicas of the client from the sentinels of the given
+// [SentinelConfiguration], instead of the addresses set by WithAddress. The client reconnects to the new primary when the
+// sentinels announce a failover, and spreads reads across the discovered replicas according to its [ReadFrom] strategy.
+func (config *ClientConfiguration) WithSentinel(sentinel *SentinelConfiguration) *ClientConfiguration {
+	config.sentinel = sentinel
+	return config
+}
+
+// GetSentinel returns the Sentinel configuration of the client, or nil if the client connects to the addresses set by
+// WithAddress.
+func (config *ClientConfiguration) GetSentinel() *SentinelConfiguration {
+	return config.sentinel
+}
+
 // WithDatabaseId sets the index of the logical database to connect to.
 func (config *ClientConfiguration) WithDatabaseId(id int) *ClientConfiguration {
 	config.databaseId = id
@@ -371,6 +521,13 @@ func (config *ClusterClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequ
 		return nil, err
 	}
 
+	for _, address := range request.Addresses {
+		if address.UnixSocketPath != "" {
+			// the nodes of a cluster are discovered and connected to over TCP
+			return nil, errors.New("Unix domain sockets are not supported in cluster mode")
+		}
+	}
+
 	request.ClusterModeEnabled = true
 	if (config.AdvancedClusterClientConfiguration.connectionTimeout) != 0 {
 		connectionTimeout, err := utils.DurationToMilliseconds(config.AdvancedClusterClientConfiguration.connectionTimeout)
@@ -450,6 +607,12 @@ func (config *ClusterClientConfiguration) WithClientAZ(clientAZ string) *Cluster
 	return config
 }
 
+// WithProtocol sets the [ProtocolVersion] used to communicate with the servers. If not set, [RESP3] will be used.
+func (config *ClusterClientConfiguration) WithProtocol(protocol ProtocolVersion) *ClusterClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithReconnectStrategy sets the [BackoffStrategy] used to determine how and when to reconnect, in case of connection
 // failures. If not set, a default backoff strategy will be used.
 func (config *ClusterClientConfiguration) WithReconnectStrategy(
@@ -459,6 +622,73 @@ func (config *ClusterClientConfiguration) WithReconnectStrategy(
 	return config
 }
 
+// WithMiddleware registers a [Middleware] which intercepts the commands and batches executed by the client. WithMiddleware
+// can be called multiple times to register multiple middlewares; they are applied in registration order.
+func (config *ClusterClientConfiguration) WithMiddleware(middleware *Middleware) *ClusterClientConfiguration {
+	config.middlewares = append(config.middlewares, middleware)
+	return config
+}
+
+// WithCompression enables the transparent compression of values with the given [CompressionConfiguration]. Compression
+// is applied as a middleware, in registration order with the middlewares registered with WithMiddleware.
+func (config *ClusterClientConfiguration) WithCompression(compression *CompressionConfiguration) *ClusterClientConfiguration {
+	config.middlewares = append(config.middlewares, compression.middleware())
+	return config
+}
+
+// WithEncryption enables the client-side encryption of values with the given [EncryptionConfiguration]. Encryption is
+// applied as a middleware, in registration order with the middlewares registered with WithMiddleware and WithCompression.
+func (config *ClusterClientConfiguration) WithEncryption(encryption *EncryptionConfiguration) *ClusterClientConfiguration {
+	config.middlewares = append(config.middlewares, encryption.middleware())
+	return config
+}
+
+// WithCommandPolicy restricts the commands the client may execute with the given [CommandPolicy]. The policy is enforced
+// before the middlewares, so it applies to the commands as issued by the caller.
+func (config *ClusterClientConfiguration) WithCommandPolicy(policy *CommandPolicy) *ClusterClientConfiguration {
+	config.commandPolicy = policy
+	return config
+}
+
+// WithHedgedReads hedges the read-only commands of the client with the given [HedgingConfiguration], which sends a
+// duplicate of the slow reads to another node.
+func (config *ClusterClientConfiguration) WithHedgedReads(hedging *HedgingConfiguration) *ClusterClientConfiguration {
+	config.hedging = hedging
+	return config
+}
+
+// WithRetryPolicy retries the commands of the client which failed with a transient error, according to the given
+// [RetryPolicy].
+func (config *ClusterClientConfiguration) WithRetryPolicy(policy *RetryPolicy) *ClusterClientConfiguration {
+	config.retryPolicy = policy
+	return config
+}
+
+// WithCircuitBreaker fails the commands sent to persistently unhealthy nodes fast, according to the given
+// [CircuitBreakerConfiguration].
+func (config *ClusterClientConfiguration) WithCircuitBreaker(
+	breaker *CircuitBreakerConfiguration,
+) *ClusterClientConfiguration {
+	config.circuitBreaker = breaker
+	return config
+}
+
+// WithBlockingConnections runs the blocking commands of the client, such as BLPOP or XREAD with BLOCK, over a pool of at
+// most size dedicated connections, so that they do not delay the other commands. The request timeout of a blocking
+// command is extended by its block timeout. A size of 0 sends blocking commands over the shared connection.
+func (config *ClusterClientConfiguration) WithBlockingConnections(size int) *ClusterClientConfiguration {
+	config.blockingConns = size
+	return config
+}
+
+// WithEventListener registers a listener of the connection lifecycle events of the client, such as disconnections,
+// reconnect attempts and successful reconnections. The listener is called from a single goroutine, in the order of the
+// events, and must not block.
+func (config *ClusterClientConfiguration) WithEventListener(listener ConnectionEventListener) *ClusterClientConfiguration {
+	config.eventListener = listener
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *ClusterClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedClusterClientConfiguration,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,7 +81,7 @@ func TestConfig_allFieldsSet(t *testing.T) {
 
 	assert.Equal(t, len(hosts), len(ports))
 	for i := 0; i < len(hosts); i++ {
-		config.WithAddress(&NodeAddress{hosts[i], ports[i]})
+		config.WithAddress(&NodeAddress{Host: hosts[i], Port: ports[i]})
 		expected.Addresses = append(
 			expected.Addresses,
 			&protobuf.NodeAddress{Host: hosts[i], Port: uint32(ports[i])},
@@ -170,7 +171,9 @@ func TestNodeAddress(t *testing.T) {
 		{NodeAddress{}, &protobuf.NodeAddress{Host: DefaultHost, Port: DefaultPort}},
 		{NodeAddress{Host: "host"}, &protobuf.NodeAddress{Host: "host", Port: DefaultPort}},
 		{NodeAddress{Port: 1234}, &protobuf.NodeAddress{Host: DefaultHost, Port: 1234}},
-		{NodeAddress{"host", 1234}, &protobuf.NodeAddress{Host: "host", Port: 1234}},
+		{NodeAddress{Host: "host", Port: 1234}, &protobuf.NodeAddress{Host: "host", Port: 1234}},
+		{*NewUnixSocketAddress("/tmp/valkey.sock"), &protobuf.NodeAddress{UnixSocketPath: "/tmp/valkey.sock"}},
+		{NodeAddress{Host: "host", UnixSocketPath: "/tmp/valkey.sock"}, &protobuf.NodeAddress{UnixSocketPath: "/tmp/valkey.sock"}},
 	}
 
 	for i, parameter := range parameters {
@@ -228,7 +231,7 @@ func TestConfig_AzAffinity(t *testing.T) {
 
 	assert.Equal(t, len(hosts), len(ports))
 	for i := 0; i < len(hosts); i++ {
-		config.WithAddress(&NodeAddress{hosts[i], ports[i]})
+		config.WithAddress(&NodeAddress{Host: hosts[i], Port: ports[i]})
 		expected.Addresses = append(
 			expected.Addresses,
 			&protobuf.NodeAddress{Host: hosts[i], Port: uint32(ports[i])},
@@ -314,3 +317,67 @@ func TestConfig_InvalidRequestAndConnectionTimeouts(t *testing.T) {
 	assert.ErrorAs(t, err8, &errorType)
 	assert.Contains(t, err8.Error(), "invalid duration was specified")
 }
+
+func TestConfig_Middlewares(t *testing.T) {
+	first, second := &Middleware{}, &Middleware{}
+
+	config := NewClientConfiguration().WithMiddleware(first).WithMiddleware(second)
+	assert.Equal(t, []*Middleware{first, second}, config.GetMiddlewares())
+
+	clusterConfig := NewClusterClientConfiguration().WithMiddleware(first)
+	assert.Equal(t, []*Middleware{first}, clusterConfig.GetMiddlewares())
+
+	assert.Empty(t, NewClientConfiguration().GetMiddlewares())
+}
+
+func TestConfig_BlockingConnections(t *testing.T) {
+	assert.Zero(t, NewClientConfiguration().GetBlockingConnections())
+	assert.Equal(t, 2, NewClientConfiguration().WithBlockingConnections(2).GetBlockingConnections())
+	assert.Equal(t, 3, NewClusterClientConfiguration().WithBlockingConnections(3).GetBlockingConnections())
+}
+
+func TestConfig_EventListener(t *testing.T) {
+	assert.Nil(t, NewClientConfiguration().GetEventListener())
+
+	var received []models.ConnectionEvent
+	listener := func(event models.ConnectionEvent) { received = append(received, event) }
+	NewClientConfiguration().WithEventListener(listener).GetEventListener()(models.ConnectionEvent{Type: models.Reconnected})
+	NewClusterClientConfiguration().WithEventListener(listener).GetEventListener()(
+		models.ConnectionEvent{Type: models.TopologyRefreshed},
+	)
+	assert.Equal(t, []models.ConnectionEvent{{Type: models.Reconnected}, {Type: models.TopologyRefreshed}}, received)
+
+	// the events are requested by the client itself, whether there is a listener or not
+	request, err := NewClientConfiguration().WithEventListener(listener).ToProtobuf()
+	assert.NoError(t, err)
+	assert.False(t, request.LifecycleEvents)
+}
+
+func TestConfig_Sentinel(t *testing.T) {
+	credentials := NewServerCredentials("user", "password")
+	sentinel := NewSentinelConfiguration("primary").
+		WithAddress(&NodeAddress{Host: "sentinel-1", Port: 26379}).
+		WithAddress(&NodeAddress{Host: "sentinel-2", Port: 26379}).
+		WithCredentials(credentials)
+
+	assert.Equal(t, "primary", sentinel.GetMasterName())
+	assert.Equal(t, []NodeAddress{{Host: "sentinel-1", Port: 26379}, {Host: "sentinel-2", Port: 26379}}, sentinel.GetAddresses())
+	assert.Same(t, credentials, sentinel.GetCredentials())
+	assert.Equal(t, 10*time.Second, sentinel.GetCheckInterval())
+	assert.Zero(t, sentinel.WithCheckInterval(0).GetCheckInterval())
+
+	assert.Nil(t, NewClientConfiguration().GetSentinel())
+	assert.Same(t, sentinel, NewClientConfiguration().WithSentinel(sentinel).GetSentinel())
+}
+
+func TestConfig_UnixSocket(t *testing.T) {
+	request, err := NewClientConfiguration().WithAddress(NewUnixSocketAddress("/tmp/valkey.sock")).ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, []*protobuf.NodeAddress{{UnixSocketPath: "/tmp/valkey.sock"}}, request.Addresses)
+
+	_, err = NewClientConfiguration().WithAddress(NewUnixSocketAddress("/tmp/valkey.sock")).WithUseTLS(true).ToProtobuf()
+	assert.Error(t, err)
+
+	_, err = NewClusterClientConfiguration().WithAddress(NewUnixSocketAddress("/tmp/valkey.sock")).ToProtobuf()
+	assert.Error(t, err)
+}
@@ -0,0 +1,495 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+)
+
+// encryptionMagic starts every encrypted value, followed by the encryption mode, the length and the value of the key ID,
+// the nonce and the sealed value. The key of the value is the additional data of the seal, and the values of hash fields
+// are sealed after the length and the name of their field.
+const encryptionMagic = "\x00\xe5"
+
+const (
+	randomMode        = 'R'
+	deterministicMode = 'D'
+)
+
+// Keyring provides the keys used by [EncryptionConfiguration]. Keys must be 16, 24 or 32 bytes long, to select AES-128,
+// AES-192 or AES-256.
+//
+// To rotate keys, make CurrentKey return the new key, and keep returning the previous keys from Key until all the values
+// they encrypted are rewritten or expired.
+type Keyring interface {
+	// CurrentKey returns the ID and the key used to encrypt values. IDs are stored with the encrypted values, and must be
+	// at most 255 bytes long.
+	CurrentKey() (id string, key []byte, err error)
+	// Key returns the key with the given ID, used to decrypt values.
+	Key(id string) ([]byte, error)
+}
+
+// StaticKeyring is a [Keyring] holding a fixed set of keys.
+type StaticKeyring struct {
+	currentId string
+	keys      map[string][]byte
+}
+
+// NewStaticKeyring returns a [StaticKeyring] which encrypts values with the key with the given ID, and decrypts values
+// with any of the keys.
+func NewStaticKeyring(currentId string, keys map[string][]byte) *StaticKeyring {
+	return &StaticKeyring{currentId: currentId, keys: keys}
+}
+
+func (keyring *StaticKeyring) CurrentKey() (string, []byte, error) {
+	key, err := keyring.Key(keyring.currentId)
+	return keyring.currentId, key, err
+}
+
+func (keyring *StaticKeyring) Key(id string) ([]byte, error) {
+	key, found := keyring.keys[id]
+	if !found {
+		return nil, fmt.Errorf("unknown key ID %q", id)
+	}
+	return key, nil
+}
+
+// DecryptionError is returned by commands reading a value which could not be decrypted, because its key is not in the
+// keyring, or because it was corrupted or encrypted with another key.
+type DecryptionError struct {
+	// KeyId is the ID of the key the value was encrypted with, or an empty string if the value header is invalid.
+	KeyId string
+	Err   error
+}
+
+func (e *DecryptionError) Error() string {
+	return fmt.Sprintf("failed to decrypt value encrypted with key %q: %v", e.KeyId, e.Err)
+}
+
+func (e *DecryptionError) Unwrap() error {
+	return e.Err
+}
+
+// EncryptionConfiguration enables the client-side encryption of values with AES-GCM, so that the server never sees them
+// in plaintext. Values are encrypted when written by SET, MSET, MSETNX, HSET and HSETNX, and decrypted when read by GET,
+// GETEX, GETDEL, MGET, HGET, HMGET, HGETALL and HVALS, including in batches. Values which are not encrypted, such as values
+// written before encryption was enabled, are returned as they are. Values which can not be decrypted fail the command
+// with a [DecryptionError].
+//
+// By default, each value is encrypted with a random nonce, so equal values have different ciphertexts. With
+// deterministic encryption, the nonce is derived from the value, so equal values encrypted with the same key have the
+// same ciphertext, at the cost of revealing which values are equal.
+//
+// Encrypted values are bound to their key, and the values of hash fields to their field as well, so a ciphertext copied
+// to another key or field fails to decrypt. HVALS does not know the fields of the values it returns, so it does not
+// detect values copied between the fields of a hash.
+//
+// The value compared by the IFEQ option of SET is encrypted with deterministic encryption, and matches the values
+// encrypted with the current key. With random nonces, it is sent in plaintext and never matches an encrypted value,
+// since equal values have different ciphertexts.
+//
+// With hash field encryption, the names of hash fields are encrypted deterministically as well, and HGET, HMGET, HDEL,
+// HEXISTS and HSTRLEN look fields up by their encrypted name. Fields are only found with the key they were written with,
+// so rotating the current key requires rewriting the hashes.
+//
+// Encryption is implemented as a [Middleware], registered in the order of the call to WithEncryption. When combined with
+// compression, compression must be registered first, since encrypted values do not compress. Commands sent via
+// CustomCommand are not affected.
+type EncryptionConfiguration struct {
+	keyring       Keyring
+	deterministic bool
+	hashFields    bool
+
+	mu    sync.Mutex
+	aeads map[string]cipher.AEAD
+}
+
+// NewEncryptionConfiguration returns an [EncryptionConfiguration] using the keys of the keyring.
+func NewEncryptionConfiguration(keyring Keyring) *EncryptionConfiguration {
+	return &EncryptionConfiguration{keyring: keyring, aeads: make(map[string]cipher.AEAD)}
+}
+
+// WithDeterministic sets whether values are encrypted deterministically.
+func (config *EncryptionConfiguration) WithDeterministic(deterministic bool) *EncryptionConfiguration {
+	config.deterministic = deterministic
+	return config
+}
+
+// WithHashFieldEncryption sets whether the names of hash fields are encrypted.
+func (config *EncryptionConfiguration) WithHashFieldEncryption(hashFields bool) *EncryptionConfiguration {
+	config.hashFields = hashFields
+	return config
+}
+
+// aead returns the cipher for the key, caching it by key ID.
+func (config *EncryptionConfiguration) aead(id string, key []byte) (cipher.AEAD, error) {
+	config.mu.Lock()
+	defer config.mu.Unlock()
+	if aead, found := config.aeads[id]; found {
+		return aead, nil
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	config.aeads[id] = aead
+	return aead, nil
+}
+
+// location identifies where a value is stored, to bind its ciphertext to it.
+type location struct {
+	key string
+	// hash is set for the values of hash fields, which are sealed with the name of their field.
+	hash bool
+	// field is the stored name of the field of a hash value, or nil when it is not known.
+	field *string
+}
+
+// plaintext returns the sealed form of a value stored at the location.
+func (location location) plaintext(value string) []byte {
+	if !location.hash {
+		return []byte(value)
+	}
+	plaintext := binary.AppendUvarint(nil, uint64(len(*location.field)))
+	plaintext = append(plaintext, *location.field...)
+	return append(plaintext, value...)
+}
+
+// value returns the value sealed in a plaintext, checking that it was sealed for the field of the location.
+func (location location) value(plaintext []byte) (string, error) {
+	if !location.hash {
+		return string(plaintext), nil
+	}
+	length, n := binary.Uvarint(plaintext)
+	if n <= 0 || length > uint64(len(plaintext)-n) {
+		return "", errors.New("invalid hash value")
+	}
+	field, value := plaintext[n:n+int(length)], plaintext[n+int(length):]
+	if location.field != nil && string(field) != *location.field {
+		return "", errors.New("value was encrypted for another field")
+	}
+	return string(value), nil
+}
+
+// encrypt returns the stored form of a value stored at the location.
+func (config *EncryptionConfiguration) encrypt(value string, deterministic bool, location location) (string, error) {
+	id, key, err := config.keyring.CurrentKey()
+	if err != nil {
+		return "", err
+	}
+	if len(id) > 255 {
+		return "", fmt.Errorf("key ID %q is longer than 255 bytes", id)
+	}
+	aead, err := config.aead(id, key)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext := location.plaintext(value)
+	mode := byte(randomMode)
+	nonce := make([]byte, aead.NonceSize())
+	if deterministic {
+		// the nonce is a MAC of the location and the value, with a MAC key derived from the encryption key, so that equal
+		// values stored at different locations do not reuse a nonce with different additional data
+		mode = deterministicMode
+		derivation := hmac.New(sha256.New, key)
+		derivation.Write([]byte("valkey-glide deterministic nonce"))
+		mac := hmac.New(sha256.New, derivation.Sum(nil))
+		mac.Write(binary.AppendUvarint(nil, uint64(len(location.key))))
+		mac.Write([]byte(location.key))
+		mac.Write(plaintext)
+		copy(nonce, mac.Sum(nil))
+	} else if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	var stored strings.Builder
+	stored.WriteString(encryptionMagic)
+	stored.WriteByte(mode)
+	stored.WriteByte(byte(len(id)))
+	stored.WriteString(id)
+	stored.Write(nonce)
+	stored.Write(aead.Seal(nil, nonce, plaintext, []byte(location.key)))
+	return stored.String(), nil
+}
+
+// decrypt returns the original form of a value stored at the location.
+func (config *EncryptionConfiguration) decrypt(stored string, location location) (string, error) {
+	if !strings.HasPrefix(stored, encryptionMagic) {
+		return stored, nil
+	}
+	header := len(encryptionMagic) + 2
+	if len(stored) < header || (stored[header-2] != randomMode && stored[header-2] != deterministicMode) {
+		return "", &DecryptionError{Err: errors.New("invalid header")}
+	}
+	idLength := int(stored[header-1])
+	if len(stored) < header+idLength {
+		return "", &DecryptionError{Err: errors.New("invalid header")}
+	}
+	id := stored[header : header+idLength]
+	key, err := config.keyring.Key(id)
+	if err != nil {
+		return "", &DecryptionError{KeyId: id, Err: err}
+	}
+	aead, err := config.aead(id, key)
+	if err != nil {
+		return "", &DecryptionError{KeyId: id, Err: err}
+	}
+	sealed := stored[header+idLength:]
+	if len(sealed) < aead.NonceSize() {
+		return "", &DecryptionError{KeyId: id, Err: errors.New("value too short")}
+	}
+	plaintext, err := aead.Open(nil, []byte(sealed[:aead.NonceSize()]), []byte(sealed[aead.NonceSize():]), []byte(location.key))
+	if err != nil {
+		return "", &DecryptionError{KeyId: id, Err: err}
+	}
+	value, err := location.value(plaintext)
+	if err != nil {
+		return "", &DecryptionError{KeyId: id, Err: err}
+	}
+	return value, nil
+}
+
+// encryptArgs encrypts the values and the hash fields in the arguments of a command.
+func (config *EncryptionConfiguration) encryptArgs(request *CommandRequest) error {
+	if request.Custom || len(request.Args) == 0 {
+		return nil
+	}
+	fields, values := encryptedArgs(request.Name, len(request.Args))
+	key := request.Args[0]
+	if config.hashFields {
+		for _, index := range fields {
+			encrypted, err := config.encrypt(request.Args[index], true, location{key: key})
+			if err != nil {
+				return err
+			}
+			request.Args[index] = encrypted
+		}
+	}
+	for _, index := range values {
+		location := location{key: key}
+		switch request.Name {
+		case "MSET", "MSETNX":
+			location.key = request.Args[index-1]
+		case "HSET", "HSETNX":
+			// the values are bound to the stored name of their field, which precedes them
+			location.hash, location.field = true, &request.Args[index-1]
+		}
+		encrypted, err := config.encrypt(request.Args[index], config.deterministic, location)
+		if err != nil {
+			return err
+		}
+		request.Args[index] = encrypted
+	}
+	if request.Name == "SET" && config.deterministic {
+		// the compared value only matches the stored value when it is encrypted the same way
+		for i := 2; i+1 < len(request.Args); i++ {
+			if request.Args[i] == string(constants.OnlyIfEquals) {
+				encrypted, err := config.encrypt(request.Args[i+1], true, location{key: key})
+				if err != nil {
+					return err
+				}
+				request.Args[i+1] = encrypted
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// encryptedArgs returns the indexes of the arguments holding hash fields and values, for the commands which use them.
+func encryptedArgs(name string, count int) (fields []int, values []int) {
+	switch name {
+	case "SET":
+		values = []int{1}
+	case "MSET", "MSETNX":
+		for i := 1; i < count; i += 2 {
+			values = append(values, i)
+		}
+	case "HSET":
+		for i := 1; i+1 < count; i += 2 {
+			fields = append(fields, i)
+			values = append(values, i+1)
+		}
+	case "HSETNX":
+		fields, values = []int{1}, []int{2}
+	case "HGET", "HEXISTS", "HSTRLEN":
+		fields = []int{1}
+	case "HMGET", "HDEL":
+		for i := 1; i < count; i++ {
+			fields = append(fields, i)
+		}
+	}
+	return fields, values
+}
+
+// decryptValue decrypts the values held by the result of a command.
+func (config *EncryptionConfiguration) decryptValue(request *CommandRequest, value any) (any, error) {
+	if request.Custom || len(request.Args) == 0 {
+		return value, nil
+	}
+	key := request.Args[0]
+	switch request.Name {
+	case "SET", "GET", "GETEX", "GETDEL":
+		if text, ok := value.(string); ok {
+			return config.decrypt(text, location{key: key})
+		}
+	case "HGET":
+		if text, ok := value.(string); ok && len(request.Args) > 1 {
+			return config.decrypt(text, location{key: key, hash: true, field: &request.Args[1]})
+		}
+	case "MGET", "HMGET", "HVALS":
+		items, ok := value.([]any)
+		if !ok {
+			return value, nil
+		}
+		for i, item := range items {
+			text, ok := item.(string)
+			if !ok {
+				continue
+			}
+			location := location{key: key}
+			switch request.Name {
+			case "MGET":
+				if i >= len(request.Args) {
+					continue
+				}
+				location.key = request.Args[i]
+			case "HMGET":
+				if i+1 >= len(request.Args) {
+					continue
+				}
+				location.hash, location.field = true, &request.Args[i+1]
+			case "HVALS":
+				location.hash = true
+			}
+			decrypted, err := config.decrypt(text, location)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = decrypted
+		}
+	case "HGETALL", "HKEYS":
+		return config.decryptHash(request.Name, key, value)
+	}
+	return value, nil
+}
+
+// decryptHash decrypts the fields and values of an HGETALL result, or the fields of an HKEYS result.
+func (config *EncryptionConfiguration) decryptHash(name string, key string, value any) (any, error) {
+	field := func(stored string) (string, error) {
+		if !config.hashFields {
+			return stored, nil
+		}
+		return config.decrypt(stored, location{key: key})
+	}
+	entry := func(stored string, item any) (string, any, error) {
+		decrypted, err := field(stored)
+		if err != nil {
+			return "", nil, err
+		}
+		if text, ok := item.(string); ok {
+			if item, err = config.decrypt(text, location{key: key, hash: true, field: &stored}); err != nil {
+				return "", nil, err
+			}
+		}
+		return decrypted, item, nil
+	}
+
+	switch value := value.(type) {
+	case []any:
+		if name == "HKEYS" {
+			for i, item := range value {
+				if text, ok := item.(string); ok {
+					decrypted, err := field(text)
+					if err != nil {
+						return nil, err
+					}
+					value[i] = decrypted
+				}
+			}
+			return value, nil
+		}
+		// the fields and the values alternate
+		for i := 0; i+1 < len(value); i += 2 {
+			if stored, ok := value[i].(string); ok {
+				decrypted, item, err := entry(stored, value[i+1])
+				if err != nil {
+					return nil, err
+				}
+				value[i], value[i+1] = decrypted, item
+			}
+		}
+		return value, nil
+	case map[string]any:
+		if name == "HKEYS" {
+			return value, nil
+		}
+		decrypted := make(map[string]any, len(value))
+		for stored, item := range value {
+			field, item, err := entry(stored, item)
+			if err != nil {
+				return nil, err
+			}
+			decrypted[field] = item
+		}
+		return decrypted, nil
+	}
+	return value, nil
+}
+
+func (config *EncryptionConfiguration) middleware() *Middleware {
+	return &Middleware{
+		BeforeCommand: func(ctx context.Context, request *CommandRequest) *CommandResult {
+			if err := config.encryptArgs(request); err != nil {
+				return &CommandResult{Err: err}
+			}
+			return nil
+		},
+		AfterCommand: func(ctx context.Context, request *CommandRequest, result *CommandResult) {
+			if result.Err == nil {
+				result.Value, result.Err = config.decryptValue(request, result.Value)
+			}
+		},
+		BeforeBatch: func(ctx context.Context, request *BatchRequest) *BatchResult {
+			for i := range request.Commands {
+				if err := config.encryptArgs(&request.Commands[i]); err != nil {
+					return &BatchResult{Err: err}
+				}
+			}
+			return nil
+		},
+		AfterBatch: func(ctx context.Context, request *BatchRequest, result *BatchResult) {
+			for i, value := range result.Values {
+				if i >= len(request.Commands) {
+					break
+				}
+				decrypted, err := config.decryptValue(&request.Commands[i], value)
+				if err != nil && request.RaiseOnError {
+					result.Values, result.Err = nil, err
+					return
+				}
+				if err != nil {
+					decrypted = err
+				}
+				result.Values[i] = decrypted
+			}
+		},
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKeyring(currentId string) *StaticKeyring {
+	return NewStaticKeyring(currentId, map[string][]byte{
+		"v1": bytes.Repeat([]byte{1}, 32),
+		"v2": bytes.Repeat([]byte{2}, 16),
+	})
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	config := NewEncryptionConfiguration(testKeyring("v1"))
+
+	stored, err := config.encrypt("secret", false, location{key: "key"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stored, encryptionMagic+"R\x02v1"))
+	assert.NotContains(t, stored, "secret")
+	value, err := config.decrypt(stored, location{key: "key"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", value)
+
+	// random nonces give different ciphertexts for equal values
+	other, err := config.encrypt("secret", false, location{key: "key"})
+	require.NoError(t, err)
+	assert.NotEqual(t, stored, other)
+
+	// values which are not encrypted are returned as they are
+	value, err = config.decrypt("legacy", location{key: "key"})
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", value)
+}
+
+func TestDeterministicEncryption(t *testing.T) {
+	config := NewEncryptionConfiguration(testKeyring("v1"))
+
+	first, err := config.encrypt("alice", true, location{key: "key"})
+	require.NoError(t, err)
+	second, err := config.encrypt("alice", true, location{key: "key"})
+	require.NoError(t, err)
+	other, err := config.encrypt("bob", true, location{key: "key"})
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, other)
+
+	value, err := config.decrypt(first, location{key: "key"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", value)
+}
+
+func TestKeyRotation(t *testing.T) {
+	keyring := testKeyring("v1")
+	config := NewEncryptionConfiguration(keyring)
+	old, err := config.encrypt("secret", false, location{key: "key"})
+	require.NoError(t, err)
+
+	keyring.currentId = "v2"
+	rotated, err := config.encrypt("secret", false, location{key: "key"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rotated, encryptionMagic+"R\x02v2"))
+
+	for _, stored := range []string{old, rotated} {
+		value, err := config.decrypt(stored, location{key: "key"})
+		require.NoError(t, err)
+		assert.Equal(t, "secret", value)
+	}
+
+	// values encrypted with a key removed from the keyring can not be decrypted
+	delete(keyring.keys, "v1")
+	_, err = config.decrypt(old, location{key: "key"})
+	var decryptionErr *DecryptionError
+	require.ErrorAs(t, err, &decryptionErr)
+	assert.Equal(t, "v1", decryptionErr.KeyId)
+
+	_, err = NewEncryptionConfiguration(testKeyring("v3")).encrypt("secret", false, location{key: "key"})
+	assert.Error(t, err)
+}
+
+func TestDecryptionErrors(t *testing.T) {
+	config := NewEncryptionConfiguration(testKeyring("v1"))
+	stored, err := config.encrypt("secret", false, location{key: "key"})
+	require.NoError(t, err)
+
+	tampered := []byte(stored)
+	tampered[len(tampered)-1] ^= 1
+	for _, value := range []string{string(tampered), stored[:len(stored)-20], encryptionMagic, encryptionMagic + "X\x00"} {
+		_, err := config.decrypt(value, location{key: "key"})
+		var decryptionErr *DecryptionError
+		assert.ErrorAs(t, err, &decryptionErr, "%q", value)
+	}
+
+	// values encrypted with another key with the same ID
+	other := NewEncryptionConfiguration(NewStaticKeyring("v1", map[string][]byte{"v1": bytes.Repeat([]byte{3}, 32)}))
+	_, err = other.decrypt(stored, location{key: "key"})
+	assert.ErrorAs(t, err, new(*DecryptionError))
+}
+
+func TestEncryptionMiddleware(t *testing.T) {
+	ctx := context.Background()
+	config := NewEncryptionConfiguration(testKeyring("v1")).WithDeterministic(true)
+	middleware := config.middleware()
+	encrypted := func(key string, value string) string {
+		stored, err := config.encrypt(value, true, location{key: key})
+		require.NoError(t, err)
+		return stored
+	}
+	hashValue := func(key string, field string, value string) string {
+		stored, err := config.encrypt(value, true, location{key: key, hash: true, field: &field})
+		require.NoError(t, err)
+		return stored
+	}
+
+	commands := []struct {
+		request  CommandRequest
+		expected []string
+	}{
+		{
+			CommandRequest{Name: "SET", Args: []string{"key", "value", "EX", "10"}},
+			[]string{"key", encrypted("key", "value"), "EX", "10"},
+		},
+		{
+			CommandRequest{Name: "SET", Args: []string{"key", "new", "IFEQ", "old"}},
+			[]string{"key", encrypted("key", "new"), "IFEQ", encrypted("key", "old")},
+		},
+		{
+			CommandRequest{Name: "MSET", Args: []string{"k1", "a", "k2", "b"}},
+			[]string{"k1", encrypted("k1", "a"), "k2", encrypted("k2", "b")},
+		},
+		{CommandRequest{Name: "HSET", Args: []string{"key", "f1", "a"}}, []string{"key", "f1", hashValue("key", "f1", "a")}},
+		{CommandRequest{Name: "HGET", Args: []string{"key", "f1"}}, []string{"key", "f1"}},
+		{CommandRequest{Name: "LPUSH", Args: []string{"key", "value"}}, []string{"key", "value"}},
+		{CommandRequest{Name: "SET", Args: []string{"SET", "key", "value"}, Custom: true}, []string{"SET", "key", "value"}},
+	}
+	for _, command := range commands {
+		assert.Nil(t, middleware.BeforeCommand(ctx, &command.request))
+		assert.Equal(t, command.expected, command.request.Args, command.request.Name)
+	}
+
+	results := []struct {
+		request  CommandRequest
+		value    any
+		expected any
+	}{
+		{CommandRequest{Name: "GET", Args: []string{"key"}}, encrypted("key", "value"), "value"},
+		{
+			CommandRequest{Name: "MGET", Args: []string{"k1", "k2", "k3"}},
+			[]any{encrypted("k1", "a"), nil, "plain"},
+			[]any{"a", nil, "plain"},
+		},
+		{CommandRequest{Name: "HGET", Args: []string{"key", "f1"}}, hashValue("key", "f1", "a"), "a"},
+		{
+			CommandRequest{Name: "HMGET", Args: []string{"key", "f1", "f2"}},
+			[]any{hashValue("key", "f1", "a"), nil},
+			[]any{"a", nil},
+		},
+		{CommandRequest{Name: "HVALS", Args: []string{"key"}}, []any{hashValue("key", "f1", "a")}, []any{"a"}},
+		{
+			CommandRequest{Name: "HGETALL", Args: []string{"key"}},
+			map[string]any{"field": hashValue("key", "field", "a")},
+			map[string]any{"field": "a"},
+		},
+		{CommandRequest{Name: "HKEYS", Args: []string{"key"}}, []any{encrypted("key", "a")}, []any{encrypted("key", "a")}},
+		{CommandRequest{Name: "LRANGE", Args: []string{"key"}}, []any{encrypted("key", "a")}, []any{encrypted("key", "a")}},
+	}
+	for _, command := range results {
+		result := &CommandResult{Value: command.value}
+		middleware.AfterCommand(ctx, &command.request, result)
+		require.NoError(t, result.Err)
+		assert.Equal(t, command.expected, result.Value, command.request.Name)
+	}
+
+	result := &CommandResult{Value: encryptionMagic + "R\x02v9"}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "GET", Args: []string{"key"}}, result)
+	var decryptionErr *DecryptionError
+	require.ErrorAs(t, result.Err, &decryptionErr)
+	assert.Equal(t, "v9", decryptionErr.KeyId)
+
+	batch := &BatchRequest{Commands: []CommandRequest{
+		{Name: "SET", Args: []string{"key", "value"}},
+		{Name: "GET", Args: []string{"key"}},
+		{Name: "GET", Args: []string{"other"}},
+	}}
+	assert.Nil(t, middleware.BeforeBatch(ctx, batch))
+	assert.Equal(t, []string{"key", encrypted("key", "value")}, batch.Commands[0].Args)
+	batchResult := &BatchResult{Values: []any{"OK", encrypted("key", "value"), encryptionMagic}}
+	middleware.AfterBatch(ctx, batch, batchResult)
+	require.Len(t, batchResult.Values, 3)
+	assert.Equal(t, []any{"OK", "value"}, batchResult.Values[:2])
+	assert.ErrorAs(t, batchResult.Values[2].(error), &decryptionErr)
+
+	batch.RaiseOnError = true
+	batchResult = &BatchResult{Values: []any{"OK", encrypted("key", "value"), encryptionMagic}}
+	middleware.AfterBatch(ctx, batch, batchResult)
+	assert.ErrorAs(t, batchResult.Err, &decryptionErr)
+}
+
+func TestHashFieldEncryption(t *testing.T) {
+	ctx := context.Background()
+	config := NewEncryptionConfiguration(testKeyring("v1")).WithHashFieldEncryption(true)
+	middleware := config.middleware()
+	field, err := config.encrypt("email", true, location{key: "key"})
+	require.NoError(t, err)
+
+	set := CommandRequest{Name: "HSET", Args: []string{"key", "email", "alice@example.com"}}
+	assert.Nil(t, middleware.BeforeCommand(ctx, &set))
+	assert.Equal(t, field, set.Args[1])
+	assert.NotEqual(t, "alice@example.com", set.Args[2])
+
+	// fields are looked up by their encrypted name
+	for _, name := range []string{"HGET", "HMGET", "HDEL", "HEXISTS"} {
+		request := CommandRequest{Name: name, Args: []string{"key", "email"}}
+		assert.Nil(t, middleware.BeforeCommand(ctx, &request))
+		assert.Equal(t, []string{"key", field}, request.Args, name)
+	}
+
+	// values are bound to the encrypted name of their field
+	get := CommandRequest{Name: "HGET", Args: []string{"key", "email"}}
+	assert.Nil(t, middleware.BeforeCommand(ctx, &get))
+	value := &CommandResult{Value: set.Args[2]}
+	middleware.AfterCommand(ctx, &get, value)
+	require.NoError(t, value.Err)
+	assert.Equal(t, "alice@example.com", value.Value)
+
+	all := &CommandResult{Value: map[string]any{field: set.Args[2]}}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "HGETALL", Args: []string{"key"}}, all)
+	require.NoError(t, all.Err)
+	assert.Equal(t, map[string]any{"email": "alice@example.com"}, all.Value)
+
+	// fields are bound to their key
+	other := CommandRequest{Name: "HGET", Args: []string{"other", "email"}}
+	assert.Nil(t, middleware.BeforeCommand(ctx, &other))
+	assert.NotEqual(t, field, other.Args[1])
+
+	keys := &CommandResult{Value: []any{field}}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "HKEYS", Args: []string{"key"}}, keys)
+	require.NoError(t, keys.Err)
+	assert.Equal(t, []any{"email"}, keys.Value)
+}
+
+func TestEncryptionBinding(t *testing.T) {
+	ctx := context.Background()
+	config := NewEncryptionConfiguration(testKeyring("v1"))
+	middleware := config.middleware()
+
+	// values copied to another key fail to decrypt
+	set := CommandRequest{Name: "SET", Args: []string{"key", "value", "IFEQ", "old"}}
+	assert.Nil(t, middleware.BeforeCommand(ctx, &set))
+	result := &CommandResult{Value: set.Args[1]}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "GET", Args: []string{"other"}}, result)
+	assert.ErrorAs(t, result.Err, new(*DecryptionError))
+	// the compared value can not match with random nonces, and is sent as it is
+	assert.Equal(t, "old", set.Args[3])
+
+	// deterministic encryption gives different ciphertexts for equal values at different keys
+	first, err := config.encrypt("value", true, location{key: "key"})
+	require.NoError(t, err)
+	second, err := config.encrypt("value", true, location{key: "other"})
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	// values copied to another field fail to decrypt, except with HVALS which does not know the fields
+	hset := CommandRequest{Name: "HSET", Args: []string{"key", "f1", "a"}}
+	assert.Nil(t, middleware.BeforeCommand(ctx, &hset))
+	result = &CommandResult{Value: hset.Args[2]}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "HGET", Args: []string{"key", "f2"}}, result)
+	var decryptionErr *DecryptionError
+	require.ErrorAs(t, result.Err, &decryptionErr)
+	assert.Equal(t, "v1", decryptionErr.KeyId)
+	result = &CommandResult{Value: map[string]any{"f2": hset.Args[2]}}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "HGETALL", Args: []string{"key"}}, result)
+	assert.ErrorAs(t, result.Err, new(*DecryptionError))
+	result = &CommandResult{Value: []any{hset.Args[2]}}
+	middleware.AfterCommand(ctx, &CommandRequest{Name: "HVALS", Args: []string{"key"}}, result)
+	require.NoError(t, result.Err)
+	assert.Equal(t, []any{"a"}, result.Value)
+}
+
+func TestEncryptionKeyErrors(t *testing.T) {
+	ctx := context.Background()
+	middleware := NewEncryptionConfiguration(testKeyring("missing")).middleware()
+
+	result := middleware.BeforeCommand(ctx, &CommandRequest{Name: "SET", Args: []string{"key", "value"}})
+	require.NotNil(t, result)
+	assert.Error(t, result.Err)
+
+	invalid := NewEncryptionConfiguration(NewStaticKeyring("short", map[string][]byte{"short": []byte("short")}))
+	_, err := invalid.encrypt("value", false, location{key: "key"})
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, new(*DecryptionError)))
+}
+
+func TestWithEncryption(t *testing.T) {
+	encryption := NewEncryptionConfiguration(testKeyring("v1"))
+	assert.Len(t, NewClientConfiguration().WithEncryption(encryption).GetMiddlewares(), 1)
+	clusterConfig := NewClusterClientConfiguration().WithCompression(NewCompressionConfiguration()).WithEncryption(encryption)
+	require.Len(t, clusterConfig.GetMiddlewares(), 2)
+	assert.NotNil(t, clusterConfig.GetMiddlewares()[1].AfterCommand)
+}
@@ -0,0 +1,86 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// HedgingConfiguration enables the hedged reads of a client, which reduce the tail latency of read-only commands when a
+// node is slow. When a read did not complete within the hedging delay, the client sends a duplicate of it to another node:
+// to the primary when the read was sent preferably to a replica, and preferably to a replica when it was sent to the
+// primary. The first response is returned, and the other read is canceled.
+//
+// The hedging delay is fixed, or follows a percentile of the latencies of the recent reads of the client, so that only the
+// slowest reads are hedged. The hedged reads of a client are reported by its HedgingStatistics method.
+//
+// Only read-only commands which neither block nor depend on the node they are sent to are hedged, for example GET, HGETALL
+// or ZRANGE, but not SCAN or RANDOMKEY. Commands with an explicit route, commands of batches, scripts and views bound to
+// dedicated connections or to a single node are not hedged.
+type HedgingConfiguration struct {
+	delay      time.Duration
+	percentile float64
+}
+
+// NewHedgingConfiguration returns a [HedgingConfiguration] which hedges the reads which did not complete within 10
+// milliseconds.
+func NewHedgingConfiguration() *HedgingConfiguration {
+	return &HedgingConfiguration{delay: 10 * time.Millisecond}
+}
+
+// WithDelay sets the fixed hedging delay. With a percentile, the delay applies until enough reads were observed.
+func (hedging *HedgingConfiguration) WithDelay(delay time.Duration) *HedgingConfiguration {
+	hedging.delay = delay
+	return hedging
+}
+
+// WithPercentile sets the hedging delay to the given percentile of the latencies of the recent reads of the client, for
+// example 95 to hedge the 5% slowest reads. A percentile of 0, or of 100 or more, uses the fixed delay.
+func (hedging *HedgingConfiguration) WithPercentile(percentile float64) *HedgingConfiguration {
+	hedging.percentile = percentile
+	return hedging
+}
+
+// GetDelay returns the fixed hedging delay.
+func (hedging *HedgingConfiguration) GetDelay() time.Duration {
+	return hedging.delay
+}
+
+// GetPercentile returns the percentile of the latencies of the recent reads used as the hedging delay, or 0 if the delay
+// is fixed.
+func (hedging *HedgingConfiguration) GetPercentile() float64 {
+	if hedging.percentile <= 0 || hedging.percentile >= 100 {
+		return 0
+	}
+	return hedging.percentile
+}
+
+// Hedges reports whether the reads of a command are hedged.
+func (hedging *HedgingConfiguration) Hedges(request *CommandRequest) bool {
+	if request.Route != nil {
+		return false
+	}
+	name := strings.ToUpper(request.Name)
+	if request.Custom && containerCommands[name] && len(request.Args) > 1 {
+		name += " " + strings.ToUpper(request.Args[1])
+	}
+	if unhedgedReads[name] {
+		return false
+	}
+	categories := strings.Fields(commandCategories[name])
+	return slices.Contains(categories, "read") && !slices.Contains(categories, "write") &&
+		!slices.Contains(categories, "blocking") && !slices.Contains(categories, "dangerous")
+}
+
+// unhedgedReads are the read-only commands whose result depends on the node they are sent to, such as the cursors of the
+// scans, or which read the whole keyspace of a node.
+var unhedgedReads = map[string]bool{
+	"DBSIZE": true, "HSCAN": true, "RANDOMKEY": true, "SCAN": true, "SSCAN": true, "ZSCAN": true,
+}
+
+// GetHedgedReads returns the hedging configuration of the client, or nil if its reads are not hedged.
+func (config *baseClientConfiguration) GetHedgedReads() *HedgingConfiguration {
+	return config.hedging
+}
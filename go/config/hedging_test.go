@@ -0,0 +1,60 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHedgingConfiguration(t *testing.T) {
+	hedging := NewHedgingConfiguration()
+	assert.Equal(t, 10*time.Millisecond, hedging.GetDelay())
+	assert.Zero(t, hedging.GetPercentile())
+
+	hedging.WithDelay(5 * time.Millisecond).WithPercentile(99)
+	assert.Equal(t, 5*time.Millisecond, hedging.GetDelay())
+	assert.Equal(t, 99.0, hedging.GetPercentile())
+	// out of range percentiles use the fixed delay
+	assert.Zero(t, hedging.WithPercentile(100).GetPercentile())
+	assert.Zero(t, hedging.WithPercentile(-1).GetPercentile())
+
+	assert.Nil(t, NewClientConfiguration().GetHedgedReads())
+	assert.Same(t, hedging, NewClientConfiguration().WithHedgedReads(hedging).GetHedgedReads())
+	assert.Same(t, hedging, NewClusterClientConfiguration().WithHedgedReads(hedging).GetHedgedReads())
+}
+
+func TestHedgingConfiguration_Hedges(t *testing.T) {
+	hedging := NewHedgingConfiguration()
+	for _, request := range []CommandRequest{
+		{Name: "GET", Args: []string{"key"}},
+		{Name: "HGETALL", Args: []string{"key"}},
+		{Name: "XINFO STREAM", Args: []string{"key"}},
+		{Name: "zrange", Args: []string{"ZRANGE", "key", "0", "-1"}, Custom: true},
+		{Name: "XINFO", Args: []string{"XINFO", "GROUPS", "key"}, Custom: true},
+	} {
+		assert.True(t, hedging.Hedges(&request), request.Name)
+	}
+	for _, request := range []CommandRequest{
+		// writes
+		{Name: "SET", Args: []string{"key", "value"}},
+		{Name: "GETDEL", Args: []string{"key"}},
+		// blocking and dangerous reads
+		{Name: "XREAD", Args: []string{"STREAMS", "key", "0"}},
+		{Name: "KEYS", Args: []string{"*"}},
+		// reads which depend on the node
+		{Name: "SCAN", Args: []string{"0"}},
+		{Name: "RANDOMKEY"},
+		// commands which are not reads
+		{Name: "PING"},
+		{Name: "INFO"},
+		// unknown commands
+		{Name: "CUSTOM.GET", Args: []string{"CUSTOM.GET", "key"}, Custom: true},
+		// explicit routes
+		{Name: "GET", Args: []string{"key"}, Route: RandomRoute},
+	} {
+		assert.False(t, hedging.Hedges(&request), request.Name)
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// InvalidConfigurationError is returned when a connection URL, a configuration file or an environment variable holds an
+// invalid value.
+type InvalidConfigurationError struct {
+	// Field is the name of the offending field, as it appears in the URL, the file or the environment.
+	Field  string
+	Reason string
+}
+
+func (e *InvalidConfigurationError) Error() string {
+	return fmt.Sprintf("invalid configuration field %s: %s", e.Field, e.Reason)
+}
+
+// settingNames are the names of the fields of configuration files, and of the query parameters of connection URLs. The
+// names of the environment variables are the upper-cased names, following a prefix.
+var settingNames = []string{
+	"url",
+	"addresses",
+	"tls",
+	"username",
+	"password",
+	"database_id",
+	"read_from",
+	"request_timeout",
+	"connection_timeout",
+	"client_name",
+	"client_az",
+	"protocol",
+}
+
+// queryParameters are the settings which can be set by the query parameters of connection URLs.
+var queryParameters = map[string]bool{
+	"tls":                true,
+	"read_from":          true,
+	"request_timeout":    true,
+	"connection_timeout": true,
+	"client_name":        true,
+	"client_az":          true,
+	"protocol":           true,
+}
+
+// setting is the value of a configuration field, with the name under which it was set.
+type setting struct {
+	value string
+	field string
+}
+
+// settings maps the names of the configuration fields to their values.
+type settings map[string]setting
+
+// ParseURL returns a [ClientConfiguration] of the standalone servers of a connection URL, of the form
+//
+//	valkey://[[username]:password@]host[:port][,host[:port]...][/database][?parameter=value&...]
+//
+// The valkeys scheme enables TLS, and the redis and rediss schemes are accepted as well. The query parameters are tls,
+// read_from (primary, prefer_replica, az_affinity or az_affinity_replicas_and_primary), request_timeout and
+// connection_timeout (a duration such as 500ms, or a number of milliseconds), client_name, client_az and protocol (resp2 or
+// resp3).
+func ParseURL(rawURL string) (*ClientConfiguration, error) {
+	values, err := parseURLSettings(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return values.clientConfiguration()
+}
+
+// ParseClusterURL returns a [ClusterClientConfiguration] of the cluster nodes of a connection URL, of the same form as
+// the URLs of [ParseURL]. The URL must not select a database.
+func ParseClusterURL(rawURL string) (*ClusterClientConfiguration, error) {
+	values, err := parseURLSettings(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return values.clusterClientConfiguration()
+}
+
+// LoadClientConfiguration returns a [ClientConfiguration] loaded from a configuration file and from environment
+// variables, which take precedence over the file. Either may be omitted by passing an empty path or prefix.
+//
+// The file is a YAML or JSON object, with the fields url, addresses (a list of host:port addresses), tls, username,
+// password, database_id, read_from, request_timeout, connection_timeout, client_name, client_az and protocol, whose values
+// are those of the query parameters of [ParseURL]. The fields set along with url take precedence over the URL.
+//
+// The environment variables are the upper-cased field names following the prefix, such as VALKEY_URL or
+// VALKEY_REQUEST_TIMEOUT with the prefix "VALKEY_". The addresses are separated by commas.
+func LoadClientConfiguration(path string, envPrefix string) (*ClientConfiguration, error) {
+	values, err := loadSettings(path, envPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return values.clientConfiguration()
+}
+
+// LoadClusterClientConfiguration returns a [ClusterClientConfiguration] loaded from a configuration file and from
+// environment variables, as [LoadClientConfiguration] does. The configuration must not select a database.
+func LoadClusterClientConfiguration(path string, envPrefix string) (*ClusterClientConfiguration, error) {
+	values, err := loadSettings(path, envPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return values.clusterClientConfiguration()
+}
+
+func parseURLSettings(rawURL string) (settings, error) {
+	// url.Parse does not accept multiple hosts, so the hosts are replaced before parsing the URL
+	scheme, rest, _ := strings.Cut(rawURL, "://")
+	end := strings.IndexAny(rest, "/?#")
+	if end < 0 {
+		end = len(rest)
+	}
+	userInfo, hosts := "", rest[:end]
+	if at := strings.LastIndex(hosts, "@"); at >= 0 {
+		userInfo, hosts = hosts[:at+1], hosts[at+1:]
+	}
+	parsed, err := url.Parse(scheme + "://" + userInfo + "host" + rest[end:])
+	if err != nil {
+		return nil, &InvalidConfigurationError{Field: "url", Reason: err.Error()}
+	}
+	values := settings{}
+	switch parsed.Scheme {
+	case "valkey", "redis":
+	case "valkeys", "rediss":
+		values["tls"] = setting{"true", "url"}
+	default:
+		return nil, &InvalidConfigurationError{Field: "url", Reason: fmt.Sprintf("unsupported scheme %q", parsed.Scheme)}
+	}
+	if hosts != "" {
+		values["addresses"] = setting{hosts, "url"}
+	}
+	if parsed.User != nil {
+		if username := parsed.User.Username(); username != "" {
+			values["username"] = setting{username, "url"}
+		}
+		if password, ok := parsed.User.Password(); ok {
+			values["password"] = setting{password, "url"}
+		}
+	}
+	if database := strings.Trim(parsed.Path, "/"); database != "" {
+		values["database_id"] = setting{database, "url"}
+	}
+	for name, parameter := range parsed.Query() {
+		if !queryParameters[name] {
+			return nil, &InvalidConfigurationError{Field: name, Reason: "unknown query parameter"}
+		}
+		values[name] = setting{parameter[len(parameter)-1], name}
+	}
+	return values, nil
+}
+
+func loadSettings(path string, envPrefix string) (settings, error) {
+	values := settings{}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]any
+		if err := yaml.Unmarshal(content, &fields); err != nil {
+			return nil, &InvalidConfigurationError{Field: path, Reason: err.Error()}
+		}
+		for name, field := range fields {
+			value, err := settingValue(name, field)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = setting{value, name}
+		}
+	}
+	if envPrefix != "" {
+		for _, name := range settingNames {
+			variable := envPrefix + strings.ToUpper(name)
+			if value, ok := os.LookupEnv(variable); ok {
+				values[name] = setting{value, variable}
+			}
+		}
+	}
+	return values, nil
+}
+
+// settingValue returns the value of a field of a configuration file.
+func settingValue(name string, field any) (string, error) {
+	if !slices.Contains(settingNames, name) {
+		return "", &InvalidConfigurationError{Field: name, Reason: "unknown field"}
+	}
+
+	switch field := field.(type) {
+	case []any:
+		if name != "addresses" {
+			return "", &InvalidConfigurationError{Field: name, Reason: "a list is only allowed for addresses"}
+		}
+		addresses := make([]string, len(field))
+		for i, address := range field {
+			addresses[i] = fmt.Sprint(address)
+		}
+		return strings.Join(addresses, ","), nil
+	case map[string]any:
+		return "", &InvalidConfigurationError{Field: name, Reason: "expected a value, not an object"}
+	case nil:
+		return "", nil
+	}
+	return fmt.Sprint(field), nil
+}
+
+// withURL returns the settings of the URL setting, overridden by the other settings.
+func (values settings) withURL() (settings, error) {
+	rawURL, ok := values["url"]
+	if !ok || rawURL.value == "" {
+		return values, nil
+	}
+	merged, err := parseURLSettings(rawURL.value)
+	if err != nil {
+		if invalid, ok := err.(*InvalidConfigurationError); ok && invalid.Field == "url" {
+			invalid.Field = rawURL.field
+		}
+		return nil, err
+	}
+	for name, value := range values {
+		if name != "url" {
+			merged[name] = value
+		}
+	}
+	return merged, nil
+}
+
+func (values settings) clientConfiguration() (*ClientConfiguration, error) {
+	values, err := values.withURL()
+	if err != nil {
+		return nil, err
+	}
+	config := NewClientConfiguration()
+	if err := values.apply(&config.baseClientConfiguration); err != nil {
+		return nil, err
+	}
+	if config.databaseId, err = values.integer("database_id"); err != nil {
+		return nil, err
+	}
+	if config.connectionTimeout, err = values.duration("connection_timeout"); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (values settings) clusterClientConfiguration() (*ClusterClientConfiguration, error) {
+	values, err := values.withURL()
+	if err != nil {
+		return nil, err
+	}
+	config := NewClusterClientConfiguration()
+	if err := values.apply(&config.baseClientConfiguration); err != nil {
+		return nil, err
+	}
+	if databaseId, err := values.integer("database_id"); err != nil {
+		return nil, err
+	} else if databaseId != 0 {
+		return nil, &InvalidConfigurationError{Field: values["database_id"].field, Reason: "cluster clients use database 0"}
+	}
+	if config.connectionTimeout, err = values.duration("connection_timeout"); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// apply sets the settings shared by standalone and cluster configurations.
+func (values settings) apply(config *baseClientConfiguration) error {
+	if addresses := values["addresses"].value; addresses != "" {
+		for _, address := range strings.Split(addresses, ",") {
+			nodeAddress, err := parseAddress(strings.TrimSpace(address))
+			if err != nil {
+				return &InvalidConfigurationError{Field: values["addresses"].field, Reason: err.Error()}
+			}
+			config.addresses = append(config.addresses, nodeAddress)
+		}
+	}
+
+	var err error
+	if config.useTLS, err = values.boolean("tls"); err != nil {
+		return err
+	}
+	if password, ok := values["password"]; ok {
+		config.credentials = NewServerCredentials(values["username"].value, password.value)
+	} else if username, ok := values["username"]; ok {
+		return &InvalidConfigurationError{Field: username.field, Reason: "a username requires a password"}
+	}
+	if config.requestTimeout, err = values.duration("request_timeout"); err != nil {
+		return err
+	}
+	config.clientName = values["client_name"].value
+	config.clientAZ = values["client_az"].value
+
+	if readFrom, ok := values["read_from"]; ok {
+		switch strings.ToLower(readFrom.value) {
+		case "", "primary":
+			config.readFrom = Primary
+		case "prefer_replica", "preferreplica":
+			config.readFrom = PreferReplica
+		case "az_affinity", "azaffinity":
+			config.readFrom = AzAffinity
+		case "az_affinity_replicas_and_primary", "azaffinityreplicasandprimary":
+			config.readFrom = AzAffinityReplicaAndPrimary
+		default:
+			return &InvalidConfigurationError{Field: readFrom.field, Reason: fmt.Sprintf("unknown read strategy %q", readFrom.value)}
+		}
+		if config.readFrom >= AzAffinity && config.clientAZ == "" {
+			return &InvalidConfigurationError{Field: readFrom.field, Reason: "AZ affinity requires client_az"}
+		}
+	}
+
+	if protocol, ok := values["protocol"]; ok {
+		switch strings.ToLower(protocol.value) {
+		case "", "resp3", "3":
+			config.protocol = RESP3
+		case "resp2", "2":
+			config.protocol = RESP2
+		default:
+			return &InvalidConfigurationError{Field: protocol.field, Reason: fmt.Sprintf("unknown protocol %q", protocol.value)}
+		}
+	}
+	return nil
+}
+
+func (values settings) boolean(name string) (bool, error) {
+	value, ok := values[name]
+	if !ok || value.value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value.value)
+	if err != nil {
+		return false, &InvalidConfigurationError{Field: value.field, Reason: fmt.Sprintf("expected a boolean, got %q", value.value)}
+	}
+	return parsed, nil
+}
+
+func (values settings) integer(name string) (int, error) {
+	value, ok := values[name]
+	if !ok || value.value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value.value)
+	if err != nil || parsed < 0 {
+		return 0, &InvalidConfigurationError{
+			Field:  value.field,
+			Reason: fmt.Sprintf("expected a non-negative integer, got %q", value.value),
+		}
+	}
+	return parsed, nil
+}
+
+// duration parses a duration such as 500ms, or a number of milliseconds.
+func (values settings) duration(name string) (time.Duration, error) {
+	value, ok := values[name]
+	if !ok || value.value == "" {
+		return 0, nil
+	}
+	if milliseconds, err := strconv.ParseUint(value.value, 10, 32); err == nil {
+		return time.Duration(milliseconds) * time.Millisecond, nil
+	}
+	parsed, err := time.ParseDuration(value.value)
+	if err != nil || parsed < 0 {
+		return 0, &InvalidConfigurationError{Field: value.field, Reason: fmt.Sprintf("expected a duration, got %q", value.value)}
+	}
+	return parsed, nil
+}
+
+// parseAddress parses a host:port address, whose port is optional.
+func parseAddress(address string) (NodeAddress, error) {
+	if address == "" {
+		return NodeAddress{}, fmt.Errorf("empty address")
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		// the address has no port
+		return NodeAddress{Host: strings.Trim(address, "[]")}, nil
+	}
+	parsed, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return NodeAddress{}, fmt.Errorf("invalid port in address %q", address)
+	}
+	return NodeAddress{Host: host, Port: int(parsed)}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"time"
+)
+
+// CommandRequest describes a single command issued by a client, as seen by a [Middleware].
+//
+// Hooks may modify Args and Route in place to rewrite the request before it is sent to the server.
+type CommandRequest struct {
+	// Name is the upper-case command name, including the container command for subcommands, for example "GET" or
+	// "CONFIG SET". For commands sent via CustomCommand, it is derived from the first argument.
+	Name string
+	// Args holds the command arguments, excluding the command name. For commands sent via CustomCommand, Args holds the
+	// whole command line, including the command name.
+	Args []string
+	// Route is the explicit route of the command, or nil if the command is routed by the client.
+	Route Route
+	// Custom is true for commands sent via CustomCommand.
+	Custom bool
+}
+
+// CommandResult holds the outcome of a command, as seen by a [Middleware].
+//
+// Value holds the server response decoded to plain Go types: nil, string, int64, float64, bool, []any, map[string]any
+// or map[string]struct{}. Simple string replies, such as "OK", are represented as strings. Hooks may replace Value and Err
+// to rewrite the result returned to the caller, as long as the new value has the shape the command expects.
+type CommandResult struct {
+	Value any
+	Err   error
+	// Latency is the time spent waiting for the server response. It is zero for short-circuited commands.
+	Latency time.Duration
+}
+
+// BatchRequest describes a batch issued by a client, as seen by a [Middleware].
+//
+// Hooks may modify the Args of the queued commands in place, but must not add or remove commands.
+type BatchRequest struct {
+	Commands     []CommandRequest
+	IsAtomic     bool
+	RaiseOnError bool
+	// Route is the explicit route of the batch, or nil if the batch is routed by the client.
+	Route Route
+}
+
+// BatchResult holds the outcome of a batch, as seen by a [Middleware].
+//
+// Values holds the converted responses, in the same form as returned by Exec. Hooks may replace Values and Err to rewrite
+// the result returned to the caller.
+type BatchResult struct {
+	Values  []any
+	Err     error
+	Latency time.Duration
+}
+
+// Middleware intercepts commands and batches executed by a client. All hooks are optional.
+//
+// Before hooks are invoked in registration order, after hooks in reverse registration order, so that the first registered
+// middleware wraps all the others. A before hook may short-circuit the execution by returning a non-nil result: the
+// request is then not sent to the server, the remaining before hooks are skipped, and the returned result is passed to the
+// after hooks of the middlewares that already ran. Returning a result with only Err set rejects the request.
+//
+// Hooks are invoked on the calling goroutine and should not block.
+//
+// Scripts invoked via InvokeScript and cluster scans are not intercepted.
+type Middleware struct {
+	BeforeCommand func(ctx context.Context, request *CommandRequest) *CommandResult
+	AfterCommand  func(ctx context.Context, request *CommandRequest, result *CommandResult)
+	BeforeBatch   func(ctx context.Context, request *BatchRequest) *BatchResult
+	AfterBatch    func(ctx context.Context, request *BatchRequest, result *BatchResult)
+}
+
+// GetMiddlewares returns the middlewares registered on the configuration, in registration order.
+func (config *baseClientConfiguration) GetMiddlewares() []*Middleware {
+	return config.middlewares
+}
@@ -0,0 +1,151 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RetryPolicy retries the commands of a client which failed with a transient error: a timeout, a disconnection, or a
+// LOADING or TRYAGAIN error of the server. Only the read-only commands and the idempotent writes are retried, since the
+// other commands may already have been applied by the server when they failed.
+//
+// The retries are delayed by an exponential backoff with full jitter, and limited by a retry budget, so that the retries
+// do not overload a struggling server: within a sliding window of 10 seconds, the retries may not exceed a ratio of the
+// commands eligible to retries, in addition to a minimum number of retries per second.
+//
+// Commands with an explicit route are retried on the same route. Commands of batches, scripts and views bound to the
+// dedicated connection of a transaction are not retried. See [ClusterBatchRetryStrategy] for the retries of batches.
+type RetryPolicy struct {
+	maxAttempts        int
+	initialBackoff     time.Duration
+	maxBackoff         time.Duration
+	budgetRatio        float64
+	minRetriesPerSec   int
+	idempotentCommands map[string]bool
+}
+
+// NewRetryPolicy returns a [RetryPolicy] which attempts the commands up to 3 times, with a backoff from 50 milliseconds
+// to 1 second, and a retry budget of 10% of the commands and 10 retries per second.
+//
+// The idempotent writes which are retried by default are DEL, EXPIREAT, HDEL, HSET, MSET, PERSIST, PEXPIREAT, PSETEX,
+// SADD, SET, SETEX, SREM, UNLINK and ZREM. Their effect is the same when they are applied twice, but their reply may
+// differ, for example DEL replies 0 when the first attempt already deleted the key. SET with the NX, XX, IFEQ or GET
+// options is never retried, since its effect and its reply depend on whether the first attempt was applied.
+func NewRetryPolicy() *RetryPolicy {
+	policy := &RetryPolicy{
+		maxAttempts:        3,
+		initialBackoff:     50 * time.Millisecond,
+		maxBackoff:         time.Second,
+		budgetRatio:        0.1,
+		minRetriesPerSec:   10,
+		idempotentCommands: make(map[string]bool),
+	}
+	return policy.WithIdempotentCommands(
+		"DEL", "EXPIREAT", "HDEL", "HSET", "MSET", "PERSIST", "PEXPIREAT", "PSETEX", "SADD", "SET", "SETEX", "SREM",
+		"UNLINK", "ZREM",
+	)
+}
+
+// WithMaxAttempts sets the maximum number of attempts of a command, including its first attempt.
+func (policy *RetryPolicy) WithMaxAttempts(maxAttempts int) *RetryPolicy {
+	policy.maxAttempts = maxAttempts
+	return policy
+}
+
+// WithBackoff sets the backoff between the attempts of a command. The delay before the Nth retry is a random duration up
+// to initial * 2^(N-1), capped by max.
+func (policy *RetryPolicy) WithBackoff(initial time.Duration, max time.Duration) *RetryPolicy {
+	policy.initialBackoff = initial
+	policy.maxBackoff = max
+	return policy
+}
+
+// WithBudget sets the retry budget of the client: the retries within a sliding window of 10 seconds may not exceed the
+// given ratio of the commands eligible to retries, in addition to minRetriesPerSecond retries per second.
+func (policy *RetryPolicy) WithBudget(ratio float64, minRetriesPerSecond int) *RetryPolicy {
+	policy.budgetRatio = ratio
+	policy.minRetriesPerSec = minRetriesPerSecond
+	return policy
+}
+
+// WithIdempotentCommands adds writes which may be retried, for example "HSETNX" or "XGROUP DESTROY".
+func (policy *RetryPolicy) WithIdempotentCommands(commands ...string) *RetryPolicy {
+	for _, command := range commands {
+		policy.idempotentCommands[strings.ToUpper(command)] = true
+	}
+	return policy
+}
+
+// GetMaxAttempts returns the maximum number of attempts of a command, including its first attempt.
+func (policy *RetryPolicy) GetMaxAttempts() int {
+	return policy.maxAttempts
+}
+
+// GetBudget returns the ratio of the commands and the minimum number of retries per second of the retry budget.
+func (policy *RetryPolicy) GetBudget() (float64, int) {
+	return policy.budgetRatio, policy.minRetriesPerSec
+}
+
+// Backoff returns a random delay before the given retry of a command, starting from 1.
+func (policy *RetryPolicy) Backoff(retry int) time.Duration {
+	if policy.initialBackoff <= 0 {
+		return 0
+	}
+	backoff := policy.maxBackoff
+	if shift := retry - 1; shift < 63 && policy.initialBackoff <= policy.maxBackoff>>shift {
+		backoff = policy.initialBackoff << shift
+	}
+	return rand.N(backoff + 1)
+}
+
+// Retries reports whether a command may be retried: the idempotent writes, and the commands which do not write, except
+// the blocking, administrative, pub/sub and transaction commands.
+func (policy *RetryPolicy) Retries(request *CommandRequest) bool {
+	name := strings.ToUpper(request.Name)
+	if request.Custom && containerCommands[name] && len(request.Args) > 1 {
+		name += " " + strings.ToUpper(request.Args[1])
+	}
+	if name == "SET" && conditionalSet(request) {
+		return false
+	}
+	if policy.idempotentCommands[name] {
+		return true
+	}
+	list, known := commandCategories[name]
+	categories := strings.Fields(list)
+	if !known {
+		return false
+	}
+	for _, category := range []string{"write", "blocking", "admin", "pubsub", "transaction"} {
+		if slices.Contains(categories, category) {
+			return false
+		}
+	}
+	return true
+}
+
+// conditionalSet reports whether a SET has a condition or returns the previous value of the key, in which case it replies
+// as if it was not applied, or with its own value, after a previous attempt was applied.
+func conditionalSet(request *CommandRequest) bool {
+	args := request.Args
+	if request.Custom {
+		args = args[min(len(args), 1):]
+	}
+	// skip the key and the value
+	for _, arg := range args[min(len(args), 2):] {
+		switch strings.ToUpper(arg) {
+		case "NX", "XX", "IFEQ", "GET":
+			return true
+		}
+	}
+	return false
+}
+
+// GetRetryPolicy returns the retry policy of the client, or nil if its commands are not retried.
+func (config *baseClientConfiguration) GetRetryPolicy() *RetryPolicy {
+	return config.retryPolicy
+}
@@ -0,0 +1,75 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := NewRetryPolicy()
+	assert.Equal(t, 3, policy.GetMaxAttempts())
+	ratio, minPerSecond := policy.GetBudget()
+	assert.Equal(t, 0.1, ratio)
+	assert.Equal(t, 10, minPerSecond)
+
+	policy.WithMaxAttempts(5).WithBudget(0.2, 1)
+	assert.Equal(t, 5, policy.GetMaxAttempts())
+	ratio, minPerSecond = policy.GetBudget()
+	assert.Equal(t, 0.2, ratio)
+	assert.Equal(t, 1, minPerSecond)
+
+	assert.Nil(t, NewClientConfiguration().GetRetryPolicy())
+	assert.Same(t, policy, NewClientConfiguration().WithRetryPolicy(policy).GetRetryPolicy())
+	assert.Same(t, policy, NewClusterClientConfiguration().WithRetryPolicy(policy).GetRetryPolicy())
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := NewRetryPolicy().WithBackoff(10*time.Millisecond, 25*time.Millisecond)
+	for range 100 {
+		assert.LessOrEqual(t, policy.Backoff(1), 10*time.Millisecond)
+		assert.LessOrEqual(t, policy.Backoff(2), 20*time.Millisecond)
+		// the backoff is capped
+		assert.LessOrEqual(t, policy.Backoff(3), 25*time.Millisecond)
+		assert.LessOrEqual(t, policy.Backoff(100), 25*time.Millisecond)
+	}
+	assert.Zero(t, NewRetryPolicy().WithBackoff(0, time.Second).Backoff(1))
+}
+
+func TestRetryPolicy_Retries(t *testing.T) {
+	policy := NewRetryPolicy().WithIdempotentCommands("hsetnx")
+	for _, request := range []CommandRequest{
+		{Name: "GET", Args: []string{"key"}},
+		{Name: "PING"},
+		{Name: "XINFO STREAM", Args: []string{"key"}},
+		{Name: "object", Args: []string{"OBJECT", "ENCODING", "key"}, Custom: true},
+		// idempotent writes
+		{Name: "SET", Args: []string{"key", "value"}},
+		{Name: "SET", Args: []string{"key", "nx", "EX", "10"}},
+		{Name: "set", Args: []string{"SET", "key", "value", "KEEPTTL"}, Custom: true},
+		{Name: "DEL", Args: []string{"key"}},
+		{Name: "HSETNX", Args: []string{"key", "field", "value"}},
+	} {
+		assert.True(t, policy.Retries(&request), request.Name)
+	}
+	for _, request := range []CommandRequest{
+		{Name: "INCR", Args: []string{"key"}},
+		// conditional writes, whose reply differs when a previous attempt was applied
+		{Name: "SET", Args: []string{"key", "value", "NX"}},
+		{Name: "SET", Args: []string{"key", "value", "xx", "GET"}},
+		{Name: "SET", Args: []string{"key", "value", "IFEQ", "previous"}},
+		{Name: "set", Args: []string{"SET", "key", "value", "PX", "100", "NX"}, Custom: true},
+		{Name: "LPUSH", Args: []string{"key", "value"}},
+		{Name: "BLPOP", Args: []string{"key", "0"}},
+		{Name: "XREAD", Args: []string{"STREAMS", "key", "0"}},
+		{Name: "CONFIG SET", Args: []string{"maxmemory", "0"}},
+		{Name: "PUBLISH", Args: []string{"channel", "message"}},
+		{Name: "EXEC"},
+		{Name: "CUSTOM.GET", Args: []string{"CUSTOM.GET", "key"}, Custom: true},
+	} {
+		assert.False(t, policy.Retries(&request), request.Name)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+// SentinelConfiguration represents the Sentinel deployment which monitors the primary of a standalone client. The client
+// discovers the primary and the replicas of the monitored master from the sentinels, and follows the failovers announced
+// by them or found by periodic checks of the primary.
+type SentinelConfiguration struct {
+	masterName    string
+	addresses     []NodeAddress
+	credentials   *ServerCredentials
+	checkInterval time.Duration
+}
+
+// NewSentinelConfiguration returns a [SentinelConfiguration] of the master with the given name, as configured in the
+// sentinels. For further configuration, use the [SentinelConfiguration] With* methods.
+func NewSentinelConfiguration(masterName string) *SentinelConfiguration {
+	return &SentinelConfiguration{masterName: masterName, checkInterval: 10 * time.Second}
+}
+
+// WithAddress adds the address of a sentinel. WithAddress can be called multiple times to add multiple sentinels, which
+// are queried in order until one of them responds.
+func (sentinel *SentinelConfiguration) WithAddress(address *NodeAddress) *SentinelConfiguration {
+	sentinel.addresses = append(sentinel.addresses, *address)
+	return sentinel
+}
+
+// WithCredentials sets the credentials for authenticating with the sentinels. If none are set, the client does not
+// authenticate itself with the sentinels. The credentials of the primary and the replicas are set by
+// [ClientConfiguration.WithCredentials].
+func (sentinel *SentinelConfiguration) WithCredentials(credentials *ServerCredentials) *SentinelConfiguration {
+	sentinel.credentials = credentials
+	return sentinel
+}
+
+// WithCheckInterval sets the interval at which the client asks the sentinels for the address of the primary, and switches
+// to it if it changed. The checks follow the failovers whose announcement was missed, such as while the sentinel of the
+// client was unreachable, in which case the next sentinel is queried. A zero interval disables the checks. The default
+// is 10 seconds.
+func (sentinel *SentinelConfiguration) WithCheckInterval(interval time.Duration) *SentinelConfiguration {
+	sentinel.checkInterval = interval
+	return sentinel
+}
+
+// GetMasterName returns the name of the monitored master.
+func (sentinel *SentinelConfiguration) GetMasterName() string {
+	return sentinel.masterName
+}
+
+// GetAddresses returns the addresses of the sentinels.
+func (sentinel *SentinelConfiguration) GetAddresses() []NodeAddress {
+	return sentinel.addresses
+}
+
+// GetCredentials returns the credentials of the sentinels, or nil if the client does not authenticate with them.
+func (sentinel *SentinelConfiguration) GetCredentials() *ServerCredentials {
+	return sentinel.credentials
+}
+
+// GetCheckInterval returns the interval of the checks of the primary, or zero if they are disabled.
+func (sentinel *SentinelConfiguration) GetCheckInterval() time.Duration {
+	return sentinel.checkInterval
+}
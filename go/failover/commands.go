@@ -0,0 +1,1896 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package failover
+
+import (
+	"context"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+)
+
+// The commands below are sent to the cluster selected by the policy of the client, see [Policy].
+
+func (client *Client) Set(ctx context.Context, key string, value string) (string, error) {
+	return client.pick(command("SET")).Set(ctx, key, value)
+}
+
+func (client *Client) SetWithOptions(
+	ctx context.Context,
+	key string,
+	value string,
+	options options.SetOptions,
+) (models.Result[string], error) {
+	return client.pick(command("SET")).SetWithOptions(ctx, key, value, options)
+}
+
+func (client *Client) Get(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("GET")).Get(ctx, key)
+}
+
+func (client *Client) GetEx(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("GETEX")).GetEx(ctx, key)
+}
+
+func (client *Client) GetExWithOptions(
+	ctx context.Context,
+	key string,
+	options options.GetExOptions,
+) (models.Result[string], error) {
+	return client.pick(command("GETEX")).GetExWithOptions(ctx, key, options)
+}
+
+func (client *Client) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	return client.pick(command("MSET")).MSet(ctx, keyValueMap)
+}
+
+func (client *Client) MGet(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	return client.pick(command("MGET")).MGet(ctx, keys)
+}
+
+func (client *Client) MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error) {
+	return client.pick(command("MSETNX")).MSetNX(ctx, keyValueMap)
+}
+
+func (client *Client) Incr(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("INCR")).Incr(ctx, key)
+}
+
+func (client *Client) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	return client.pick(command("INCRBY")).IncrBy(ctx, key, amount)
+}
+
+func (client *Client) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
+	return client.pick(command("INCRBYFLOAT")).IncrByFloat(ctx, key, amount)
+}
+
+func (client *Client) Decr(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("DECR")).Decr(ctx, key)
+}
+
+func (client *Client) DecrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	return client.pick(command("DECRBY")).DecrBy(ctx, key, amount)
+}
+
+func (client *Client) Strlen(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("STRLEN")).Strlen(ctx, key)
+}
+
+func (client *Client) SetRange(ctx context.Context, key string, offset int, value string) (int64, error) {
+	return client.pick(command("SETRANGE")).SetRange(ctx, key, offset, value)
+}
+
+func (client *Client) GetRange(ctx context.Context, key string, start int, end int) (string, error) {
+	return client.pick(command("GETRANGE")).GetRange(ctx, key, start, end)
+}
+
+func (client *Client) Append(ctx context.Context, key string, value string) (int64, error) {
+	return client.pick(command("APPEND")).Append(ctx, key, value)
+}
+
+func (client *Client) LCS(ctx context.Context, key1 string, key2 string) (string, error) {
+	return client.pick(command("LCS")).LCS(ctx, key1, key2)
+}
+
+func (client *Client) LCSLen(ctx context.Context, key1 string, key2 string) (int64, error) {
+	return client.pick(command("LCS")).LCSLen(ctx, key1, key2)
+}
+
+func (client *Client) LCSWithOptions(
+	ctx context.Context,
+	key1, key2 string,
+	opts options.LCSIdxOptions,
+) (map[string]any, error) {
+	return client.pick(command("LCS")).LCSWithOptions(ctx, key1, key2, opts)
+}
+
+func (client *Client) GetDel(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("GETDEL")).GetDel(ctx, key)
+}
+
+func (client *Client) HGet(ctx context.Context, key string, field string) (models.Result[string], error) {
+	return client.pick(command("HGET")).HGet(ctx, key, field)
+}
+
+func (client *Client) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return client.pick(command("HGETALL")).HGetAll(ctx, key)
+}
+
+func (client *Client) HMGet(ctx context.Context, key string, fields []string) ([]models.Result[string], error) {
+	return client.pick(command("HMGET")).HMGet(ctx, key, fields)
+}
+
+func (client *Client) HSet(ctx context.Context, key string, values map[string]string) (int64, error) {
+	return client.pick(command("HSET")).HSet(ctx, key, values)
+}
+
+func (client *Client) HSetNX(ctx context.Context, key string, field string, value string) (bool, error) {
+	return client.pick(command("HSETNX")).HSetNX(ctx, key, field, value)
+}
+
+func (client *Client) HDel(ctx context.Context, key string, fields []string) (int64, error) {
+	return client.pick(command("HDEL")).HDel(ctx, key, fields)
+}
+
+func (client *Client) HLen(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("HLEN")).HLen(ctx, key)
+}
+
+func (client *Client) HVals(ctx context.Context, key string) ([]string, error) {
+	return client.pick(command("HVALS")).HVals(ctx, key)
+}
+
+func (client *Client) HExists(ctx context.Context, key string, field string) (bool, error) {
+	return client.pick(command("HEXISTS")).HExists(ctx, key, field)
+}
+
+func (client *Client) HKeys(ctx context.Context, key string) ([]string, error) {
+	return client.pick(command("HKEYS")).HKeys(ctx, key)
+}
+
+func (client *Client) HStrLen(ctx context.Context, key string, field string) (int64, error) {
+	return client.pick(command("HSTRLEN")).HStrLen(ctx, key, field)
+}
+
+func (client *Client) HIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error) {
+	return client.pick(command("HINCRBY")).HIncrBy(ctx, key, field, increment)
+}
+
+func (client *Client) HIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error) {
+	return client.pick(command("HINCRBYFLOAT")).HIncrByFloat(ctx, key, field, increment)
+}
+
+func (client *Client) HScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.pick(command("HSCAN")).HScan(ctx, key, cursor)
+}
+
+func (client *Client) HRandField(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("HRANDFIELD")).HRandField(ctx, key)
+}
+
+func (client *Client) HRandFieldWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.pick(command("HRANDFIELD")).HRandFieldWithCount(ctx, key, count)
+}
+
+func (client *Client) HRandFieldWithCountWithValues(ctx context.Context, key string, count int64) ([][]string, error) {
+	return client.pick(command("HRANDFIELD")).HRandFieldWithCountWithValues(ctx, key, count)
+}
+
+func (client *Client) HScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.HashScanOptions,
+) (string, []string, error) {
+	return client.pick(command("HSCAN")).HScanWithOptions(ctx, key, cursor, options)
+}
+
+func (client *Client) LPush(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.pick(command("LPUSH")).LPush(ctx, key, elements)
+}
+
+func (client *Client) LPop(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("LPOP")).LPop(ctx, key)
+}
+
+func (client *Client) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.pick(command("LPOP")).LPopCount(ctx, key, count)
+}
+
+func (client *Client) LPos(ctx context.Context, key string, element string) (models.Result[int64], error) {
+	return client.pick(command("LPOS")).LPos(ctx, key, element)
+}
+
+func (client *Client) LPosWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	options options.LPosOptions,
+) (models.Result[int64], error) {
+	return client.pick(command("LPOS")).LPosWithOptions(ctx, key, element, options)
+}
+
+func (client *Client) LPosCount(ctx context.Context, key string, element string, count int64) ([]int64, error) {
+	return client.pick(command("LPOS")).LPosCount(ctx, key, element, count)
+}
+
+func (client *Client) LPosCountWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	count int64,
+	options options.LPosOptions,
+) ([]int64, error) {
+	return client.pick(command("LPOS")).LPosCountWithOptions(ctx, key, element, count, options)
+}
+
+func (client *Client) RPush(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.pick(command("RPUSH")).RPush(ctx, key, elements)
+}
+
+func (client *Client) LRange(ctx context.Context, key string, start int64, end int64) ([]string, error) {
+	return client.pick(command("LRANGE")).LRange(ctx, key, start, end)
+}
+
+func (client *Client) LIndex(ctx context.Context, key string, index int64) (models.Result[string], error) {
+	return client.pick(command("LINDEX")).LIndex(ctx, key, index)
+}
+
+func (client *Client) LTrim(ctx context.Context, key string, start int64, end int64) (string, error) {
+	return client.pick(command("LTRIM")).LTrim(ctx, key, start, end)
+}
+
+func (client *Client) LLen(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("LLEN")).LLen(ctx, key)
+}
+
+func (client *Client) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
+	return client.pick(command("LREM")).LRem(ctx, key, count, element)
+}
+
+func (client *Client) RPop(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("RPOP")).RPop(ctx, key)
+}
+
+func (client *Client) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.pick(command("RPOP")).RPopCount(ctx, key, count)
+}
+
+func (client *Client) LInsert(
+	ctx context.Context,
+	key string,
+	insertPosition constants.InsertPosition,
+	pivot string,
+	element string,
+) (int64, error) {
+	return client.pick(command("LINSERT")).LInsert(ctx, key, insertPosition, pivot, element)
+}
+
+func (client *Client) BLPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	return client.pick(command("BLPOP")).BLPop(ctx, keys, timeoutSecs)
+}
+
+func (client *Client) BRPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	return client.pick(command("BRPOP")).BRPop(ctx, keys, timeoutSecs)
+}
+
+func (client *Client) RPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.pick(command("RPUSHX")).RPushX(ctx, key, elements)
+}
+
+func (client *Client) LPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.pick(command("LPUSHX")).LPushX(ctx, key, elements)
+}
+
+func (client *Client) LMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+) (map[string][]string, error) {
+	return client.pick(command("LMPOP")).LMPop(ctx, keys, listDirection)
+}
+
+func (client *Client) LMPopCount(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+) (map[string][]string, error) {
+	return client.pick(command("LMPOP")).LMPopCount(ctx, keys, listDirection, count)
+}
+
+func (client *Client) BLMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	timeoutSecs float64,
+) (map[string][]string, error) {
+	return client.pick(command("BLMPOP")).BLMPop(ctx, keys, listDirection, timeoutSecs)
+}
+
+func (client *Client) BLMPopCount(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+	timeoutSecs float64,
+) (map[string][]string, error) {
+	return client.pick(command("BLMPOP")).BLMPopCount(ctx, keys, listDirection, count, timeoutSecs)
+}
+
+func (client *Client) LSet(ctx context.Context, key string, index int64, element string) (string, error) {
+	return client.pick(command("LSET")).LSet(ctx, key, index, element)
+}
+
+func (client *Client) LMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+) (models.Result[string], error) {
+	return client.pick(command("LMOVE")).LMove(ctx, source, destination, whereFrom, whereTo)
+}
+
+func (client *Client) BLMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+	timeoutSecs float64,
+) (models.Result[string], error) {
+	return client.pick(command("BLMOVE")).BLMove(ctx, source, destination, whereFrom, whereTo, timeoutSecs)
+}
+
+func (client *Client) SAdd(ctx context.Context, key string, members []string) (int64, error) {
+	return client.pick(command("SADD")).SAdd(ctx, key, members)
+}
+
+func (client *Client) SRem(ctx context.Context, key string, members []string) (int64, error) {
+	return client.pick(command("SREM")).SRem(ctx, key, members)
+}
+
+func (client *Client) SMembers(ctx context.Context, key string) (map[string]struct{}, error) {
+	return client.pick(command("SMEMBERS")).SMembers(ctx, key)
+}
+
+func (client *Client) SCard(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("SCARD")).SCard(ctx, key)
+}
+
+func (client *Client) SIsMember(ctx context.Context, key string, member string) (bool, error) {
+	return client.pick(command("SISMEMBER")).SIsMember(ctx, key, member)
+}
+
+func (client *Client) SDiff(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return client.pick(command("SDIFF")).SDiff(ctx, keys)
+}
+
+func (client *Client) SDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.pick(command("SDIFFSTORE")).SDiffStore(ctx, destination, keys)
+}
+
+func (client *Client) SInter(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return client.pick(command("SINTER")).SInter(ctx, keys)
+}
+
+func (client *Client) SInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.pick(command("SINTERSTORE")).SInterStore(ctx, destination, keys)
+}
+
+func (client *Client) SInterCard(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("SINTERCARD")).SInterCard(ctx, keys)
+}
+
+func (client *Client) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
+	return client.pick(command("SINTERCARD")).SInterCardLimit(ctx, keys, limit)
+}
+
+func (client *Client) SRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("SRANDMEMBER")).SRandMember(ctx, key)
+}
+
+func (client *Client) SRandMemberCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.pick(command("SRANDMEMBER")).SRandMemberCount(ctx, key, count)
+}
+
+func (client *Client) SPop(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("SPOP")).SPop(ctx, key)
+}
+
+func (client *Client) SPopCount(ctx context.Context, key string, count int64) (map[string]struct{}, error) {
+	return client.pick(command("SPOP")).SPopCount(ctx, key, count)
+}
+
+func (client *Client) SMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
+	return client.pick(command("SMISMEMBER")).SMIsMember(ctx, key, members)
+}
+
+func (client *Client) SUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.pick(command("SUNIONSTORE")).SUnionStore(ctx, destination, keys)
+}
+
+func (client *Client) SUnion(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return client.pick(command("SUNION")).SUnion(ctx, keys)
+}
+
+func (client *Client) SScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.pick(command("SSCAN")).SScan(ctx, key, cursor)
+}
+
+func (client *Client) SScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.BaseScanOptions,
+) (string, []string, error) {
+	return client.pick(command("SSCAN")).SScanWithOptions(ctx, key, cursor, options)
+}
+
+func (client *Client) SMove(ctx context.Context, source string, destination string, member string) (bool, error) {
+	return client.pick(command("SMOVE")).SMove(ctx, source, destination, member)
+}
+
+func (client *Client) XAdd(ctx context.Context, key string, values [][]string) (models.Result[string], error) {
+	return client.pick(command("XADD")).XAdd(ctx, key, values)
+}
+
+func (client *Client) XAddWithOptions(
+	ctx context.Context,
+	key string,
+	values [][]string,
+	options options.XAddOptions,
+) (models.Result[string], error) {
+	return client.pick(command("XADD")).XAddWithOptions(ctx, key, values, options)
+}
+
+func (client *Client) XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error) {
+	return client.pick(command("XTRIM")).XTrim(ctx, key, options)
+}
+
+func (client *Client) XLen(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("XLEN")).XLen(ctx, key)
+}
+
+func (client *Client) XAutoClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (models.XAutoClaimResponse, error) {
+	return client.pick(command("XAUTOCLAIM")).XAutoClaim(ctx, key, group, consumer, minIdleTime, start)
+}
+
+func (client *Client) XAutoClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimResponse, error) {
+	return client.pick(command("XAUTOCLAIM")).XAutoClaimWithOptions(ctx, key, group, consumer, minIdleTime, start, options)
+}
+
+func (client *Client) XAutoClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (models.XAutoClaimJustIdResponse, error) {
+	return client.pick(command("XAUTOCLAIM")).XAutoClaimJustId(ctx, key, group, consumer, minIdleTime, start)
+}
+
+func (client *Client) XAutoClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimJustIdResponse, error) {
+	return client.pick(command("XAUTOCLAIM")).XAutoClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, start, options)
+}
+
+func (client *Client) XReadGroup(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+) (map[string]map[string][][]string, error) {
+	return client.pick(command("XREADGROUP")).XReadGroup(ctx, group, consumer, keysAndIds)
+}
+
+func (client *Client) XReadGroupWithOptions(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+	options options.XReadGroupOptions,
+) (map[string]map[string][][]string, error) {
+	return client.pick(command("XREADGROUP")).XReadGroupWithOptions(ctx, group, consumer, keysAndIds, options)
+}
+
+func (client *Client) XRead(ctx context.Context, keysAndIds map[string]string) (map[string]map[string][][]string, error) {
+	return client.pick(command("XREAD")).XRead(ctx, keysAndIds)
+}
+
+func (client *Client) XReadWithOptions(
+	ctx context.Context,
+	keysAndIds map[string]string,
+	options options.XReadOptions,
+) (map[string]map[string][][]string, error) {
+	return client.pick(command("XREAD")).XReadWithOptions(ctx, keysAndIds, options)
+}
+
+func (client *Client) XDel(ctx context.Context, key string, ids []string) (int64, error) {
+	return client.pick(command("XDEL")).XDel(ctx, key, ids)
+}
+
+func (client *Client) XPending(ctx context.Context, key string, group string) (models.XPendingSummary, error) {
+	return client.pick(command("XPENDING")).XPending(ctx, key, group)
+}
+
+func (client *Client) XPendingWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	options options.XPendingOptions,
+) ([]models.XPendingDetail, error) {
+	return client.pick(command("XPENDING")).XPendingWithOptions(ctx, key, group, options)
+}
+
+func (client *Client) XGroupSetId(ctx context.Context, key string, group string, id string) (string, error) {
+	return client.pick(command("XGROUP SETID")).XGroupSetId(ctx, key, group, id)
+}
+
+func (client *Client) XGroupSetIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupSetIdOptions,
+) (string, error) {
+	return client.pick(command("XGROUP SETID")).XGroupSetIdWithOptions(ctx, key, group, id, opts)
+}
+
+func (client *Client) XGroupCreate(ctx context.Context, key string, group string, id string) (string, error) {
+	return client.pick(command("XGROUP CREATE")).XGroupCreate(ctx, key, group, id)
+}
+
+func (client *Client) XGroupCreateWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupCreateOptions,
+) (string, error) {
+	return client.pick(command("XGROUP CREATE")).XGroupCreateWithOptions(ctx, key, group, id, opts)
+}
+
+func (client *Client) XGroupDestroy(ctx context.Context, key string, group string) (bool, error) {
+	return client.pick(command("XGROUP DESTROY")).XGroupDestroy(ctx, key, group)
+}
+
+func (client *Client) XGroupCreateConsumer(ctx context.Context, key string, group string, consumer string) (bool, error) {
+	return client.pick(command("XGROUP CREATECONSUMER")).XGroupCreateConsumer(ctx, key, group, consumer)
+}
+
+func (client *Client) XGroupDelConsumer(ctx context.Context, key string, group string, consumer string) (int64, error) {
+	return client.pick(command("XGROUP DELCONSUMER")).XGroupDelConsumer(ctx, key, group, consumer)
+}
+
+func (client *Client) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
+	return client.pick(command("XACK")).XAck(ctx, key, group, ids)
+}
+
+func (client *Client) XClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) (map[string][][]string, error) {
+	return client.pick(command("XCLAIM")).XClaim(ctx, key, group, consumer, minIdleTime, ids)
+}
+
+func (client *Client) XClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	options options.XClaimOptions,
+) (map[string][][]string, error) {
+	return client.pick(command("XCLAIM")).XClaimWithOptions(ctx, key, group, consumer, minIdleTime, ids, options)
+}
+
+func (client *Client) XClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) ([]string, error) {
+	return client.pick(command("XCLAIM")).XClaimJustId(ctx, key, group, consumer, minIdleTime, ids)
+}
+
+func (client *Client) XClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	options options.XClaimOptions,
+) ([]string, error) {
+	return client.pick(command("XCLAIM")).XClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, ids, options)
+}
+
+func (client *Client) XInfoStream(ctx context.Context, key string) (map[string]any, error) {
+	return client.pick(command("XINFO STREAM")).XInfoStream(ctx, key)
+}
+
+func (client *Client) XInfoStreamFullWithOptions(
+	ctx context.Context,
+	key string,
+	options *options.XInfoStreamOptions,
+) (map[string]any, error) {
+	return client.pick(command("XINFO STREAM")).XInfoStreamFullWithOptions(ctx, key, options)
+}
+
+func (client *Client) XInfoConsumers(ctx context.Context, key string, group string) ([]models.XInfoConsumerInfo, error) {
+	return client.pick(command("XINFO CONSUMERS")).XInfoConsumers(ctx, key, group)
+}
+
+func (client *Client) XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error) {
+	return client.pick(command("XINFO GROUPS")).XInfoGroups(ctx, key)
+}
+
+func (client *Client) XRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.XRangeResponse, error) {
+	return client.pick(command("XRANGE")).XRange(ctx, key, start, end)
+}
+
+func (client *Client) XRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	options options.XRangeOptions,
+) ([]models.XRangeResponse, error) {
+	return client.pick(command("XRANGE")).XRangeWithOptions(ctx, key, start, end, options)
+}
+
+func (client *Client) XRevRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.XRangeResponse, error) {
+	return client.pick(command("XREVRANGE")).XRevRange(ctx, key, start, end)
+}
+
+func (client *Client) XRevRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	options options.XRangeOptions,
+) ([]models.XRangeResponse, error) {
+	return client.pick(command("XREVRANGE")).XRevRangeWithOptions(ctx, key, start, end, options)
+}
+
+func (client *Client) ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error) {
+	return client.pick(command("ZADD")).ZAdd(ctx, key, membersScoreMap)
+}
+
+func (client *Client) ZAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersScoreMap map[string]float64,
+	opts options.ZAddOptions,
+) (int64, error) {
+	return client.pick(command("ZADD")).ZAddWithOptions(ctx, key, membersScoreMap, opts)
+}
+
+func (client *Client) ZAddIncr(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+) (models.Result[float64], error) {
+	return client.pick(command("ZADD")).ZAddIncr(ctx, key, member, increment)
+}
+
+func (client *Client) ZAddIncrWithOptions(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+	opts options.ZAddOptions,
+) (models.Result[float64], error) {
+	return client.pick(command("ZADD")).ZAddIncrWithOptions(ctx, key, member, increment, opts)
+}
+
+func (client *Client) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+	return client.pick(command("ZINCRBY")).ZIncrBy(ctx, key, increment, member)
+}
+
+func (client *Client) ZPopMin(ctx context.Context, key string) (map[string]float64, error) {
+	return client.pick(command("ZPOPMIN")).ZPopMin(ctx, key)
+}
+
+func (client *Client) ZPopMinWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	return client.pick(command("ZPOPMIN")).ZPopMinWithOptions(ctx, key, options)
+}
+
+func (client *Client) ZPopMax(ctx context.Context, key string) (map[string]float64, error) {
+	return client.pick(command("ZPOPMAX")).ZPopMax(ctx, key)
+}
+
+func (client *Client) ZPopMaxWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	return client.pick(command("ZPOPMAX")).ZPopMaxWithOptions(ctx, key, options)
+}
+
+func (client *Client) ZRem(ctx context.Context, key string, members []string) (int64, error) {
+	return client.pick(command("ZREM")).ZRem(ctx, key, members)
+}
+
+func (client *Client) ZCard(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("ZCARD")).ZCard(ctx, key)
+}
+
+func (client *Client) BZPopMin(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	return client.pick(command("BZPOPMIN")).BZPopMin(ctx, keys, timeoutSecs)
+}
+
+func (client *Client) BZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.pick(command("BZMPOP")).BZMPop(ctx, keys, scoreFilter, timeoutSecs)
+}
+
+func (client *Client) BZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeoutSecs float64,
+	options options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.pick(command("BZMPOP")).BZMPopWithOptions(ctx, keys, scoreFilter, timeoutSecs, options)
+}
+
+func (client *Client) ZRange(ctx context.Context, key string, rangeQuery options.ZRangeQuery) ([]string, error) {
+	return client.pick(command("ZRANGE")).ZRange(ctx, key, rangeQuery)
+}
+
+func (client *Client) BZPopMax(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	return client.pick(command("BZPOPMAX")).BZPopMax(ctx, keys, timeoutSecs)
+}
+
+func (client *Client) ZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.pick(command("ZMPOP")).ZMPop(ctx, keys, scoreFilter)
+}
+
+func (client *Client) ZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	opts options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.pick(command("ZMPOP")).ZMPopWithOptions(ctx, keys, scoreFilter, opts)
+}
+
+func (client *Client) ZRangeWithScores(
+	ctx context.Context,
+	key string,
+	rangeQuery options.ZRangeQueryWithScores,
+) ([]models.MemberAndScore, error) {
+	return client.pick(command("ZRANGE")).ZRangeWithScores(ctx, key, rangeQuery)
+}
+
+func (client *Client) ZRangeStore(
+	ctx context.Context,
+	destination string,
+	key string,
+	rangeQuery options.ZRangeQuery,
+) (int64, error) {
+	return client.pick(command("ZRANGESTORE")).ZRangeStore(ctx, destination, key, rangeQuery)
+}
+
+func (client *Client) ZRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	return client.pick(command("ZRANK")).ZRank(ctx, key, member)
+}
+
+func (client *Client) ZRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (models.Result[int64], models.Result[float64], error) {
+	return client.pick(command("ZRANK")).ZRankWithScore(ctx, key, member)
+}
+
+func (client *Client) ZRevRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	return client.pick(command("ZREVRANK")).ZRevRank(ctx, key, member)
+}
+
+func (client *Client) ZRevRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (models.Result[int64], models.Result[float64], error) {
+	return client.pick(command("ZREVRANK")).ZRevRankWithScore(ctx, key, member)
+}
+
+func (client *Client) ZScore(ctx context.Context, key string, member string) (models.Result[float64], error) {
+	return client.pick(command("ZSCORE")).ZScore(ctx, key, member)
+}
+
+func (client *Client) ZCount(ctx context.Context, key string, rangeOptions options.ZCountRange) (int64, error) {
+	return client.pick(command("ZCOUNT")).ZCount(ctx, key, rangeOptions)
+}
+
+func (client *Client) ZScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.pick(command("ZSCAN")).ZScan(ctx, key, cursor)
+}
+
+func (client *Client) ZScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.ZScanOptions,
+) (string, []string, error) {
+	return client.pick(command("ZSCAN")).ZScanWithOptions(ctx, key, cursor, options)
+}
+
+func (client *Client) ZRemRangeByLex(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
+	return client.pick(command("ZREMRANGEBYLEX")).ZRemRangeByLex(ctx, key, rangeQuery)
+}
+
+func (client *Client) ZRemRangeByRank(ctx context.Context, key string, start int64, stop int64) (int64, error) {
+	return client.pick(command("ZREMRANGEBYRANK")).ZRemRangeByRank(ctx, key, start, stop)
+}
+
+func (client *Client) ZRemRangeByScore(ctx context.Context, key string, rangeQuery options.RangeByScore) (int64, error) {
+	return client.pick(command("ZREMRANGEBYSCORE")).ZRemRangeByScore(ctx, key, rangeQuery)
+}
+
+func (client *Client) ZDiff(ctx context.Context, keys []string) ([]string, error) {
+	return client.pick(command("ZDIFF")).ZDiff(ctx, keys)
+}
+
+func (client *Client) ZDiffWithScores(ctx context.Context, keys []string) ([]models.MemberAndScore, error) {
+	return client.pick(command("ZDIFF")).ZDiffWithScores(ctx, keys)
+}
+
+func (client *Client) ZRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("ZRANDMEMBER")).ZRandMember(ctx, key)
+}
+
+func (client *Client) ZRandMemberWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.pick(command("ZRANDMEMBER")).ZRandMemberWithCount(ctx, key, count)
+}
+
+func (client *Client) ZRandMemberWithCountWithScores(
+	ctx context.Context,
+	key string,
+	count int64,
+) ([]models.MemberAndScore, error) {
+	return client.pick(command("ZRANDMEMBER")).ZRandMemberWithCountWithScores(ctx, key, count)
+}
+
+func (client *Client) ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error) {
+	return client.pick(command("ZMSCORE")).ZMScore(ctx, key, members)
+}
+
+func (client *Client) ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.pick(command("ZDIFFSTORE")).ZDiffStore(ctx, destination, keys)
+}
+
+func (client *Client) ZInter(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	return client.pick(command("ZINTER")).ZInter(ctx, keys)
+}
+
+func (client *Client) ZInterWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options options.ZInterOptions,
+) ([]models.MemberAndScore, error) {
+	return client.pick(command("ZINTER")).ZInterWithScores(ctx, keysOrWeightedKeys, options)
+}
+
+func (client *Client) ZInterStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return client.pick(command("ZINTERSTORE")).ZInterStore(ctx, destination, keysOrWeightedKeys)
+}
+
+func (client *Client) ZInterStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options options.ZInterOptions,
+) (int64, error) {
+	return client.pick(command("ZINTERSTORE")).ZInterStoreWithOptions(ctx, destination, keysOrWeightedKeys, options)
+}
+
+func (client *Client) ZUnion(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	return client.pick(command("ZUNION")).ZUnion(ctx, keys)
+}
+
+func (client *Client) ZUnionWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options *options.ZUnionOptions,
+) ([]models.MemberAndScore, error) {
+	return client.pick(command("ZUNION")).ZUnionWithScores(ctx, keysOrWeightedKeys, options)
+}
+
+func (client *Client) ZUnionStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return client.pick(command("ZUNIONSTORE")).ZUnionStore(ctx, destination, keysOrWeightedKeys)
+}
+
+func (client *Client) ZUnionStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zUnionOptions *options.ZUnionOptions,
+) (int64, error) {
+	return client.pick(command("ZUNIONSTORE")).ZUnionStoreWithOptions(ctx, destination, keysOrWeightedKeys, zUnionOptions)
+}
+
+func (client *Client) ZInterCard(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("ZINTERCARD")).ZInterCard(ctx, keys)
+}
+
+func (client *Client) ZInterCardWithOptions(
+	ctx context.Context,
+	keys []string,
+	options *options.ZInterCardOptions,
+) (int64, error) {
+	return client.pick(command("ZINTERCARD")).ZInterCardWithOptions(ctx, keys, options)
+}
+
+func (client *Client) ZLexCount(ctx context.Context, key string, rangeQuery *options.RangeByLex) (int64, error) {
+	return client.pick(command("ZLEXCOUNT")).ZLexCount(ctx, key, rangeQuery)
+}
+
+func (client *Client) PfAdd(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.pick(command("PFADD")).PfAdd(ctx, key, elements)
+}
+
+func (client *Client) PfCount(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("PFCOUNT")).PfCount(ctx, keys)
+}
+
+func (client *Client) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	return client.pick(command("PFMERGE")).PfMerge(ctx, destination, sourceKeys)
+}
+
+func (client *Client) Del(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("DEL")).Del(ctx, keys)
+}
+
+func (client *Client) Exists(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("EXISTS")).Exists(ctx, keys)
+}
+
+func (client *Client) Expire(ctx context.Context, key string, seconds int64) (bool, error) {
+	return client.pick(command("EXPIRE")).Expire(ctx, key, seconds)
+}
+
+func (client *Client) ExpireWithOptions(
+	ctx context.Context,
+	key string,
+	seconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.pick(command("EXPIRE")).ExpireWithOptions(ctx, key, seconds, expireCondition)
+}
+
+func (client *Client) ExpireAt(ctx context.Context, key string, unixTimestampInSeconds int64) (bool, error) {
+	return client.pick(command("EXPIREAT")).ExpireAt(ctx, key, unixTimestampInSeconds)
+}
+
+func (client *Client) ExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	unixTimestampInSeconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.pick(command("EXPIREAT")).ExpireAtWithOptions(ctx, key, unixTimestampInSeconds, expireCondition)
+}
+
+func (client *Client) PExpire(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	return client.pick(command("PEXPIRE")).PExpire(ctx, key, milliseconds)
+}
+
+func (client *Client) PExpireWithOptions(
+	ctx context.Context,
+	key string,
+	milliseconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.pick(command("PEXPIRE")).PExpireWithOptions(ctx, key, milliseconds, expireCondition)
+}
+
+func (client *Client) PExpireAt(ctx context.Context, key string, unixTimestampInMilliSeconds int64) (bool, error) {
+	return client.pick(command("PEXPIREAT")).PExpireAt(ctx, key, unixTimestampInMilliSeconds)
+}
+
+func (client *Client) PExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	unixTimestampInMilliSeconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.pick(command("PEXPIREAT")).PExpireAtWithOptions(ctx, key, unixTimestampInMilliSeconds, expireCondition)
+}
+
+func (client *Client) ExpireTime(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("EXPIRETIME")).ExpireTime(ctx, key)
+}
+
+func (client *Client) PExpireTime(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("PEXPIRETIME")).PExpireTime(ctx, key)
+}
+
+func (client *Client) TTL(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("TTL")).TTL(ctx, key)
+}
+
+func (client *Client) PTTL(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("PTTL")).PTTL(ctx, key)
+}
+
+func (client *Client) Unlink(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("UNLINK")).Unlink(ctx, keys)
+}
+
+func (client *Client) Touch(ctx context.Context, keys []string) (int64, error) {
+	return client.pick(command("TOUCH")).Touch(ctx, keys)
+}
+
+func (client *Client) Type(ctx context.Context, key string) (string, error) {
+	return client.pick(command("TYPE")).Type(ctx, key)
+}
+
+func (client *Client) Rename(ctx context.Context, key string, newKey string) (string, error) {
+	return client.pick(command("RENAME")).Rename(ctx, key, newKey)
+}
+
+func (client *Client) RenameNX(ctx context.Context, key string, newKey string) (bool, error) {
+	return client.pick(command("RENAMENX")).RenameNX(ctx, key, newKey)
+}
+
+func (client *Client) Persist(ctx context.Context, key string) (bool, error) {
+	return client.pick(command("PERSIST")).Persist(ctx, key)
+}
+
+func (client *Client) Restore(ctx context.Context, key string, ttl int64, value string) (string, error) {
+	return client.pick(command("RESTORE")).Restore(ctx, key, ttl, value)
+}
+
+func (client *Client) RestoreWithOptions(
+	ctx context.Context,
+	key string,
+	ttl int64,
+	value string,
+	option options.RestoreOptions,
+) (string, error) {
+	return client.pick(command("RESTORE")).RestoreWithOptions(ctx, key, ttl, value, option)
+}
+
+func (client *Client) ObjectEncoding(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("OBJECT ENCODING")).ObjectEncoding(ctx, key)
+}
+
+func (client *Client) Dump(ctx context.Context, key string) (models.Result[string], error) {
+	return client.pick(command("DUMP")).Dump(ctx, key)
+}
+
+func (client *Client) ObjectFreq(ctx context.Context, key string) (models.Result[int64], error) {
+	return client.pick(command("OBJECT FREQ")).ObjectFreq(ctx, key)
+}
+
+func (client *Client) ObjectIdleTime(ctx context.Context, key string) (models.Result[int64], error) {
+	return client.pick(command("OBJECT IDLETIME")).ObjectIdleTime(ctx, key)
+}
+
+func (client *Client) ObjectRefCount(ctx context.Context, key string) (models.Result[int64], error) {
+	return client.pick(command("OBJECT REFCOUNT")).ObjectRefCount(ctx, key)
+}
+
+func (client *Client) Sort(ctx context.Context, key string) ([]models.Result[string], error) {
+	return client.pick(command("SORT")).Sort(ctx, key)
+}
+
+func (client *Client) SortWithOptions(
+	ctx context.Context,
+	key string,
+	sortOptions options.SortOptions,
+) ([]models.Result[string], error) {
+	return client.pick(command("SORT")).SortWithOptions(ctx, key, sortOptions)
+}
+
+func (client *Client) SortStore(ctx context.Context, key string, destination string) (int64, error) {
+	return client.pick(command("SORT")).SortStore(ctx, key, destination)
+}
+
+func (client *Client) SortStoreWithOptions(
+	ctx context.Context,
+	key string,
+	destination string,
+	sortOptions options.SortOptions,
+) (int64, error) {
+	return client.pick(command("SORT")).SortStoreWithOptions(ctx, key, destination, sortOptions)
+}
+
+func (client *Client) SortReadOnly(ctx context.Context, key string) ([]models.Result[string], error) {
+	return client.pick(command("SORT_RO")).SortReadOnly(ctx, key)
+}
+
+func (client *Client) SortReadOnlyWithOptions(
+	ctx context.Context,
+	key string,
+	sortOptions options.SortOptions,
+) ([]models.Result[string], error) {
+	return client.pick(command("SORT_RO")).SortReadOnlyWithOptions(ctx, key, sortOptions)
+}
+
+func (client *Client) Wait(ctx context.Context, numberOfReplicas int64, timeout int64) (int64, error) {
+	return client.pick(command("WAIT")).Wait(ctx, numberOfReplicas, timeout)
+}
+
+func (client *Client) Copy(ctx context.Context, source string, destination string) (bool, error) {
+	return client.pick(command("COPY")).Copy(ctx, source, destination)
+}
+
+func (client *Client) CopyWithOptions(
+	ctx context.Context,
+	source string,
+	destination string,
+	option options.CopyOptions,
+) (bool, error) {
+	return client.pick(command("COPY")).CopyWithOptions(ctx, source, destination, option)
+}
+
+func (client *Client) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	return client.pick(command("SETBIT")).SetBit(ctx, key, offset, value)
+}
+
+func (client *Client) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	return client.pick(command("GETBIT")).GetBit(ctx, key, offset)
+}
+
+func (client *Client) BitCount(ctx context.Context, key string) (int64, error) {
+	return client.pick(command("BITCOUNT")).BitCount(ctx, key)
+}
+
+func (client *Client) BitCountWithOptions(ctx context.Context, key string, options options.BitCountOptions) (int64, error) {
+	return client.pick(command("BITCOUNT")).BitCountWithOptions(ctx, key, options)
+}
+
+func (client *Client) BitPos(ctx context.Context, key string, bit int64) (int64, error) {
+	return client.pick(command("BITPOS")).BitPos(ctx, key, bit)
+}
+
+func (client *Client) BitPosWithOptions(
+	ctx context.Context,
+	key string,
+	bit int64,
+	options options.BitPosOptions,
+) (int64, error) {
+	return client.pick(command("BITPOS")).BitPosWithOptions(ctx, key, bit, options)
+}
+
+func (client *Client) BitField(
+	ctx context.Context,
+	key string,
+	subCommands []options.BitFieldSubCommands,
+) ([]models.Result[int64], error) {
+	return client.pick(command("BITFIELD")).BitField(ctx, key, subCommands)
+}
+
+func (client *Client) BitFieldRO(
+	ctx context.Context,
+	key string,
+	commands []options.BitFieldROCommands,
+) ([]models.Result[int64], error) {
+	return client.pick(command("BITFIELD_RO")).BitFieldRO(ctx, key, commands)
+}
+
+func (client *Client) BitOp(
+	ctx context.Context,
+	bitwiseOperation options.BitOpType,
+	destination string,
+	keys []string,
+) (int64, error) {
+	return client.pick(command("BITOP")).BitOp(ctx, bitwiseOperation, destination, keys)
+}
+
+func (client *Client) GeoAdd(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+) (int64, error) {
+	return client.pick(command("GEOADD")).GeoAdd(ctx, key, membersToGeospatialData)
+}
+
+func (client *Client) GeoAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+	options options.GeoAddOptions,
+) (int64, error) {
+	return client.pick(command("GEOADD")).GeoAddWithOptions(ctx, key, membersToGeospatialData, options)
+}
+
+func (client *Client) GeoHash(ctx context.Context, key string, members []string) ([]string, error) {
+	return client.pick(command("GEOHASH")).GeoHash(ctx, key, members)
+}
+
+func (client *Client) GeoPos(ctx context.Context, key string, members []string) ([][]float64, error) {
+	return client.pick(command("GEOPOS")).GeoPos(ctx, key, members)
+}
+
+func (client *Client) GeoDist(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+) (models.Result[float64], error) {
+	return client.pick(command("GEODIST")).GeoDist(ctx, key, member1, member2)
+}
+
+func (client *Client) GeoDistWithUnit(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+	unit constants.GeoUnit,
+) (models.Result[float64], error) {
+	return client.pick(command("GEODIST")).GeoDistWithUnit(ctx, key, member1, member2, unit)
+}
+
+func (client *Client) GeoSearch(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) ([]string, error) {
+	return client.pick(command("GEOSEARCH")).GeoSearch(ctx, key, searchFrom, searchByShape)
+}
+
+func (client *Client) GeoSearchWithInfoOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return client.pick(command("GEOSEARCH")).GeoSearchWithInfoOptions(ctx, key, searchFrom, searchByShape, infoOptions)
+}
+
+func (client *Client) GeoSearchWithResultOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) ([]string, error) {
+	return client.pick(command("GEOSEARCH")).GeoSearchWithResultOptions(ctx, key, searchFrom, searchByShape, resultOptions)
+}
+
+func (client *Client) GeoSearchWithFullOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return client.pick(command("GEOSEARCH")).GeoSearchWithFullOptions(
+		ctx,
+		key,
+		searchFrom,
+		searchByShape,
+		resultOptions,
+		infoOptions,
+	)
+}
+
+func (client *Client) GeoSearchStore(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) (int64, error) {
+	return client.pick(command("GEOSEARCHSTORE")).GeoSearchStore(ctx, destinationKey, sourceKey, searchFrom, searchByShape)
+}
+
+func (client *Client) GeoSearchStoreWithInfoOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	storeInfoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return client.pick(command("GEOSEARCHSTORE")).GeoSearchStoreWithInfoOptions(
+		ctx,
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		storeInfoOptions,
+	)
+}
+
+func (client *Client) GeoSearchStoreWithResultOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) (int64, error) {
+	return client.pick(command("GEOSEARCHSTORE")).GeoSearchStoreWithResultOptions(
+		ctx,
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		resultOptions,
+	)
+}
+
+func (client *Client) GeoSearchStoreWithFullOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	storeInfoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return client.pick(command("GEOSEARCHSTORE")).GeoSearchStoreWithFullOptions(
+		ctx,
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		resultOptions,
+		storeInfoOptions,
+	)
+}
+
+func (client *Client) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
+	return client.pick(command("FUNCTION LOAD")).FunctionLoad(ctx, libraryCode, replace)
+}
+
+func (client *Client) FunctionFlush(ctx context.Context) (string, error) {
+	return client.pick(command("FUNCTION FLUSH")).FunctionFlush(ctx)
+}
+
+func (client *Client) FunctionFlushSync(ctx context.Context) (string, error) {
+	return client.pick(command("FUNCTION FLUSH")).FunctionFlushSync(ctx)
+}
+
+func (client *Client) FunctionFlushAsync(ctx context.Context) (string, error) {
+	return client.pick(command("FUNCTION FLUSH")).FunctionFlushAsync(ctx)
+}
+
+func (client *Client) FCall(ctx context.Context, function string) (any, error) {
+	return client.pick(command("FCALL")).FCall(ctx, function)
+}
+
+func (client *Client) FCallReadOnly(ctx context.Context, function string) (any, error) {
+	return client.pick(command("FCALL_RO")).FCallReadOnly(ctx, function)
+}
+
+func (client *Client) FCallWithKeysAndArgs(ctx context.Context, function string, keys []string, args []string) (any, error) {
+	return client.pick(command("FCALL")).FCallWithKeysAndArgs(ctx, function, keys, args)
+}
+
+func (client *Client) FCallReadOnlyWithKeysAndArgs(
+	ctx context.Context,
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	return client.pick(command("FCALL_RO")).FCallReadOnlyWithKeysAndArgs(ctx, function, keys, args)
+}
+
+func (client *Client) InvokeScript(ctx context.Context, script options.Script) (any, error) {
+	return client.pick(command("EVALSHA")).InvokeScript(ctx, script)
+}
+
+func (client *Client) InvokeScriptWithOptions(
+	ctx context.Context,
+	script options.Script,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return client.pick(command("EVALSHA")).InvokeScriptWithOptions(ctx, script, scriptOptions)
+}
+
+func (client *Client) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
+	return client.pick(command("SCRIPT EXISTS")).ScriptExists(ctx, sha1s)
+}
+
+func (client *Client) ScriptFlush(ctx context.Context) (string, error) {
+	return client.pick(command("SCRIPT FLUSH")).ScriptFlush(ctx)
+}
+
+func (client *Client) ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error) {
+	return client.pick(command("SCRIPT FLUSH")).ScriptFlushWithMode(ctx, mode)
+}
+
+func (client *Client) ScriptShow(ctx context.Context, sha1 string) (string, error) {
+	return client.pick(command("SCRIPT SHOW")).ScriptShow(ctx, sha1)
+}
+
+func (client *Client) ScriptKill(ctx context.Context) (string, error) {
+	return client.pick(command("SCRIPT KILL")).ScriptKill(ctx)
+}
+
+func (client *Client) PubSubChannels(ctx context.Context) ([]string, error) {
+	return client.pick(command("PUBSUB CHANNELS")).PubSubChannels(ctx)
+}
+
+func (client *Client) PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	return client.pick(command("PUBSUB CHANNELS")).PubSubChannelsWithPattern(ctx, pattern)
+}
+
+func (client *Client) PubSubNumPat(ctx context.Context) (int64, error) {
+	return client.pick(command("PUBSUB NUMPAT")).PubSubNumPat(ctx)
+}
+
+func (client *Client) PubSubNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	return client.pick(command("PUBSUB NUMSUB")).PubSubNumSub(ctx, channels...)
+}
+
+func (client *Client) Watch(ctx context.Context, keys []string) (string, error) {
+	return client.pick(Command{Name: "WATCH"}).Watch(ctx, keys)
+}
+
+func (client *Client) Unwatch(ctx context.Context) (string, error) {
+	return client.pick(Command{Name: "UNWATCH"}).Unwatch(ctx)
+}
+
+func (client *Client) CustomCommand(ctx context.Context, args []string) (models.ClusterValue[any], error) {
+	return client.pick(customCommand(args)).CustomCommand(ctx, args)
+}
+
+func (client *Client) CustomCommandWithRoute(
+	ctx context.Context,
+	args []string,
+	route config.Route,
+) (models.ClusterValue[any], error) {
+	return client.pick(customCommand(args)).CustomCommandWithRoute(ctx, args, route)
+}
+
+func (client *Client) Scan(
+	ctx context.Context,
+	cursor options.ClusterScanCursor,
+) (options.ClusterScanCursor, []string, error) {
+	return client.pick(command("SCAN")).Scan(ctx, cursor)
+}
+
+func (client *Client) ScanWithOptions(
+	ctx context.Context,
+	cursor options.ClusterScanCursor,
+	opts options.ClusterScanOptions,
+) (options.ClusterScanCursor, []string, error) {
+	return client.pick(command("SCAN")).ScanWithOptions(ctx, cursor, opts)
+}
+
+func (client *Client) RandomKey(ctx context.Context) (models.Result[string], error) {
+	return client.pick(command("RANDOMKEY")).RandomKey(ctx)
+}
+
+func (client *Client) RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (models.Result[string], error) {
+	return client.pick(command("RANDOMKEY")).RandomKeyWithRoute(ctx, opts)
+}
+
+func (client *Client) Info(ctx context.Context) (map[string]string, error) {
+	return client.pick(command("INFO")).Info(ctx)
+}
+
+func (client *Client) InfoWithOptions(
+	ctx context.Context,
+	options options.ClusterInfoOptions,
+) (models.ClusterValue[string], error) {
+	return client.pick(command("INFO")).InfoWithOptions(ctx, options)
+}
+
+func (client *Client) TimeWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	return client.pick(command("TIME")).TimeWithOptions(ctx, routeOption)
+}
+
+func (client *Client) DBSizeWithOptions(ctx context.Context, routeOption options.RouteOption) (int64, error) {
+	return client.pick(command("DBSIZE")).DBSizeWithOptions(ctx, routeOption)
+}
+
+func (client *Client) FlushAll(ctx context.Context) (string, error) {
+	return client.pick(command("FLUSHALL")).FlushAll(ctx)
+}
+
+func (client *Client) FlushAllWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error) {
+	return client.pick(command("FLUSHALL")).FlushAllWithOptions(ctx, options)
+}
+
+func (client *Client) FlushDB(ctx context.Context) (string, error) {
+	return client.pick(command("FLUSHDB")).FlushDB(ctx)
+}
+
+func (client *Client) FlushDBWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error) {
+	return client.pick(command("FLUSHDB")).FlushDBWithOptions(ctx, options)
+}
+
+func (client *Client) Lolwut(ctx context.Context) (string, error) {
+	return client.pick(command("LOLWUT")).Lolwut(ctx)
+}
+
+func (client *Client) LolwutWithOptions(
+	ctx context.Context,
+	lolwutOptions options.ClusterLolwutOptions,
+) (models.ClusterValue[string], error) {
+	return client.pick(command("LOLWUT")).LolwutWithOptions(ctx, lolwutOptions)
+}
+
+func (client *Client) LastSave(ctx context.Context) (models.ClusterValue[int64], error) {
+	return client.pick(command("LASTSAVE")).LastSave(ctx)
+}
+
+func (client *Client) LastSaveWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	return client.pick(command("LASTSAVE")).LastSaveWithOptions(ctx, routeOption)
+}
+
+func (client *Client) ConfigResetStat(ctx context.Context) (string, error) {
+	return client.pick(command("CONFIG RESETSTAT")).ConfigResetStat(ctx)
+}
+
+func (client *Client) ConfigResetStatWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error) {
+	return client.pick(command("CONFIG RESETSTAT")).ConfigResetStatWithOptions(ctx, routeOption)
+}
+
+func (client *Client) ConfigSet(ctx context.Context, parameters map[string]string) (string, error) {
+	return client.pick(command("CONFIG SET")).ConfigSet(ctx, parameters)
+}
+
+func (client *Client) ConfigSetWithOptions(
+	ctx context.Context,
+	parameters map[string]string,
+	routeOption options.RouteOption,
+) (string, error) {
+	return client.pick(command("CONFIG SET")).ConfigSetWithOptions(ctx, parameters, routeOption)
+}
+
+func (client *Client) ConfigGet(ctx context.Context, parameters []string) (map[string]string, error) {
+	return client.pick(command("CONFIG GET")).ConfigGet(ctx, parameters)
+}
+
+func (client *Client) ConfigGetWithOptions(
+	ctx context.Context,
+	parameters []string,
+	routeOption options.RouteOption,
+) (models.ClusterValue[map[string]string], error) {
+	return client.pick(command("CONFIG GET")).ConfigGetWithOptions(ctx, parameters, routeOption)
+}
+
+func (client *Client) ConfigRewrite(ctx context.Context) (string, error) {
+	return client.pick(command("CONFIG REWRITE")).ConfigRewrite(ctx)
+}
+
+func (client *Client) ConfigRewriteWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error) {
+	return client.pick(command("CONFIG REWRITE")).ConfigRewriteWithOptions(ctx, routeOption)
+}
+
+func (client *Client) Ping(ctx context.Context) (string, error) {
+	return client.pick(command("PING")).Ping(ctx)
+}
+
+func (client *Client) PingWithOptions(ctx context.Context, pingOptions options.ClusterPingOptions) (string, error) {
+	return client.pick(command("PING")).PingWithOptions(ctx, pingOptions)
+}
+
+func (client *Client) Echo(ctx context.Context, message string) (models.Result[string], error) {
+	return client.pick(command("ECHO")).Echo(ctx, message)
+}
+
+func (client *Client) EchoWithOptions(
+	ctx context.Context,
+	message string,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[string], error) {
+	return client.pick(command("ECHO")).EchoWithOptions(ctx, message, routeOptions)
+}
+
+func (client *Client) ClientId(ctx context.Context) (models.ClusterValue[int64], error) {
+	return client.pick(command("CLIENT ID")).ClientId(ctx)
+}
+
+func (client *Client) ClientIdWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	return client.pick(command("CLIENT ID")).ClientIdWithOptions(ctx, routeOptions)
+}
+
+func (client *Client) ClientSetName(ctx context.Context, connectionName string) (models.ClusterValue[string], error) {
+	return client.pick(command("CLIENT SETNAME")).ClientSetName(ctx, connectionName)
+}
+
+func (client *Client) ClientSetNameWithOptions(
+	ctx context.Context,
+	connectionName string,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[string], error) {
+	return client.pick(command("CLIENT SETNAME")).ClientSetNameWithOptions(ctx, connectionName, routeOptions)
+}
+
+func (client *Client) ClientGetName(ctx context.Context) (models.ClusterValue[string], error) {
+	return client.pick(command("CLIENT GETNAME")).ClientGetName(ctx)
+}
+
+func (client *Client) ClientGetNameWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[string], error) {
+	return client.pick(command("CLIENT GETNAME")).ClientGetNameWithOptions(ctx, routeOptions)
+}
+
+func (client *Client) FunctionLoadWithRoute(
+	ctx context.Context,
+	libraryCode string,
+	replace bool,
+	route options.RouteOption,
+) (string, error) {
+	return client.pick(command("FUNCTION LOAD")).FunctionLoadWithRoute(ctx, libraryCode, replace, route)
+}
+
+func (client *Client) FunctionFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.pick(command("FUNCTION FLUSH")).FunctionFlushWithRoute(ctx, route)
+}
+
+func (client *Client) FunctionFlushSyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.pick(command("FUNCTION FLUSH")).FunctionFlushSyncWithRoute(ctx, route)
+}
+
+func (client *Client) FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.pick(command("FUNCTION FLUSH")).FunctionFlushAsyncWithRoute(ctx, route)
+}
+
+func (client *Client) FCallWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("FCALL")).FCallWithRoute(ctx, function, route)
+}
+
+func (client *Client) FCallReadOnlyWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("FCALL_RO")).FCallReadOnlyWithRoute(ctx, function, route)
+}
+
+func (client *Client) FCallWithArgs(ctx context.Context, function string, args []string) (models.ClusterValue[any], error) {
+	return client.pick(command("FCALL")).FCallWithArgs(ctx, function, args)
+}
+
+func (client *Client) FCallReadOnlyWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("FCALL_RO")).FCallReadOnlyWithArgs(ctx, function, args)
+}
+
+func (client *Client) FCallWithArgsWithRoute(
+	ctx context.Context,
+	function string,
+	args []string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("FCALL")).FCallWithArgsWithRoute(ctx, function, args, route)
+}
+
+func (client *Client) FCallReadOnlyWithArgsWithRoute(
+	ctx context.Context,
+	function string,
+	args []string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("FCALL_RO")).FCallReadOnlyWithArgsWithRoute(ctx, function, args, route)
+}
+
+func (client *Client) FunctionStats(ctx context.Context) (map[string]models.FunctionStatsResult, error) {
+	return client.pick(command("FUNCTION STATS")).FunctionStats(ctx)
+}
+
+func (client *Client) FunctionStatsWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (models.ClusterValue[models.FunctionStatsResult], error) {
+	return client.pick(command("FUNCTION STATS")).FunctionStatsWithRoute(ctx, route)
+}
+
+func (client *Client) FunctionDelete(ctx context.Context, libName string) (string, error) {
+	return client.pick(command("FUNCTION DELETE")).FunctionDelete(ctx, libName)
+}
+
+func (client *Client) FunctionDeleteWithRoute(ctx context.Context, libName string, route options.RouteOption) (string, error) {
+	return client.pick(command("FUNCTION DELETE")).FunctionDeleteWithRoute(ctx, libName, route)
+}
+
+func (client *Client) FunctionKill(ctx context.Context) (string, error) {
+	return client.pick(command("FUNCTION KILL")).FunctionKill(ctx)
+}
+
+func (client *Client) FunctionKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.pick(command("FUNCTION KILL")).FunctionKillWithRoute(ctx, route)
+}
+
+func (client *Client) FunctionList(ctx context.Context, query models.FunctionListQuery) ([]models.LibraryInfo, error) {
+	return client.pick(command("FUNCTION LIST")).FunctionList(ctx, query)
+}
+
+func (client *Client) FunctionListWithRoute(
+	ctx context.Context,
+	query models.FunctionListQuery,
+	route options.RouteOption,
+) (models.ClusterValue[[]models.LibraryInfo], error) {
+	return client.pick(command("FUNCTION LIST")).FunctionListWithRoute(ctx, query, route)
+}
+
+func (client *Client) FunctionDump(ctx context.Context) (string, error) {
+	return client.pick(command("FUNCTION DUMP")).FunctionDump(ctx)
+}
+
+func (client *Client) FunctionDumpWithRoute(ctx context.Context, route config.Route) (models.ClusterValue[string], error) {
+	return client.pick(command("FUNCTION DUMP")).FunctionDumpWithRoute(ctx, route)
+}
+
+func (client *Client) FunctionRestore(ctx context.Context, payload string) (string, error) {
+	return client.pick(command("FUNCTION RESTORE")).FunctionRestore(ctx, payload)
+}
+
+func (client *Client) FunctionRestoreWithRoute(ctx context.Context, payload string, route config.Route) (string, error) {
+	return client.pick(command("FUNCTION RESTORE")).FunctionRestoreWithRoute(ctx, payload, route)
+}
+
+func (client *Client) FunctionRestoreWithPolicy(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+) (string, error) {
+	return client.pick(command("FUNCTION RESTORE")).FunctionRestoreWithPolicy(ctx, payload, policy)
+}
+
+func (client *Client) FunctionRestoreWithPolicyWithRoute(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+	route config.Route,
+) (string, error) {
+	return client.pick(command("FUNCTION RESTORE")).FunctionRestoreWithPolicyWithRoute(ctx, payload, policy, route)
+}
+
+func (client *Client) InvokeScriptWithRoute(
+	ctx context.Context,
+	script options.Script,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("EVALSHA")).InvokeScriptWithRoute(ctx, script, route)
+}
+
+func (client *Client) InvokeScriptWithClusterOptions(
+	ctx context.Context,
+	script options.Script,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	return client.pick(command("EVALSHA")).InvokeScriptWithClusterOptions(ctx, script, clusterScriptOptions)
+}
+
+func (client *Client) ScriptExistsWithRoute(ctx context.Context, sha1s []string, route options.RouteOption) ([]bool, error) {
+	return client.pick(command("SCRIPT EXISTS")).ScriptExistsWithRoute(ctx, sha1s, route)
+}
+
+func (client *Client) ScriptFlushWithOptions(ctx context.Context, options options.ScriptFlushOptions) (string, error) {
+	return client.pick(command("SCRIPT FLUSH")).ScriptFlushWithOptions(ctx, options)
+}
+
+func (client *Client) ScriptKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.pick(command("SCRIPT KILL")).ScriptKillWithRoute(ctx, route)
+}
+
+func (client *Client) Publish(ctx context.Context, channel string, message string, sharded bool) (int64, error) {
+	return client.pick(command("PUBLISH")).Publish(ctx, channel, message, sharded)
+}
+
+func (client *Client) PubSubShardChannels(ctx context.Context) ([]string, error) {
+	return client.pick(command("PUBSUB SHARDCHANNELS")).PubSubShardChannels(ctx)
+}
+
+func (client *Client) PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	return client.pick(command("PUBSUB SHARDCHANNELS")).PubSubShardChannelsWithPattern(ctx, pattern)
+}
+
+func (client *Client) PubSubShardNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	return client.pick(command("PUBSUB SHARDNUMSUB")).PubSubShardNumSub(ctx, channels...)
+}
+
+func (client *Client) UnwatchWithOptions(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.pick(Command{Name: "UNWATCH"}).UnwatchWithOptions(ctx, route)
+}
+
+func (client *Client) Exec(ctx context.Context, batch pipeline.ClusterBatch, raiseOnError bool) ([]any, error) {
+	return client.pick(batchCommand).Exec(ctx, batch, raiseOnError)
+}
+
+func (client *Client) ExecWithOptions(
+	ctx context.Context,
+	batch pipeline.ClusterBatch,
+	raiseOnError bool,
+	options pipeline.ClusterBatchOptions,
+) ([]any, error) {
+	return client.pick(batchCommand).ExecWithOptions(ctx, batch, raiseOnError, options)
+}
@@ -0,0 +1,411 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package failover implements a client which fails over between two Valkey GLIDE cluster clients, connected to the
+// clusters of an active region and of a passive region.
+//
+// A [Client] implements [interfaces.GlideClusterClientCommands], and sends each command to one of the clusters, as
+// selected by its [Policy]. The client checks the health of both clusters with PING, and fails over to the secondary
+// cluster when the active cluster fails its health checks. It fails back to the primary cluster once it was healthy for
+// the failback delay, or when [Client.SwitchTo] is called:
+//
+//	primary, err := glide.NewClusterClient(primaryConfig)
+//	...
+//	secondary, err := glide.NewClusterClient(secondaryConfig)
+//	...
+//	client := failover.NewClient(primary, secondary, failover.NewConfiguration().
+//		WithPolicy(failover.ReadsFrom(failover.Secondary)).
+//		WithListener(func(event failover.Event) {
+//			log.Printf("switched from the %s to the %s cluster: %s", event.From, event.To, event.Reason)
+//		}))
+//	defer client.Close()
+//
+// The client does not replicate the data between the clusters, which is expected to be done by the deployment. Commands
+// in progress when the client fails over complete on the cluster they were sent to. The state of the connections, such
+// as the cursors of the cluster scans, the watched keys and the names of the connections, is not shared by the clusters.
+package failover
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+)
+
+var _ interfaces.GlideClusterClientCommands = (*Client)(nil)
+
+// ErrUnhealthy is returned by [Client.SwitchTo] when the cluster did not pass its health checks.
+var ErrUnhealthy = errors.New("failover: the cluster is unhealthy")
+
+// Cluster identifies one of the two clusters of a [Client].
+type Cluster int
+
+const (
+	// Primary - The cluster of the active region, which is active when the client is created.
+	Primary Cluster = iota
+	// Secondary - The cluster of the passive region, which is active after a failover.
+	Secondary
+)
+
+func (cluster Cluster) String() string {
+	switch cluster {
+	case Primary:
+		return "primary"
+	case Secondary:
+		return "secondary"
+	}
+	return "unknown"
+}
+
+// Reason is the reason of a switch of the active cluster.
+type Reason int
+
+const (
+	// Unhealthy - The active cluster failed its health checks, and the other cluster is healthy.
+	Unhealthy Reason = iota
+	// Failback - The primary cluster was healthy for the failback delay.
+	Failback
+	// Manual - The active cluster was switched by [Client.SwitchTo].
+	Manual
+)
+
+func (reason Reason) String() string {
+	switch reason {
+	case Unhealthy:
+		return "unhealthy"
+	case Failback:
+		return "failback"
+	case Manual:
+		return "manual"
+	}
+	return "unknown"
+}
+
+// Event is a switch of the active cluster of a [Client].
+type Event struct {
+	From   Cluster
+	To     Cluster
+	Reason Reason
+	Time   time.Time
+}
+
+// Listener is called on the switches of the active cluster of a [Client]. It is called by the health checks of the
+// client, or by [Client.SwitchTo], and should return quickly.
+type Listener func(event Event)
+
+// State is a snapshot of the state of a [Client].
+type State struct {
+	// Active is the cluster which receives the commands of the client, unless its policy selects the other cluster.
+	Active           Cluster
+	PrimaryHealthy   bool
+	SecondaryHealthy bool
+}
+
+// Healthy reports whether a cluster passed its health checks.
+func (state State) Healthy(cluster Cluster) bool {
+	if cluster == Secondary {
+		return state.SecondaryHealthy
+	}
+	return state.PrimaryHealthy
+}
+
+// Command describes a command of a [Client], as seen by its [Policy].
+type Command struct {
+	// Name is the upper-case command name, including the container command for subcommands, for example "GET" or
+	// "CONFIG SET". For commands sent via CustomCommand, it is the upper-case first argument, and for batches, it is
+	// "EXEC".
+	Name string
+	// ReadOnly is true for commands which do not write data, as defined by [config.CommandPolicy.WithReadOnly]. Batches,
+	// scripts and the commands of transactions are not read-only.
+	ReadOnly bool
+}
+
+// Configuration configures the health checks, the failback and the policy of a [Client].
+type Configuration struct {
+	interval          time.Duration
+	timeout           time.Duration
+	failureThreshold  int
+	recoveryThreshold int
+	automaticFailback bool
+	failbackDelay     time.Duration
+	policy            Policy
+	listener          Listener
+}
+
+// NewConfiguration returns a [Configuration] which checks the health of the clusters every second with a timeout of
+// 500 milliseconds, marks a cluster unhealthy after 3 failed checks and healthy again after 3 passed checks, fails back
+// to the primary cluster once it was healthy for 30 seconds, and sends all the commands to the active cluster.
+func NewConfiguration() *Configuration {
+	return &Configuration{
+		interval:          time.Second,
+		timeout:           500 * time.Millisecond,
+		failureThreshold:  3,
+		recoveryThreshold: 3,
+		automaticFailback: true,
+		failbackDelay:     30 * time.Second,
+		policy:            ActiveOnly(),
+	}
+}
+
+// WithHealthCheck sets the interval between the health checks of the clusters, and the timeout of a health check.
+func (configuration *Configuration) WithHealthCheck(interval time.Duration, timeout time.Duration) *Configuration {
+	configuration.interval = interval
+	configuration.timeout = timeout
+	return configuration
+}
+
+// WithThresholds sets the number of consecutive failed health checks after which a cluster is unhealthy, and the number
+// of consecutive passed health checks after which an unhealthy cluster is healthy again.
+func (configuration *Configuration) WithThresholds(failures int, recoveries int) *Configuration {
+	configuration.failureThreshold = failures
+	configuration.recoveryThreshold = recoveries
+	return configuration
+}
+
+// WithFailback sets whether the client fails back to the primary cluster automatically, once the primary cluster passed
+// all its health checks for the given delay. Without automatic failback, the client stays on the secondary cluster until
+// [Client.SwitchTo] is called, or until the secondary cluster is unhealthy.
+func (configuration *Configuration) WithFailback(automatic bool, delay time.Duration) *Configuration {
+	configuration.automaticFailback = automatic
+	configuration.failbackDelay = delay
+	return configuration
+}
+
+// WithPolicy sets the policy which selects the cluster of the commands. The default policy is [ActiveOnly].
+func (configuration *Configuration) WithPolicy(policy Policy) *Configuration {
+	configuration.policy = policy
+	return configuration
+}
+
+// WithListener sets the listener of the switches of the active cluster.
+func (configuration *Configuration) WithListener(listener Listener) *Configuration {
+	configuration.listener = listener
+	return configuration
+}
+
+// health is the outcome of the recent health checks of a cluster.
+type health struct {
+	healthy   bool
+	failures  int
+	successes int
+	// passingSince is the time since which the cluster passed all its health checks, or zero if its last check failed.
+	passingSince time.Time
+}
+
+// Client sends commands to the primary or the secondary cluster, and fails over between them. It is safe for concurrent
+// use.
+type Client struct {
+	clusters      [2]interfaces.GlideClusterClientCommands
+	configuration *Configuration
+
+	mu     sync.Mutex
+	active Cluster
+	health [2]health
+
+	stop      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewClient creates a client over the clusters of the active and of the passive region, which starts checking their
+// health. Both clusters are considered healthy until they fail their health checks. A nil configuration uses the
+// defaults of [NewConfiguration].
+//
+// Closing the client closes both cluster clients.
+func NewClient(
+	primary interfaces.GlideClusterClientCommands,
+	secondary interfaces.GlideClusterClientCommands,
+	configuration *Configuration,
+) *Client {
+	if configuration == nil {
+		configuration = NewConfiguration()
+	}
+	now := time.Now()
+	client := &Client{
+		clusters:      [2]interfaces.GlideClusterClientCommands{primary, secondary},
+		configuration: configuration,
+		active:        Primary,
+		health:        [2]health{{healthy: true, passingSince: now}, {healthy: true, passingSince: now}},
+		stop:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+	go client.checkHealth()
+	return client
+}
+
+// Cluster returns the client of a cluster.
+func (client *Client) Cluster(cluster Cluster) interfaces.GlideClusterClientCommands {
+	if cluster == Secondary {
+		return client.clusters[Secondary]
+	}
+	return client.clusters[Primary]
+}
+
+// State returns a snapshot of the active cluster and of the health of the clusters.
+func (client *Client) State() State {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.state()
+}
+
+// state must be called while holding the lock.
+func (client *Client) state() State {
+	return State{
+		Active:           client.active,
+		PrimaryHealthy:   client.health[Primary].healthy,
+		SecondaryHealthy: client.health[Secondary].healthy,
+	}
+}
+
+// SwitchTo makes a cluster active, for example to fail back to the primary cluster when automatic failback is disabled.
+// It returns [ErrUnhealthy] if the cluster did not pass its health checks. With automatic failback, the client fails back
+// to the primary cluster once it was healthy for the failback delay.
+func (client *Client) SwitchTo(cluster Cluster) error {
+	client.mu.Lock()
+	if !client.state().Healthy(cluster) {
+		client.mu.Unlock()
+		return ErrUnhealthy
+	}
+	event := client.switchTo(cluster, Manual, time.Now())
+	client.mu.Unlock()
+	client.notify(event)
+	return nil
+}
+
+// switchTo makes a cluster active, and returns the switch event, or nil if the cluster was already active. It must be
+// called while holding the lock.
+func (client *Client) switchTo(cluster Cluster, reason Reason, now time.Time) *Event {
+	if client.active == cluster {
+		return nil
+	}
+	event := &Event{From: client.active, To: cluster, Reason: reason, Time: now}
+	client.active = cluster
+	return event
+}
+
+func (client *Client) notify(event *Event) {
+	if event != nil && client.configuration.listener != nil {
+		client.configuration.listener(*event)
+	}
+}
+
+// checkHealth checks the health of the clusters at every interval, until the client is closed.
+func (client *Client) checkHealth() {
+	defer close(client.stopped)
+	ticker := time.NewTicker(client.configuration.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-client.stop:
+			return
+		case <-ticker.C:
+		}
+		var results [2]error
+		var wg sync.WaitGroup
+		for cluster := range client.clusters {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), client.configuration.timeout)
+				defer cancel()
+				_, results[cluster] = client.clusters[cluster].Ping(ctx)
+			}()
+		}
+		wg.Wait()
+		client.record(results, time.Now())
+	}
+}
+
+// record updates the health of the clusters with the results of their health checks, and switches the active cluster
+// when it is unhealthy, or when the client fails back to the primary cluster.
+func (client *Client) record(results [2]error, now time.Time) {
+	client.mu.Lock()
+	for cluster, err := range results {
+		health := &client.health[cluster]
+		if err != nil {
+			health.successes = 0
+			health.failures++
+			health.passingSince = time.Time{}
+			if health.failures >= client.configuration.failureThreshold {
+				health.healthy = false
+			}
+			continue
+		}
+		health.failures = 0
+		health.successes++
+		if health.passingSince.IsZero() {
+			health.passingSince = now
+		}
+		if health.successes >= client.configuration.recoveryThreshold {
+			health.healthy = true
+		}
+	}
+
+	var event *Event
+	active, other := client.active, Secondary-client.active
+	primary := client.health[Primary]
+	switch {
+	case !client.health[active].healthy && client.health[other].healthy:
+		event = client.switchTo(other, Unhealthy, now)
+	case active == Secondary && client.configuration.automaticFailback && primary.healthy &&
+		!primary.passingSince.IsZero() && now.Sub(primary.passingSince) >= client.configuration.failbackDelay:
+		event = client.switchTo(Primary, Failback, now)
+	}
+	client.mu.Unlock()
+	client.notify(event)
+}
+
+// readOnly is the command policy which rejects the commands which may write, and identifies the read-only commands.
+var readOnly = config.NewCommandPolicy().WithReadOnly(true)
+
+func command(name string) Command {
+	return Command{Name: name, ReadOnly: readOnly.Check(&config.CommandRequest{Name: name}) == nil}
+}
+
+func customCommand(args []string) Command {
+	if len(args) == 0 {
+		return Command{}
+	}
+	request := config.CommandRequest{Name: strings.ToUpper(args[0]), Args: args, Custom: true}
+	return Command{Name: request.Name, ReadOnly: readOnly.Check(&request) == nil}
+}
+
+var batchCommand = Command{Name: "EXEC"}
+
+// pick returns the client of the cluster selected by the policy for a command.
+func (client *Client) pick(command Command) interfaces.GlideClusterClientCommands {
+	return client.Cluster(client.configuration.policy.Select(command, client.State()))
+}
+
+// UpdateConnectionPassword updates the password of the connections of both clusters. See the UpdateConnectionPassword
+// method of the cluster clients.
+func (client *Client) UpdateConnectionPassword(ctx context.Context, password string, immediateAuth bool) (string, error) {
+	result, err := client.clusters[Primary].UpdateConnectionPassword(ctx, password, immediateAuth)
+	if err != nil {
+		return result, err
+	}
+	return client.clusters[Secondary].UpdateConnectionPassword(ctx, password, immediateAuth)
+}
+
+// ResetConnectionPassword removes the password of the connections of both clusters. See the ResetConnectionPassword
+// method of the cluster clients.
+func (client *Client) ResetConnectionPassword(ctx context.Context) (string, error) {
+	result, err := client.clusters[Primary].ResetConnectionPassword(ctx)
+	if err != nil {
+		return result, err
+	}
+	return client.clusters[Secondary].ResetConnectionPassword(ctx)
+}
+
+// Close stops the health checks, and closes the clients of both clusters.
+func (client *Client) Close() {
+	client.closeOnce.Do(func() {
+		close(client.stop)
+		<-client.stopped
+		client.clusters[Primary].Close()
+		client.clusters[Secondary].Close()
+	})
+}
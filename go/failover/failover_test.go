@@ -0,0 +1,226 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package failover
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnreachable = errors.New("unreachable")
+
+// flaky is a cluster client whose health checks fail while it is down.
+type flaky struct {
+	interfaces.GlideClusterClientCommands
+	mu   sync.Mutex
+	down bool
+}
+
+func (client *flaky) setDown(down bool) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.down = down
+}
+
+func (client *flaky) Ping(ctx context.Context) (string, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.down {
+		return "", errUnreachable
+	}
+	return client.GlideClusterClientCommands.Ping(ctx)
+}
+
+// recorder records the switch events of a client.
+type recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (recorder *recorder) listen(event Event) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.events = append(recorder.events, event)
+}
+
+func (recorder *recorder) reasons() []Reason {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	var reasons []Reason
+	for _, event := range recorder.events {
+		reasons = append(reasons, event.Reason)
+	}
+	return reasons
+}
+
+// newTestClient creates a client over two fake clusters, whose health is only updated by the test.
+func newTestClient(configuration *Configuration) (*Client, *glidetest.Server, *glidetest.Server) {
+	primary, secondary := glidetest.NewServer(), glidetest.NewServer()
+	client := NewClient(primary.NewClusterClient(), secondary.NewClusterClient(), configuration.WithHealthCheck(time.Hour, 0))
+	return client, primary, secondary
+}
+
+func TestActiveOnly(t *testing.T) {
+	ctx := context.Background()
+	client, primary, secondary := newTestClient(NewConfiguration())
+	defer client.Close()
+
+	_, err := client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+	value, err = primary.NewClusterClient().Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+	value, err = secondary.NewClusterClient().Get(ctx, "key")
+	require.NoError(t, err)
+	assert.True(t, value.IsNil())
+}
+
+func TestReadsFrom(t *testing.T) {
+	ctx := context.Background()
+	client, primary, secondary := newTestClient(NewConfiguration().WithPolicy(ReadsFrom(Secondary)))
+	defer client.Close()
+	_, err := secondary.NewClusterClient().Set(ctx, "key", "replicated")
+	require.NoError(t, err)
+
+	// the writes are sent to the primary cluster, and the reads to the secondary cluster
+	_, err = client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "replicated", value.Value())
+	value, err = primary.NewClusterClient().Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+
+	// the reads are sent to the active cluster while the secondary cluster is unhealthy
+	client.record([2]error{nil, errUnreachable}, time.Now())
+	client.record([2]error{nil, errUnreachable}, time.Now())
+	client.record([2]error{nil, errUnreachable}, time.Now())
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+}
+
+func TestCommand(t *testing.T) {
+	assert.Equal(t, Command{Name: "GET", ReadOnly: true}, command("GET"))
+	assert.Equal(t, Command{Name: "SET"}, command("SET"))
+	assert.Equal(t, Command{Name: "OBJECT", ReadOnly: true}, customCommand([]string{"object", "encoding", "key"}))
+	assert.Equal(t, Command{Name: "EVAL"}, customCommand([]string{"eval", "return 1", "0"}))
+	assert.Equal(t, Command{}, customCommand(nil))
+}
+
+func TestFailoverAndFailback(t *testing.T) {
+	events := &recorder{}
+	client, _, _ := newTestClient(NewConfiguration().
+		WithThresholds(2, 2).
+		WithFailback(true, 10*time.Second).
+		WithListener(events.listen))
+	defer client.Close()
+	now := time.Now()
+
+	// the client fails over once the primary cluster failed enough health checks
+	client.record([2]error{errUnreachable, nil}, now)
+	assert.Equal(t, State{Active: Primary, PrimaryHealthy: true, SecondaryHealthy: true}, client.State())
+	client.record([2]error{errUnreachable, nil}, now.Add(time.Second))
+	assert.Equal(t, State{Active: Secondary, PrimaryHealthy: false, SecondaryHealthy: true}, client.State())
+	require.Len(t, events.events, 1)
+	assert.Equal(t, Event{From: Primary, To: Secondary, Reason: Unhealthy, Time: now.Add(time.Second)}, events.events[0])
+
+	// the client fails back once the primary cluster passed all its health checks for the failback delay
+	client.record([2]error{nil, nil}, now.Add(2*time.Second))
+	client.record([2]error{nil, nil}, now.Add(3*time.Second))
+	assert.Equal(t, State{Active: Secondary, PrimaryHealthy: true, SecondaryHealthy: true}, client.State())
+	client.record([2]error{errUnreachable, nil}, now.Add(4*time.Second))
+	client.record([2]error{nil, nil}, now.Add(5*time.Second))
+	client.record([2]error{nil, nil}, now.Add(14*time.Second))
+	assert.Equal(t, Secondary, client.State().Active)
+	client.record([2]error{nil, nil}, now.Add(15*time.Second))
+	assert.Equal(t, Primary, client.State().Active)
+	assert.Equal(t, []Reason{Unhealthy, Failback}, events.reasons())
+}
+
+func TestFailoverToUnhealthyCluster(t *testing.T) {
+	events := &recorder{}
+	client, _, _ := newTestClient(NewConfiguration().WithThresholds(1, 1).WithListener(events.listen))
+	defer client.Close()
+
+	// the client stays on the primary cluster when both clusters are unhealthy
+	client.record([2]error{errUnreachable, errUnreachable}, time.Now())
+	assert.Equal(t, State{Active: Primary}, client.State())
+
+	// the client switches back to the primary cluster when the secondary cluster is unhealthy
+	client.record([2]error{errUnreachable, nil}, time.Now())
+	assert.Equal(t, Secondary, client.State().Active)
+	client.record([2]error{nil, errUnreachable}, time.Now())
+	assert.Equal(t, Primary, client.State().Active)
+	assert.Equal(t, []Reason{Unhealthy, Unhealthy}, events.reasons())
+}
+
+func TestManualFailback(t *testing.T) {
+	events := &recorder{}
+	client, _, _ := newTestClient(NewConfiguration().
+		WithThresholds(1, 1).
+		WithFailback(false, 0).
+		WithListener(events.listen))
+	defer client.Close()
+
+	client.record([2]error{errUnreachable, nil}, time.Now())
+	assert.Equal(t, Secondary, client.State().Active)
+	assert.ErrorIs(t, client.SwitchTo(Primary), ErrUnhealthy)
+
+	client.record([2]error{nil, nil}, time.Now().Add(time.Hour))
+	assert.Equal(t, Secondary, client.State().Active)
+	require.NoError(t, client.SwitchTo(Primary))
+	assert.Equal(t, Primary, client.State().Active)
+	require.NoError(t, client.SwitchTo(Primary))
+	assert.Equal(t, []Reason{Unhealthy, Manual}, events.reasons())
+}
+
+func TestHealthChecks(t *testing.T) {
+	ctx := context.Background()
+	primary := &flaky{GlideClusterClientCommands: glidetest.NewClusterClient()}
+	secondary := glidetest.NewServer()
+	events := &recorder{}
+	client := NewClient(primary, secondary.NewClusterClient(), NewConfiguration().
+		WithHealthCheck(10*time.Millisecond, time.Second).
+		WithThresholds(2, 2).
+		WithFailback(true, 50*time.Millisecond).
+		WithListener(events.listen))
+	defer client.Close()
+
+	primary.setDown(true)
+	require.Eventually(t, func() bool { return client.State().Active == Secondary }, 5*time.Second, 10*time.Millisecond)
+	_, err := client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+	value, err := secondary.NewClusterClient().Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+
+	primary.setDown(false)
+	require.Eventually(t, func() bool { return client.State().Active == Primary }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []Reason{Unhealthy, Failback}, events.reasons())
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := glidetest.NewClusterClient(), glidetest.NewClusterClient()
+	client := NewClient(primary, secondary, nil)
+	client.Close()
+	client.Close()
+
+	_, err := primary.Get(ctx, "key")
+	assert.Error(t, err)
+	_, err = secondary.Get(ctx, "key")
+	assert.Error(t, err)
+}
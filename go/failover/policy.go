@@ -0,0 +1,37 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package failover
+
+// Policy selects the cluster which a command of a [Client] is sent to.
+type Policy interface {
+	// Select returns the cluster of a command, given the state of the client.
+	Select(command Command, state State) Cluster
+}
+
+// PolicyFunc adapts a function to the [Policy] interface.
+type PolicyFunc func(command Command, state State) Cluster
+
+// Select calls the function.
+func (fn PolicyFunc) Select(command Command, state State) Cluster {
+	return fn(command, state)
+}
+
+// ActiveOnly returns a [Policy] which sends all the commands to the active cluster.
+func ActiveOnly() Policy {
+	return PolicyFunc(func(_ Command, state State) Cluster {
+		return state.Active
+	})
+}
+
+// ReadsFrom returns a [Policy] which sends the writes to the active cluster, and the read-only commands to the given
+// cluster while it is healthy, for example to the cluster of the local region. The writes are only sent to the primary
+// cluster, unless the client failed over to the secondary cluster. The reads sent to the passive cluster may not observe
+// the writes which were not yet replicated to it.
+func ReadsFrom(cluster Cluster) Policy {
+	return PolicyFunc(func(command Command, state State) Cluster {
+		if command.ReadOnly && state.Healthy(cluster) {
+			return cluster
+		}
+		return state.Active
+	})
+}
@@ -10,7 +10,7 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
 )
 
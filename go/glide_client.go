@@ -11,7 +11,7 @@ import (
 	"github.com/itayporezky/valkey-glide/go/v4/config"
 
 	"github.com/itayporezky/valkey-glide/go/v4/constants"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/utils"
 	"github.com/itayporezky/valkey-glide/go/v4/models"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
@@ -53,8 +53,16 @@ type Client struct {
 //	  - **TLS**: If `UseTLS` is set to `true`, the client will establish a secure connection using TLS.
 //	  - **Reconnection Strategy**: The `BackoffStrategy` settings define how the client will attempt to reconnect
 //	      in case of disconnections.
+//	  - **Sentinel**: If a `SentinelConfiguration` is provided, the client will connect to the primary and the replicas
+//	      discovered from the sentinels, and follow their failovers.
 func NewClient(config *config.ClientConfiguration) (*Client, error) {
-	client, err := createClient(config)
+	var client *baseClient
+	var err error
+	if config.GetSentinel() != nil {
+		client, err = createSentinelClient(config)
+	} else {
+		client, err = createClient(config)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -11,8 +11,8 @@ import (
 
 	"github.com/itayporezky/valkey-glide/go/v4/config"
 	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/utils"
 	"github.com/itayporezky/valkey-glide/go/v4/models"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
@@ -67,7 +67,11 @@ func NewClusterClient(config *config.ClusterClientConfiguration) (*ClusterClient
 		client.setMessageHandler(NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()))
 	}
 
-	return &ClusterClient{client}, nil
+	clusterClient := &ClusterClient{client}
+	if client.breakers != nil {
+		client.breakers.topology = clusterClient.Topology
+	}
+	return clusterClient, nil
 }
 
 // Executes a batch by processing the queued commands.
@@ -624,6 +628,11 @@ func (client *ClusterClient) clusterScan(
 	default:
 		// Continue with execution
 	}
+	if client.commandPolicy != nil {
+		if err := client.commandPolicy.Check(&config.CommandRequest{Name: "SCAN"}); err != nil {
+			return nil, err
+		}
+	}
 
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
@@ -634,7 +643,7 @@ func (client *ClusterClient) clusterScan(
 	defer pinner.Unpin()
 
 	client.mu.Lock()
-	if client.coreClient == nil {
+	if client.coreClient == nil || client.closing {
 		client.mu.Unlock()
 		return nil, &errors.ClosingError{Msg: "Cluster Scan failed. The client is closed."}
 	}
@@ -648,6 +657,9 @@ func (client *ClusterClient) clusterScan(
 	if err != nil {
 		return nil, err
 	}
+	if client.namespace != "" {
+		args = namespacedScanArgs(client.namespace, args)
+	}
 
 	var cArgsPtr *C.uintptr_t = nil
 	var argLengthsPtr *C.ulong = nil
@@ -671,11 +683,8 @@ func (client *ClusterClient) clusterScan(
 	var payload payload
 	select {
 	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
+		client.removePending(resultChannelPtr)
+		client.cancelRequest(pinnedChannelPtr)
 		// Start cleanup goroutine
 		go func() {
 			// Wait for payload on separate channel
@@ -688,11 +697,7 @@ func (client *ClusterClient) clusterScan(
 		// Continue with normal processing
 	}
 
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
+	client.removePending(resultChannelPtr)
 
 	if payload.error != nil {
 		return nil, payload.error
@@ -737,7 +742,7 @@ func (client *ClusterClient) Scan(
 	}
 
 	nextCursor, keys, err := handleScanResponse(response)
-	return *options.NewClusterScanCursorWithId(nextCursor), keys, err
+	return *options.NewClusterScanCursorWithId(nextCursor), client.stripNamespace(keys), err
 }
 
 // Incrementally iterates over the keys in the cluster.
@@ -778,7 +783,7 @@ func (client *ClusterClient) ScanWithOptions(
 	}
 
 	nextCursor, keys, err := handleScanResponse(response)
-	return *options.NewClusterScanCursorWithId(nextCursor), keys, err
+	return *options.NewClusterScanCursorWithId(nextCursor), client.stripNamespace(keys), err
 }
 
 // Displays a piece of generative computer art of the specific Valkey version and it's optional arguments.
@@ -0,0 +1,420 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"strconv"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+
+	// The options and models packages call into the FFI library, which is linked by the glide package.
+	_ "github.com/itayporezky/valkey-glide/go/v4"
+)
+
+const ok = "OK"
+
+var (
+	_ interfaces.GlideClientCommands        = (*Client)(nil)
+	_ interfaces.GlideClusterClientCommands = (*ClusterClient)(nil)
+)
+
+// Client is an in-memory fake of the standalone `glide.Client`.
+type Client struct {
+	*base
+}
+
+// ClusterClient is an in-memory fake of the `glide.ClusterClient`.
+type ClusterClient struct {
+	*base
+}
+
+// base implements the commands shared by [Client] and [ClusterClient]. All fields are guarded by the server lock.
+type base struct {
+	server *Server
+	id     int64
+	db     int64
+	name   string
+	closed bool
+
+	channels      map[string]struct{}
+	patterns      map[string]struct{}
+	shardChannels map[string]struct{}
+	callback      config.MessageCallback
+	context       any
+	messages      []*models.PubSubMessage
+}
+
+// acquire locks the server for the execution of a command. On success, the caller must call [base.release].
+func (client *base) acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	client.server.mu.Lock()
+	if client.closed {
+		client.server.mu.Unlock()
+		return &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
+	}
+	return nil
+}
+
+func (client *base) release() {
+	client.server.mu.Unlock()
+}
+
+func (client *base) keyspace() map[string]*entry {
+	return client.server.db(client.db)
+}
+
+// lookup returns the entry of the key, or nil if the key does not exist or has expired.
+func (client *base) lookup(key string) *entry {
+	keyspace := client.keyspace()
+	e, found := keyspace[key]
+	if !found {
+		return nil
+	}
+	if client.server.expired(e) {
+		delete(keyspace, key)
+		return nil
+	}
+	return e
+}
+
+// lookupValue returns the value of the key, if it exists and holds a value of type T.
+func lookupValue[T any](client *base, key string) (T, bool, error) {
+	var zero T
+	e := client.lookup(key)
+	if e == nil {
+		return zero, false, nil
+	}
+	value, isType := e.value.(T)
+	if !isType {
+		return zero, false, wrongTypeError()
+	}
+	return value, true, nil
+}
+
+// store updates the value of the key, preserving its TTL. Storing an empty collection deletes the key.
+func (client *base) store(key string, value any) {
+	if isEmpty(value) {
+		delete(client.keyspace(), key)
+		return
+	}
+	if e := client.lookup(key); e != nil {
+		e.value = value
+		return
+	}
+	client.keyspace()[key] = &entry{value: value}
+}
+
+// replace sets the value of the key and discards its TTL. Replacing with an empty collection deletes the key.
+func (client *base) replace(key string, value any) {
+	if isEmpty(value) {
+		delete(client.keyspace(), key)
+		return
+	}
+	client.keyspace()[key] = &entry{value: value}
+}
+
+func isEmpty(value any) bool {
+	switch value := value.(type) {
+	case []string:
+		return len(value) == 0
+	case map[string]string:
+		return len(value) == 0
+	case map[string]struct{}:
+		return len(value) == 0
+	case map[string]float64:
+		return len(value) == 0
+	}
+	return false
+}
+
+func wrongTypeError() error {
+	return &errors.RequestError{Msg: "WRONGTYPE Operation against a key holding the wrong kind of value"}
+}
+
+func notIntegerError() error {
+	return &errors.RequestError{Msg: "ERR value is not an integer or out of range"}
+}
+
+func notFloatError() error {
+	return &errors.RequestError{Msg: "ERR value is not a valid float"}
+}
+
+func syntaxError() error {
+	return &errors.RequestError{Msg: "ERR syntax error"}
+}
+
+func unsupportedError(method string) error {
+	return &errors.RequestError{Msg: "glidetest: " + method + " is not supported"}
+}
+
+// Close closes the client. Subsequent commands fail with a ClosingError.
+func (client *base) Close() {
+	client.server.mu.Lock()
+	defer client.server.mu.Unlock()
+	client.closed = true
+	delete(client.server.clients, client)
+}
+
+// Watch is accepted for compatibility, but the fake does not support transactions.
+func (client *base) Watch(ctx context.Context, keys []string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	return ok, nil
+}
+
+// Unwatch is accepted for compatibility, but the fake does not support transactions.
+func (client *base) Unwatch(ctx context.Context) (string, error) {
+	return client.Watch(ctx, nil)
+}
+
+func (client *ClusterClient) UnwatchWithOptions(ctx context.Context, route options.RouteOption) (string, error) {
+	return client.Unwatch(ctx)
+}
+
+func (client *Client) Ping(ctx context.Context) (string, error) {
+	return client.PingWithOptions(ctx, options.PingOptions{})
+}
+
+func (client *Client) PingWithOptions(ctx context.Context, pingOptions options.PingOptions) (string, error) {
+	return client.ping(ctx, pingOptions.Message)
+}
+
+func (client *ClusterClient) Ping(ctx context.Context) (string, error) {
+	return client.ping(ctx, "")
+}
+
+func (client *ClusterClient) PingWithOptions(ctx context.Context, pingOptions options.ClusterPingOptions) (string, error) {
+	if pingOptions.PingOptions == nil {
+		return client.ping(ctx, "")
+	}
+	return client.ping(ctx, pingOptions.Message)
+}
+
+func (client *base) ping(ctx context.Context, message string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	if message == "" {
+		return "PONG", nil
+	}
+	return message, nil
+}
+
+func (client *base) Echo(ctx context.Context, message string) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	return models.CreateStringResult(message), nil
+}
+
+func (client *ClusterClient) EchoWithOptions(
+	ctx context.Context,
+	message string,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[string], error) {
+	result, err := client.Echo(ctx, message)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return models.CreateClusterSingleValue(result.Value()), nil
+}
+
+func (client *base) clientId(ctx context.Context) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	return client.id, nil
+}
+
+func (client *base) clientGetName(ctx context.Context) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	return client.name, nil
+}
+
+func (client *base) clientSetName(ctx context.Context, connectionName string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	client.name = connectionName
+	return ok, nil
+}
+
+func (client *Client) ClientId(ctx context.Context) (int64, error) {
+	return client.clientId(ctx)
+}
+
+func (client *Client) ClientGetName(ctx context.Context) (string, error) {
+	return client.clientGetName(ctx)
+}
+
+func (client *Client) ClientSetName(ctx context.Context, connectionName string) (string, error) {
+	return client.clientSetName(ctx, connectionName)
+}
+
+func (client *ClusterClient) ClientId(ctx context.Context) (models.ClusterValue[int64], error) {
+	return toClusterValue(client.clientId(ctx))
+}
+
+func (client *ClusterClient) ClientIdWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	return client.ClientId(ctx)
+}
+
+func (client *ClusterClient) ClientGetName(ctx context.Context) (models.ClusterValue[string], error) {
+	return toClusterValue(client.clientGetName(ctx))
+}
+
+func (client *ClusterClient) ClientGetNameWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[string], error) {
+	return client.ClientGetName(ctx)
+}
+
+func (client *ClusterClient) ClientSetName(ctx context.Context, connectionName string) (models.ClusterValue[string], error) {
+	return toClusterValue(client.clientSetName(ctx, connectionName))
+}
+
+func (client *ClusterClient) ClientSetNameWithOptions(
+	ctx context.Context,
+	connectionName string,
+	routeOptions options.RouteOption,
+) (models.ClusterValue[string], error) {
+	return client.ClientSetName(ctx, connectionName)
+}
+
+func toClusterValue[T any](value T, err error) (models.ClusterValue[T], error) {
+	if err != nil {
+		return models.CreateEmptyClusterValue[T](), err
+	}
+	return models.CreateClusterSingleValue(value), nil
+}
+
+// Select changes the database used by the client.
+func (client *Client) Select(ctx context.Context, index int64) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	if index < 0 {
+		return models.DefaultStringResponse, &errors.RequestError{Msg: "ERR DB index is out of range"}
+	}
+	client.db = index
+	return ok, nil
+}
+
+func (client *base) dbSize(ctx context.Context) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	var size int64
+	for key := range client.keyspace() {
+		if client.lookup(key) != nil {
+			size++
+		}
+	}
+	return size, nil
+}
+
+func (client *Client) DBSize(ctx context.Context) (int64, error) {
+	return client.dbSize(ctx)
+}
+
+func (client *ClusterClient) DBSizeWithOptions(ctx context.Context, routeOption options.RouteOption) (int64, error) {
+	return client.dbSize(ctx)
+}
+
+func (client *base) flush(ctx context.Context, all bool) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	if all {
+		client.server.dbs = make(map[int64]map[string]*entry)
+	} else {
+		delete(client.server.dbs, client.db)
+	}
+	return ok, nil
+}
+
+func (client *base) FlushAll(ctx context.Context) (string, error) {
+	return client.flush(ctx, true)
+}
+
+func (client *base) FlushDB(ctx context.Context) (string, error) {
+	return client.flush(ctx, false)
+}
+
+func (client *Client) FlushAllWithOptions(ctx context.Context, mode options.FlushMode) (string, error) {
+	return client.flush(ctx, true)
+}
+
+func (client *Client) FlushDBWithOptions(ctx context.Context, mode options.FlushMode) (string, error) {
+	return client.flush(ctx, false)
+}
+
+func (client *ClusterClient) FlushAllWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error) {
+	return client.flush(ctx, true)
+}
+
+func (client *ClusterClient) FlushDBWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error) {
+	return client.flush(ctx, false)
+}
+
+// Time returns the server clock, as the unix time in seconds and the microseconds.
+func (client *Client) Time(ctx context.Context) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	now := client.server.now()
+	return []string{strconv.FormatInt(now.Unix(), 10), strconv.Itoa(now.Nanosecond() / 1000)}, nil
+}
+
+// sortedKeys returns the keys of the map in lexicographical order.
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// randomMembers picks random members, following the semantics of the count argument of SRANDMEMBER: a positive count
+// returns distinct members, a negative count allows repetitions.
+func randomMembers(members []string, count int64) []string {
+	if len(members) == 0 {
+		return []string{}
+	}
+	if count < 0 {
+		result := make([]string, -count)
+		for i := range result {
+			result[i] = members[rand.Intn(len(members))]
+		}
+		return result
+	}
+	rand.Shuffle(len(members), func(i, j int) { members[i], members[j] = members[j], members[i] })
+	return members[:min(count, int64(len(members)))]
+}
@@ -0,0 +1,567 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"maps"
+	"math/rand"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// expiryTime converts an expiry option, such as "EX 10", to an absolute time.
+func (client *base) expiryTime(expiryType string, count string) (time.Time, error) {
+	value, err := strconv.ParseInt(count, 10, 64)
+	if err != nil {
+		return time.Time{}, notIntegerError()
+	}
+	switch constants.ExpiryType(expiryType) {
+	case constants.Seconds:
+		return client.server.now().Add(time.Duration(value) * time.Second), nil
+	case constants.Milliseconds:
+		return client.server.now().Add(time.Duration(value) * time.Millisecond), nil
+	case constants.UnixSeconds:
+		return time.Unix(value, 0), nil
+	case constants.UnixMilliseconds:
+		return time.UnixMilli(value), nil
+	}
+	return time.Time{}, syntaxError()
+}
+
+func (client *base) Del(ctx context.Context, keys []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	var deleted int64
+	for _, key := range keys {
+		if client.lookup(key) != nil {
+			delete(client.keyspace(), key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (client *base) Unlink(ctx context.Context, keys []string) (int64, error) {
+	return client.Del(ctx, keys)
+}
+
+func (client *base) Exists(ctx context.Context, keys []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	var existing int64
+	for _, key := range keys {
+		if client.lookup(key) != nil {
+			existing++
+		}
+	}
+	return existing, nil
+}
+
+func (client *base) Touch(ctx context.Context, keys []string) (int64, error) {
+	return client.Exists(ctx, keys)
+}
+
+func (client *base) Type(ctx context.Context, key string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	return typeName(client.lookup(key)), nil
+}
+
+func typeName(e *entry) string {
+	if e == nil {
+		return "none"
+	}
+	switch e.value.(type) {
+	case string:
+		return string(constants.ObjectTypeString)
+	case []string:
+		return string(constants.ObjectTypeList)
+	case map[string]string:
+		return string(constants.ObjectTypeHash)
+	case map[string]struct{}:
+		return string(constants.ObjectTypeSet)
+	case map[string]float64:
+		return string(constants.ObjectTypeZSet)
+	default:
+		return string(constants.ObjectTypeStream)
+	}
+}
+
+func (client *base) Expire(ctx context.Context, key string, seconds int64) (bool, error) {
+	return client.expire(ctx, key, time.Duration(seconds)*time.Second, "")
+}
+
+func (client *base) ExpireWithOptions(
+	ctx context.Context,
+	key string,
+	seconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.expire(ctx, key, time.Duration(seconds)*time.Second, expireCondition)
+}
+
+func (client *base) PExpire(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	return client.expire(ctx, key, time.Duration(milliseconds)*time.Millisecond, "")
+}
+
+func (client *base) PExpireWithOptions(
+	ctx context.Context,
+	key string,
+	milliseconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.expire(ctx, key, time.Duration(milliseconds)*time.Millisecond, expireCondition)
+}
+
+func (client *base) ExpireAt(ctx context.Context, key string, unixTimestampInSeconds int64) (bool, error) {
+	return client.expireAt(ctx, key, time.Unix(unixTimestampInSeconds, 0), "")
+}
+
+func (client *base) ExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	unixTimestampInSeconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.expireAt(ctx, key, time.Unix(unixTimestampInSeconds, 0), expireCondition)
+}
+
+func (client *base) PExpireAt(ctx context.Context, key string, unixTimestampInMilliSeconds int64) (bool, error) {
+	return client.expireAt(ctx, key, time.UnixMilli(unixTimestampInMilliSeconds), "")
+}
+
+func (client *base) PExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	unixTimestampInMilliSeconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return client.expireAt(ctx, key, time.UnixMilli(unixTimestampInMilliSeconds), expireCondition)
+}
+
+func (client *base) expire(
+	ctx context.Context,
+	key string,
+	duration time.Duration,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	return client.setExpiry(key, client.server.now().Add(duration), expireCondition)
+}
+
+func (client *base) expireAt(
+	ctx context.Context,
+	key string,
+	expireAt time.Time,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	return client.setExpiry(key, expireAt, expireCondition)
+}
+
+func (client *base) setExpiry(key string, expireAt time.Time, expireCondition constants.ExpireCondition) (bool, error) {
+	if _, err := expireCondition.ToString(); expireCondition != "" && err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	e := client.lookup(key)
+	if e == nil {
+		return false, nil
+	}
+	// keys without a TTL are considered to have an infinite TTL
+	switch expireCondition {
+	case constants.HasNoExpiry:
+		if !e.expireAt.IsZero() {
+			return false, nil
+		}
+	case constants.HasExistingExpiry:
+		if e.expireAt.IsZero() {
+			return false, nil
+		}
+	case constants.NewExpiryGreaterThanCurrent:
+		if e.expireAt.IsZero() || !expireAt.After(e.expireAt) {
+			return false, nil
+		}
+	case constants.NewExpiryLessThanCurrent:
+		if !e.expireAt.IsZero() && !expireAt.Before(e.expireAt) {
+			return false, nil
+		}
+	}
+	if !expireAt.After(client.server.now()) {
+		delete(client.keyspace(), key)
+		return true, nil
+	}
+	e.expireAt = expireAt
+	return true, nil
+}
+
+func (client *base) Persist(ctx context.Context, key string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	e := client.lookup(key)
+	if e == nil || e.expireAt.IsZero() {
+		return false, nil
+	}
+	e.expireAt = time.Time{}
+	return true, nil
+}
+
+func (client *base) TTL(ctx context.Context, key string) (int64, error) {
+	return client.ttl(ctx, key, time.Second, false)
+}
+
+func (client *base) PTTL(ctx context.Context, key string) (int64, error) {
+	return client.ttl(ctx, key, time.Millisecond, false)
+}
+
+func (client *base) ExpireTime(ctx context.Context, key string) (int64, error) {
+	return client.ttl(ctx, key, time.Second, true)
+}
+
+func (client *base) PExpireTime(ctx context.Context, key string) (int64, error) {
+	return client.ttl(ctx, key, time.Millisecond, true)
+}
+
+// ttl returns the TTL of the key, or its absolute expiry time, in the given unit. It returns -2 if the key does not exist
+// and -1 if it has no TTL.
+func (client *base) ttl(ctx context.Context, key string, unit time.Duration, absolute bool) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	e := client.lookup(key)
+	switch {
+	case e == nil:
+		return -2, nil
+	case e.expireAt.IsZero():
+		return -1, nil
+	case absolute:
+		return e.expireAt.UnixNano() / int64(unit), nil
+	}
+	// round up, so that a key is not reported to have a TTL of 0 before it expires
+	remaining := e.expireAt.Sub(client.server.now())
+	return int64((remaining + unit - 1) / unit), nil
+}
+
+func (client *base) Rename(ctx context.Context, key string, newKey string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	e := client.lookup(key)
+	if e == nil {
+		return models.DefaultStringResponse, &errors.RequestError{Msg: "ERR no such key"}
+	}
+	delete(client.keyspace(), key)
+	client.keyspace()[newKey] = e
+	return ok, nil
+}
+
+func (client *base) RenameNX(ctx context.Context, key string, newKey string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	e := client.lookup(key)
+	if e == nil {
+		return models.DefaultBoolResponse, &errors.RequestError{Msg: "ERR no such key"}
+	}
+	if client.lookup(newKey) != nil {
+		return false, nil
+	}
+	delete(client.keyspace(), key)
+	client.keyspace()[newKey] = e
+	return true, nil
+}
+
+func (client *base) Copy(ctx context.Context, source string, destination string) (bool, error) {
+	return client.copy(ctx, source, destination, client.db, false)
+}
+
+func (client *base) CopyWithOptions(
+	ctx context.Context,
+	source string,
+	destination string,
+	option options.CopyOptions,
+) (bool, error) {
+	optionArgs, err := option.ToArgs()
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	db, replace := client.db, false
+	for i := 0; i < len(optionArgs); i++ {
+		switch optionArgs[i] {
+		case constants.ReplaceKeyword:
+			replace = true
+		case "DB":
+			i++
+			if db, err = strconv.ParseInt(optionArgs[i], 10, 64); err != nil {
+				return models.DefaultBoolResponse, notIntegerError()
+			}
+		}
+	}
+	return client.copy(ctx, source, destination, db, replace)
+}
+
+func (client *base) copy(ctx context.Context, source string, destination string, db int64, replace bool) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	e := client.lookup(source)
+	if e == nil {
+		return false, nil
+	}
+	target := client.server.db(db)
+	if existing, found := target[destination]; found && !client.server.expired(existing) && !replace {
+		return false, nil
+	}
+	target[destination] = &entry{value: cloneValue(e.value), expireAt: e.expireAt}
+	return true, nil
+}
+
+// Move moves the key to another database.
+func (client *Client) Move(ctx context.Context, key string, dbIndex int64) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	e := client.lookup(key)
+	if e == nil {
+		return false, nil
+	}
+	target := client.server.db(dbIndex)
+	if existing, found := target[key]; found && !client.server.expired(existing) {
+		return false, nil
+	}
+	delete(client.keyspace(), key)
+	target[key] = e
+	return true, nil
+}
+
+func cloneValue(value any) any {
+	switch value := value.(type) {
+	case []string:
+		return slices.Clone(value)
+	case map[string]string:
+		return maps.Clone(value)
+	case map[string]struct{}:
+		return maps.Clone(value)
+	case map[string]float64:
+		return maps.Clone(value)
+	case *stream:
+		return value.clone()
+	}
+	return value
+}
+
+func (client *base) randomKey(ctx context.Context) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	keys := client.keys()
+	if len(keys) == 0 {
+		return models.CreateNilStringResult(), nil
+	}
+	return models.CreateStringResult(keys[rand.Intn(len(keys))]), nil
+}
+
+// keys returns the sorted keys of the current database, omitting the expired ones.
+func (client *base) keys() []string {
+	keys := make([]string, 0, len(client.keyspace()))
+	for key := range client.keyspace() {
+		if client.lookup(key) != nil {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (client *Client) RandomKey(ctx context.Context) (models.Result[string], error) {
+	return client.randomKey(ctx)
+}
+
+func (client *ClusterClient) RandomKey(ctx context.Context) (models.Result[string], error) {
+	return client.randomKey(ctx)
+}
+
+func (client *ClusterClient) RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (models.Result[string], error) {
+	return client.randomKey(ctx)
+}
+
+// Scan iterates the keys of the current database. The cursor is the offset of the next key in lexicographical order.
+func (client *Client) Scan(ctx context.Context, cursor int64) (string, []string, error) {
+	return client.ScanWithOptions(ctx, cursor, *options.NewScanOptions())
+}
+
+func (client *Client) ScanWithOptions(
+	ctx context.Context,
+	cursor int64,
+	scanOptions options.ScanOptions,
+) (string, []string, error) {
+	optionArgs, err := scanOptions.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	defer client.release()
+
+	scan, optionArgs, err := parseScanArgs(optionArgs)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	var objectType string
+	if len(optionArgs) == 2 && optionArgs[0] == constants.TypeKeyword {
+		objectType = optionArgs[1]
+	}
+	nextCursor, keys := scan.page(client.keys(), cursor)
+	result := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if scan.matches(key) && (objectType == "" || typeName(client.lookup(key)) == objectType) {
+			result = append(result, key)
+		}
+	}
+	return nextCursor, result, nil
+}
+
+// scanArgs holds the common options of the SCAN family of commands.
+type scanArgs struct {
+	match string
+	count int64
+}
+
+// parseScanArgs extracts the MATCH and COUNT options, and returns the remaining arguments.
+func parseScanArgs(args []string) (scanArgs, []string, error) {
+	scan := scanArgs{count: 10}
+	rest := []string{}
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case constants.MatchKeyword:
+			i++
+			scan.match = args[i]
+		case constants.CountKeyword:
+			i++
+			count, err := strconv.ParseInt(args[i], 10, 64)
+			if err != nil || count < 1 {
+				return scan, nil, syntaxError()
+			}
+			scan.count = count
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+	return scan, rest, nil
+}
+
+func (scan scanArgs) matches(value string) bool {
+	return scan.match == "" || globMatch(scan.match, value)
+}
+
+// page returns the next cursor and the items of the page starting at the cursor.
+func (scan scanArgs) page(items []string, cursor int64) (string, []string) {
+	start := min(max(cursor, 0), int64(len(items)))
+	end := min(start+scan.count, int64(len(items)))
+	if end == int64(len(items)) {
+		return "0", items[start:end]
+	}
+	return strconv.FormatInt(end, 10), items[start:end]
+}
+
+// globMatch reports whether the value matches a glob-style pattern, as used by the KEYS and PSUBSCRIBE commands.
+func globMatch(pattern string, value string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 0 && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(value); i++ {
+				if globMatch(pattern, value[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(value) == 0 {
+				return false
+			}
+		case '[':
+			if len(value) == 0 {
+				return false
+			}
+			end := 1
+			for end < len(pattern) && pattern[end] != ']' {
+				if pattern[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(pattern) || !classMatch(pattern[1:end], value[0]) {
+				return false
+			}
+			pattern = pattern[end:]
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(value) == 0 || pattern[0] != value[0] {
+				return false
+			}
+		}
+		pattern = pattern[1:]
+		value = value[1:]
+	}
+	return len(value) == 0
+}
+
+// classMatch reports whether the character matches a glob character class, given without the enclosing brackets.
+func classMatch(class string, char byte) bool {
+	negate := len(class) > 0 && class[0] == '^'
+	if negate {
+		class = class[1:]
+	}
+	matched := false
+	for i := 0; i < len(class); i++ {
+		switch {
+		case class[i] == '\\' && i+1 < len(class):
+			i++
+			matched = matched || class[i] == char
+		case i+2 < len(class) && class[i+1] == '-':
+			low, high := min(class[i], class[i+2]), max(class[i], class[i+2])
+			matched = matched || (low <= char && char <= high)
+			i += 2
+		default:
+			matched = matched || class[i] == char
+		}
+	}
+	return matched != negate
+}
@@ -0,0 +1,318 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrings(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	result, err := client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateStringResult("value"), value)
+
+	value, err = client.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.True(t, value.IsNil())
+
+	setOptions := options.NewSetOptions().SetConditionalSet(constants.OnlyIfDoesNotExist)
+	value, err = client.SetWithOptions(ctx, "key", "other", *setOptions)
+	require.NoError(t, err)
+	assert.True(t, value.IsNil())
+
+	counter, err := client.IncrBy(ctx, "counter", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), counter)
+
+	_, err = client.Incr(ctx, "key")
+	assert.IsType(t, &errors.RequestError{}, err)
+
+	values, err := client.MGet(ctx, []string{"key", "missing", "counter"})
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]models.Result[string]{
+			models.CreateStringResult("value"),
+			models.CreateNilStringResult(),
+			models.CreateStringResult("5"),
+		},
+		values,
+	)
+}
+
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	client := server.NewClient()
+
+	setOptions := options.NewSetOptions().SetExpiry(options.NewExpiry().SetType(constants.Seconds).SetCount(10))
+	_, err := client.SetWithOptions(ctx, "key", "value", *setOptions)
+	require.NoError(t, err)
+
+	ttl, err := client.TTL(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), ttl)
+
+	server.FastForward(4 * time.Second)
+	ttl, err = client.TTL(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), ttl)
+
+	server.FastForward(6 * time.Second)
+	exists, err := client.Exists(ctx, []string{"key"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+
+	ttl, err = client.TTL(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), ttl)
+}
+
+func TestHashes(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	added, err := client.HSet(ctx, "hash", map[string]string{"a": "1", "b": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), added)
+
+	value, err := client.HIncrBy(ctx, "hash", "a", 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), value)
+
+	all, err := client.HGetAll(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "11", "b": "2"}, all)
+
+	_, err = client.HDel(ctx, "hash", []string{"a", "b"})
+	require.NoError(t, err)
+	keyType, err := client.Type(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, "none", keyType)
+}
+
+func TestLists(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	length, err := client.RPush(ctx, "list", []string{"a", "b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), length)
+
+	_, err = client.LPush(ctx, "list", []string{"z"})
+	require.NoError(t, err)
+
+	elements, err := client.LRange(ctx, "list", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"z", "a", "b", "c"}, elements)
+
+	popped, err := client.RPop(ctx, "list")
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateStringResult("c"), popped)
+
+	popped, err = client.LPop(ctx, "missing")
+	require.NoError(t, err)
+	assert.True(t, popped.IsNil())
+
+	result, err := client.BLPop(ctx, []string{"missing", "list"}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"list", "z"}, result)
+}
+
+func TestSets(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	_, err := client.SAdd(ctx, "set1", []string{"a", "b", "c"})
+	require.NoError(t, err)
+	_, err = client.SAdd(ctx, "set2", []string{"b", "c", "d"})
+	require.NoError(t, err)
+
+	inter, err := client.SInter(ctx, []string{"set1", "set2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"b": {}, "c": {}}, inter)
+
+	count, err := client.SUnionStore(ctx, "union", []string{"set1", "set2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	member, err := client.SIsMember(ctx, "union", "d")
+	require.NoError(t, err)
+	assert.True(t, member)
+}
+
+func TestSortedSets(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	added, err := client.ZAdd(ctx, "zset", map[string]float64{"one": 1, "two": 2, "three": 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), added)
+
+	members, err := client.ZRange(ctx, "zset", options.NewRangeByIndexQuery(0, -1).SetReverse())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"three", "two", "one"}, members)
+
+	query := options.NewRangeByScoreQuery(
+		options.NewInclusiveScoreBoundary(2),
+		options.NewInfiniteScoreBoundary(constants.PositiveInfinity),
+	)
+	withScores, err := client.ZRangeWithScores(ctx, "zset", query)
+	require.NoError(t, err)
+	assert.Equal(t, []models.MemberAndScore{{Member: "two", Score: 2}, {Member: "three", Score: 3}}, withScores)
+
+	rank, err := client.ZRank(ctx, "zset", "three")
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateInt64Result(2), rank)
+
+	popped, err := client.ZPopMin(ctx, "zset")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"one": 1}, popped)
+}
+
+func TestStreams(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	id, err := client.XAddWithOptions(
+		ctx,
+		"stream",
+		[][]string{{"field", "value1"}},
+		*options.NewXAddOptions().SetId("1-1"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateStringResult("1-1"), id)
+
+	_, err = client.XAddWithOptions(ctx, "stream", [][]string{{"field", "value2"}}, *options.NewXAddOptions().SetId("1-1"))
+	assert.IsType(t, &errors.RequestError{}, err)
+
+	id, err = client.XAdd(ctx, "stream", [][]string{{"field", "value2"}})
+	require.NoError(t, err)
+	assert.False(t, id.IsNil())
+
+	length, err := client.XLen(ctx, "stream")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), length)
+
+	entries, err := client.XRange(ctx, "stream", "-", "+")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.XRangeResponse{StreamId: "1-1", Entries: [][]string{{"field", "value1"}}}, entries[0])
+
+	read, err := client.XRead(ctx, map[string]string{"stream": "1-1"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][][]string{id.Value(): {{"field", "value2"}}}, read["stream"])
+}
+
+func TestPubSub(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	publisher := server.NewClient()
+	subscriber := server.NewClient()
+	patternSubscriber := server.NewClient()
+	subscriber.Subscribe("news")
+	patternSubscriber.PSubscribe("n*")
+
+	var received []*models.PubSubMessage
+	callbackSubscriber := server.NewClient()
+	callbackSubscriber.Subscribe("news")
+	callbackSubscriber.WithCallback(func(message *models.PubSubMessage, ctx any) {
+		received = append(received, message)
+	}, nil)
+
+	count, err := publisher.Publish(ctx, "news", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	assert.Equal(t, []*models.PubSubMessage{models.NewPubSubMessage("hello", "news")}, subscriber.Messages())
+	assert.Empty(t, subscriber.Messages())
+	assert.Equal(
+		t,
+		[]*models.PubSubMessage{models.NewPubSubMessageWithPattern("hello", "news", models.CreateStringResult("n*"))},
+		patternSubscriber.Messages(),
+	)
+	assert.Equal(t, []*models.PubSubMessage{models.NewPubSubMessage("hello", "news")}, received)
+
+	numSub, err := publisher.PubSubNumSub(ctx, "news", "other")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"news": 2, "other": 0}, numSub)
+
+	subscriber.Unsubscribe()
+	count, err = publisher.Publish(ctx, "other", "ignored")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestShardedPubSub(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	publisher := server.NewClusterClient()
+	subscriber := server.NewClusterClient()
+	subscriber.SSubscribe("shard")
+
+	count, err := publisher.Publish(ctx, "shard", "message", false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = publisher.Publish(ctx, "shard", "message", true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, []*models.PubSubMessage{models.NewPubSubMessage("message", "shard")}, subscriber.Messages())
+}
+
+func TestSharedKeyspace(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	client := server.NewClient()
+	clusterClient := server.NewClusterClient()
+
+	_, err := client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+	value, err := clusterClient.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+
+	_, err = client.Select(ctx, 1)
+	require.NoError(t, err)
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.True(t, value.IsNil())
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	_, err := client.PfAdd(ctx, "key", []string{"a"})
+	assert.IsType(t, &errors.RequestError{}, err)
+
+	_, err = client.LPush(ctx, "key", []string{"a"})
+	require.NoError(t, err)
+	_, err = client.Get(ctx, "key")
+	assert.IsType(t, &errors.RequestError{}, err)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.Get(canceled, "key")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	client.Close()
+	_, err = client.Get(ctx, "key")
+	assert.IsType(t, &errors.ClosingError{}, err)
+}
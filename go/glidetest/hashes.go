@@ -0,0 +1,320 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/utils"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+func (client *base) hash(key string) (map[string]string, error) {
+	hash, _, err := lookupValue[map[string]string](client, key)
+	return hash, err
+}
+
+func (client *base) HGet(ctx context.Context, key string, field string) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if value, found := hash[field]; found {
+		return models.CreateStringResult(value), nil
+	}
+	return models.CreateNilStringResult(), nil
+}
+
+func (client *base) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	result := maps.Clone(hash)
+	if result == nil {
+		result = map[string]string{}
+	}
+	return result, nil
+}
+
+func (client *base) HMGet(ctx context.Context, key string, fields []string) ([]models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]models.Result[string], len(fields))
+	for i, field := range fields {
+		if value, found := hash[field]; found {
+			values[i] = models.CreateStringResult(value)
+		} else {
+			values[i] = models.CreateNilStringResult()
+		}
+	}
+	return values, nil
+}
+
+func (client *base) HSet(ctx context.Context, key string, values map[string]string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if hash == nil {
+		hash = make(map[string]string, len(values))
+	}
+	var added int64
+	for field, value := range values {
+		if _, found := hash[field]; !found {
+			added++
+		}
+		hash[field] = value
+	}
+	client.store(key, hash)
+	return added, nil
+}
+
+func (client *base) HSetNX(ctx context.Context, key string, field string, value string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	if _, found := hash[field]; found {
+		return false, nil
+	}
+	if hash == nil {
+		hash = make(map[string]string)
+	}
+	hash[field] = value
+	client.store(key, hash)
+	return true, nil
+}
+
+func (client *base) HDel(ctx context.Context, key string, fields []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil || hash == nil {
+		return models.DefaultIntResponse, err
+	}
+	var deleted int64
+	for _, field := range fields {
+		if _, found := hash[field]; found {
+			delete(hash, field)
+			deleted++
+		}
+	}
+	client.store(key, hash)
+	return deleted, nil
+}
+
+func (client *base) HLen(ctx context.Context, key string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	return int64(len(hash)), err
+}
+
+func (client *base) HKeys(ctx context.Context, key string) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	return sortedKeys(hash), nil
+}
+
+func (client *base) HVals(ctx context.Context, key string) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(hash))
+	for _, field := range sortedKeys(hash) {
+		values = append(values, hash[field])
+	}
+	return values, nil
+}
+
+func (client *base) HExists(ctx context.Context, key string, field string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	_, found := hash[field]
+	return found, err
+}
+
+func (client *base) HStrLen(ctx context.Context, key string, field string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	return int64(len(hash[field])), err
+}
+
+func (client *base) HIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	var current int64
+	if value, found := hash[field]; found {
+		if current, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return models.DefaultIntResponse, &errors.RequestError{Msg: "ERR hash value is not an integer"}
+		}
+	}
+	if hash == nil {
+		hash = make(map[string]string)
+	}
+	current += increment
+	hash[field] = utils.IntToString(current)
+	client.store(key, hash)
+	return current, nil
+}
+
+func (client *base) HIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultFloatResponse, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return models.DefaultFloatResponse, err
+	}
+	var current float64
+	if value, found := hash[field]; found {
+		if current, err = strconv.ParseFloat(value, 64); err != nil {
+			return models.DefaultFloatResponse, &errors.RequestError{Msg: "ERR hash value is not a float"}
+		}
+	}
+	if hash == nil {
+		hash = make(map[string]string)
+	}
+	current += increment
+	hash[field] = utils.FloatToString(current)
+	client.store(key, hash)
+	return current, nil
+}
+
+func (client *base) HScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.HScanWithOptions(ctx, key, cursor, *options.NewHashScanOptions())
+}
+
+func (client *base) HScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.HashScanOptions,
+) (string, []string, error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	defer client.release()
+	scan, optionArgs, err := parseScanArgs(optionArgs)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	position, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil {
+		return models.DefaultStringResponse, nil, &errors.RequestError{Msg: "ERR invalid cursor"}
+	}
+	hash, err := client.hash(key)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	noValue := slices.Contains(optionArgs, constants.NoValueKeyword)
+	nextCursor, fields := scan.page(sortedKeys(hash), position)
+	result := []string{}
+	for _, field := range fields {
+		if scan.matches(field) {
+			result = append(result, field)
+			if !noValue {
+				result = append(result, hash[field])
+			}
+		}
+	}
+	return nextCursor, result, nil
+}
+
+func (client *base) HRandField(ctx context.Context, key string) (models.Result[string], error) {
+	fields, err := client.HRandFieldWithCount(ctx, key, 1)
+	if err != nil || len(fields) == 0 {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(fields[0]), nil
+}
+
+func (client *base) HRandFieldWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	return randomMembers(sortedKeys(hash), count), nil
+}
+
+func (client *base) HRandFieldWithCountWithValues(ctx context.Context, key string, count int64) ([][]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	hash, err := client.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	fields := randomMembers(sortedKeys(hash), count)
+	result := make([][]string, len(fields))
+	for i, field := range fields {
+		result[i] = []string{field, hash[field]}
+	}
+	return result, nil
+}
@@ -0,0 +1,486 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+func (client *base) list(key string) ([]string, bool, error) {
+	return lookupValue[[]string](client, key)
+}
+
+func (client *base) LPush(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.push(ctx, key, elements, constants.Left, false)
+}
+
+func (client *base) LPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.push(ctx, key, elements, constants.Left, true)
+}
+
+func (client *base) RPush(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.push(ctx, key, elements, constants.Right, false)
+}
+
+func (client *base) RPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	return client.push(ctx, key, elements, constants.Right, true)
+}
+
+func (client *base) push(
+	ctx context.Context,
+	key string,
+	elements []string,
+	where constants.ListDirection,
+	onlyIfExists bool,
+) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	list, found, err := client.list(key)
+	if err != nil || (onlyIfExists && !found) {
+		return models.DefaultIntResponse, err
+	}
+	for _, element := range elements {
+		if where == constants.Left {
+			list = slices.Insert(list, 0, element)
+		} else {
+			list = append(list, element)
+		}
+	}
+	client.store(key, list)
+	return int64(len(list)), nil
+}
+
+func (client *base) LPop(ctx context.Context, key string) (models.Result[string], error) {
+	return client.popOne(ctx, key, constants.Left)
+}
+
+func (client *base) RPop(ctx context.Context, key string) (models.Result[string], error) {
+	return client.popOne(ctx, key, constants.Right)
+}
+
+func (client *base) popOne(ctx context.Context, key string, where constants.ListDirection) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	popped, err := client.pop(key, where, 1)
+	if err != nil || popped == nil {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(popped[0]), nil
+}
+
+func (client *base) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.popCount(ctx, key, constants.Left, count)
+}
+
+func (client *base) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return client.popCount(ctx, key, constants.Right, count)
+}
+
+func (client *base) popCount(
+	ctx context.Context,
+	key string,
+	where constants.ListDirection,
+	count int64,
+) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	if count < 0 {
+		return nil, &errors.RequestError{Msg: "ERR value is out of range, must be positive"}
+	}
+	return client.pop(key, where, count)
+}
+
+// pop removes up to count elements from the given end of the list. It returns nil if the list does not exist.
+func (client *base) pop(key string, where constants.ListDirection, count int64) ([]string, error) {
+	list, found, err := client.list(key)
+	if err != nil || !found {
+		return nil, err
+	}
+	count = min(count, int64(len(list)))
+	var popped []string
+	if where == constants.Left {
+		popped = slices.Clone(list[:count])
+		list = list[count:]
+	} else {
+		popped = make([]string, 0, count)
+		for i := len(list) - 1; i >= len(list)-int(count); i-- {
+			popped = append(popped, list[i])
+		}
+		list = list[:len(list)-int(count)]
+	}
+	client.store(key, slices.Clone(list))
+	return popped, nil
+}
+
+func (client *base) LPos(ctx context.Context, key string, element string) (models.Result[int64], error) {
+	return client.LPosWithOptions(ctx, key, element, *options.NewLPosOptions())
+}
+
+func (client *base) LPosWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	options options.LPosOptions,
+) (models.Result[int64], error) {
+	positions, err := client.lpos(ctx, key, element, 1, options)
+	if err != nil || len(positions) == 0 {
+		return models.CreateNilInt64Result(), err
+	}
+	return models.CreateInt64Result(positions[0]), nil
+}
+
+func (client *base) LPosCount(ctx context.Context, key string, element string, count int64) ([]int64, error) {
+	return client.LPosCountWithOptions(ctx, key, element, count, *options.NewLPosOptions())
+}
+
+func (client *base) LPosCountWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	count int64,
+	options options.LPosOptions,
+) ([]int64, error) {
+	return client.lpos(ctx, key, element, count, options)
+}
+
+func (client *base) lpos(
+	ctx context.Context,
+	key string,
+	element string,
+	count int64,
+	options options.LPosOptions,
+) ([]int64, error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	rank, maxLen := int64(1), int64(0)
+	for i := 0; i+1 < len(optionArgs); i += 2 {
+		value, err := strconv.ParseInt(optionArgs[i+1], 10, 64)
+		if err != nil {
+			return nil, notIntegerError()
+		}
+		if optionArgs[i] == constants.RankKeyword {
+			rank = value
+		} else {
+			maxLen = value
+		}
+	}
+	if rank == 0 || count < 0 || maxLen < 0 {
+		return nil, syntaxError()
+	}
+	list, _, err := client.list(key)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := []int64{}
+	skip := max(rank, -rank) - 1
+	for compared := int64(0); compared < int64(len(list)) && (maxLen == 0 || compared < maxLen); compared++ {
+		index := compared
+		if rank < 0 {
+			index = int64(len(list)) - 1 - compared
+		}
+		if list[index] != element {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		positions = append(positions, index)
+		if count != 0 && int64(len(positions)) == count {
+			break
+		}
+	}
+	return positions, nil
+}
+
+func (client *base) LRange(ctx context.Context, key string, start int64, end int64) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	list, _, err := client.list(key)
+	if err != nil {
+		return nil, err
+	}
+	from, to, nonEmpty := normalizeRange(start, end, len(list))
+	if !nonEmpty {
+		return []string{}, nil
+	}
+	return slices.Clone(list[from : to+1]), nil
+}
+
+func (client *base) LIndex(ctx context.Context, key string, index int64) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	list, _, err := client.list(key)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if index < 0 {
+		index += int64(len(list))
+	}
+	if index < 0 || index >= int64(len(list)) {
+		return models.CreateNilStringResult(), nil
+	}
+	return models.CreateStringResult(list[index]), nil
+}
+
+func (client *base) LSet(ctx context.Context, key string, index int64, element string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	list, found, err := client.list(key)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	if !found {
+		return models.DefaultStringResponse, &errors.RequestError{Msg: "ERR no such key"}
+	}
+	if index < 0 {
+		index += int64(len(list))
+	}
+	if index < 0 || index >= int64(len(list)) {
+		return models.DefaultStringResponse, &errors.RequestError{Msg: "ERR index out of range"}
+	}
+	list[index] = element
+	return ok, nil
+}
+
+func (client *base) LTrim(ctx context.Context, key string, start int64, end int64) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	list, _, err := client.list(key)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	from, to, nonEmpty := normalizeRange(start, end, len(list))
+	if !nonEmpty {
+		client.store(key, []string{})
+	} else {
+		client.store(key, slices.Clone(list[from:to+1]))
+	}
+	return ok, nil
+}
+
+func (client *base) LLen(ctx context.Context, key string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	list, _, err := client.list(key)
+	return int64(len(list)), err
+}
+
+func (client *base) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	list, _, err := client.list(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	limit := max(count, -count)
+	var removed int64
+	remove := func(index int) bool {
+		if list[index] != element || (limit != 0 && removed == limit) {
+			return false
+		}
+		removed++
+		return true
+	}
+	kept := make([]string, 0, len(list))
+	if count >= 0 {
+		for i := range list {
+			if !remove(i) {
+				kept = append(kept, list[i])
+			}
+		}
+	} else {
+		for i := len(list) - 1; i >= 0; i-- {
+			if !remove(i) {
+				kept = append(kept, list[i])
+			}
+		}
+		slices.Reverse(kept)
+	}
+	client.store(key, kept)
+	return removed, nil
+}
+
+func (client *base) LInsert(
+	ctx context.Context,
+	key string,
+	insertPosition constants.InsertPosition,
+	pivot string,
+	element string,
+) (int64, error) {
+	if _, err := insertPosition.ToString(); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	list, found, err := client.list(key)
+	if err != nil || !found {
+		return models.DefaultIntResponse, err
+	}
+	index := slices.Index(list, pivot)
+	if index < 0 {
+		return -1, nil
+	}
+	if insertPosition == constants.After {
+		index++
+	}
+	list = slices.Insert(list, index, element)
+	client.store(key, list)
+	return int64(len(list)), nil
+}
+
+// BLPop pops an element from the first non-empty list. The fake does not block, and returns nil if all lists are empty.
+func (client *base) BLPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	return client.blockingPop(ctx, keys, constants.Left)
+}
+
+// BRPop pops an element from the first non-empty list. The fake does not block, and returns nil if all lists are empty.
+func (client *base) BRPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	return client.blockingPop(ctx, keys, constants.Right)
+}
+
+func (client *base) blockingPop(ctx context.Context, keys []string, where constants.ListDirection) ([]string, error) {
+	popped, err := client.LMPop(ctx, keys, where)
+	for key, elements := range popped {
+		return []string{key, elements[0]}, nil
+	}
+	return nil, err
+}
+
+func (client *base) LMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+) (map[string][]string, error) {
+	return client.LMPopCount(ctx, keys, listDirection, 1)
+}
+
+func (client *base) LMPopCount(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+) (map[string][]string, error) {
+	if _, err := listDirection.ToString(); err != nil {
+		return nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	if count <= 0 {
+		return nil, &errors.RequestError{Msg: "ERR count should be greater than 0"}
+	}
+	for _, key := range keys {
+		popped, err := client.pop(key, listDirection, count)
+		if err != nil {
+			return nil, err
+		}
+		if popped != nil {
+			return map[string][]string{key: popped}, nil
+		}
+	}
+	return nil, nil
+}
+
+// BLMPop pops elements from the first non-empty list. The fake does not block, and returns nil if all lists are empty.
+func (client *base) BLMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	timeoutSecs float64,
+) (map[string][]string, error) {
+	return client.LMPopCount(ctx, keys, listDirection, 1)
+}
+
+// BLMPopCount pops elements from the first non-empty list. The fake does not block, and returns nil if all lists are
+// empty.
+func (client *base) BLMPopCount(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+	timeoutSecs float64,
+) (map[string][]string, error) {
+	return client.LMPopCount(ctx, keys, listDirection, count)
+}
+
+func (client *base) LMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+) (models.Result[string], error) {
+	if _, err := whereFrom.ToString(); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if _, err := whereTo.ToString(); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	if _, _, err := client.list(destination); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	popped, err := client.pop(source, whereFrom, 1)
+	if err != nil || popped == nil {
+		return models.CreateNilStringResult(), err
+	}
+	list, _, _ := client.list(destination)
+	if whereTo == constants.Left {
+		list = slices.Insert(list, 0, popped[0])
+	} else {
+		list = append(list, popped[0])
+	}
+	client.store(destination, list)
+	return models.CreateStringResult(popped[0]), nil
+}
+
+// BLMove moves an element between lists. The fake does not block, and returns nil if the source list is empty.
+func (client *base) BLMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+	timeoutSecs float64,
+) (models.Result[string], error) {
+	return client.LMove(ctx, source, destination, whereFrom, whereTo)
+}
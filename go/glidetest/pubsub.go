@@ -0,0 +1,237 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+)
+
+// Subscribe subscribes the client to the given channels. It replaces the subscription configuration of the real clients,
+// which can not be read by the fake.
+func (client *base) Subscribe(channels ...string) {
+	client.subscribe(&client.channels, channels)
+}
+
+// PSubscribe subscribes the client to the channels matching the given glob-style patterns.
+func (client *base) PSubscribe(patterns ...string) {
+	client.subscribe(&client.patterns, patterns)
+}
+
+// Unsubscribe unsubscribes the client from the given channels, or from all channels if none are given.
+func (client *base) Unsubscribe(channels ...string) {
+	client.unsubscribe(client.channels, channels)
+}
+
+// PUnsubscribe unsubscribes the client from the given patterns, or from all patterns if none are given.
+func (client *base) PUnsubscribe(patterns ...string) {
+	client.unsubscribe(client.patterns, patterns)
+}
+
+// SSubscribe subscribes the client to the given shard channels.
+func (client *ClusterClient) SSubscribe(channels ...string) {
+	client.subscribe(&client.shardChannels, channels)
+}
+
+// SUnsubscribe unsubscribes the client from the given shard channels, or from all shard channels if none are given.
+func (client *ClusterClient) SUnsubscribe(channels ...string) {
+	client.unsubscribe(client.shardChannels, channels)
+}
+
+func (client *base) subscribe(subscriptions *map[string]struct{}, channels []string) {
+	client.server.mu.Lock()
+	defer client.server.mu.Unlock()
+	if *subscriptions == nil {
+		*subscriptions = make(map[string]struct{})
+	}
+	for _, channel := range channels {
+		(*subscriptions)[channel] = struct{}{}
+	}
+}
+
+func (client *base) unsubscribe(subscriptions map[string]struct{}, channels []string) {
+	client.server.mu.Lock()
+	defer client.server.mu.Unlock()
+	if len(channels) == 0 {
+		clear(subscriptions)
+	}
+	for _, channel := range channels {
+		delete(subscriptions, channel)
+	}
+}
+
+// WithCallback sets the callback invoked for each message received by the client, like the callback of the subscription
+// configuration of the real clients. When no callback is set, messages are queued and returned by Messages.
+//
+// The callback is invoked on the goroutine which publishes the message.
+func (client *base) WithCallback(callback config.MessageCallback, context any) {
+	client.server.mu.Lock()
+	defer client.server.mu.Unlock()
+	client.callback = callback
+	client.context = context
+}
+
+// Messages removes and returns the messages queued for the client, in the order they were published.
+func (client *base) Messages() []*models.PubSubMessage {
+	client.server.mu.Lock()
+	defer client.server.mu.Unlock()
+	messages := client.messages
+	client.messages = nil
+	return messages
+}
+
+// delivery is a message to be delivered to a subscriber.
+type delivery struct {
+	callback config.MessageCallback
+	context  any
+	message  *models.PubSubMessage
+}
+
+func (client *base) publish(ctx context.Context, channel string, message string, sharded bool) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	deliveries := client.server.deliveries(channel, message, sharded)
+	client.release()
+
+	// callbacks are invoked without holding the lock, so that they may issue commands
+	for _, delivery := range deliveries {
+		if delivery.callback != nil {
+			delivery.callback(delivery.message, delivery.context)
+		}
+	}
+	return int64(len(deliveries)), nil
+}
+
+// deliveries returns the deliveries of a published message. Messages of subscribers without a callback are queued.
+func (server *Server) deliveries(channel string, message string, sharded bool) []delivery {
+	deliveries := []delivery{}
+	for _, subscriber := range server.subscribers() {
+		var messages []*models.PubSubMessage
+		if sharded {
+			if _, found := subscriber.shardChannels[channel]; found {
+				messages = append(messages, models.NewPubSubMessage(message, channel))
+			}
+		} else {
+			if _, found := subscriber.channels[channel]; found {
+				messages = append(messages, models.NewPubSubMessage(message, channel))
+			}
+			for _, pattern := range sortedKeys(subscriber.patterns) {
+				if globMatch(pattern, channel) {
+					messages = append(
+						messages,
+						models.NewPubSubMessageWithPattern(message, channel, models.CreateStringResult(pattern)),
+					)
+				}
+			}
+		}
+		for _, message := range messages {
+			if subscriber.callback == nil {
+				subscriber.messages = append(subscriber.messages, message)
+			}
+			deliveries = append(deliveries, delivery{subscriber.callback, subscriber.context, message})
+		}
+	}
+	return deliveries
+}
+
+// subscribers returns the connected clients, ordered by their ID.
+func (server *Server) subscribers() []*base {
+	clients := make([]*base, 0, len(server.clients))
+	for client := range server.clients {
+		clients = append(clients, client)
+	}
+	slices.SortFunc(clients, func(a, b *base) int { return int(a.id - b.id) })
+	return clients
+}
+
+func (client *Client) Publish(ctx context.Context, channel string, message string) (int64, error) {
+	return client.publish(ctx, channel, message, false)
+}
+
+func (client *ClusterClient) Publish(ctx context.Context, channel string, message string, sharded bool) (int64, error) {
+	return client.publish(ctx, channel, message, sharded)
+}
+
+func (client *base) PubSubChannels(ctx context.Context) ([]string, error) {
+	return client.PubSubChannelsWithPattern(ctx, "*")
+}
+
+func (client *base) PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	return client.activeChannels(ctx, pattern, func(subscriber *base) map[string]struct{} { return subscriber.channels })
+}
+
+func (client *base) PubSubNumPat(ctx context.Context) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	patterns := map[string]struct{}{}
+	for subscriber := range client.server.clients {
+		for pattern := range subscriber.patterns {
+			patterns[pattern] = struct{}{}
+		}
+	}
+	return int64(len(patterns)), nil
+}
+
+func (client *base) PubSubNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	return client.numSub(ctx, channels, func(subscriber *base) map[string]struct{} { return subscriber.channels })
+}
+
+func (client *ClusterClient) PubSubShardChannels(ctx context.Context) ([]string, error) {
+	return client.PubSubShardChannelsWithPattern(ctx, "*")
+}
+
+func (client *ClusterClient) PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	return client.activeChannels(ctx, pattern, func(subscriber *base) map[string]struct{} { return subscriber.shardChannels })
+}
+
+func (client *ClusterClient) PubSubShardNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	return client.numSub(ctx, channels, func(subscriber *base) map[string]struct{} { return subscriber.shardChannels })
+}
+
+// activeChannels returns the channels with at least one subscriber which match the pattern.
+func (client *base) activeChannels(
+	ctx context.Context,
+	pattern string,
+	subscriptions func(*base) map[string]struct{},
+) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	channels := map[string]struct{}{}
+	for subscriber := range client.server.clients {
+		for channel := range subscriptions(subscriber) {
+			if globMatch(pattern, channel) {
+				channels[channel] = struct{}{}
+			}
+		}
+	}
+	return sortedKeys(channels), nil
+}
+
+func (client *base) numSub(
+	ctx context.Context,
+	channels []string,
+	subscriptions func(*base) map[string]struct{},
+) (map[string]int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	counts := make(map[string]int64, len(channels))
+	for _, channel := range channels {
+		counts[channel] = 0
+		for subscriber := range client.server.clients {
+			if _, found := subscriptions(subscriber)[channel]; found {
+				counts[channel]++
+			}
+		}
+	}
+	return counts, nil
+}
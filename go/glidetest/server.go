@@ -0,0 +1,114 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package glidetest provides an in-memory fake of the Valkey GLIDE clients for unit tests.
+//
+// A [Server] holds the keyspace shared by the clients created from it. [Client] implements
+// [interfaces.GlideClientCommands] and [ClusterClient] implements [interfaces.GlideClusterClientCommands], so code that
+// depends on these interfaces can be tested without a running server:
+//
+//	server := glidetest.NewServer()
+//	client := server.NewClient()
+//	client.Set(ctx, "key", "value")
+//
+// The fake covers the string, hash, list, set, sorted set, TTL and basic stream commands, and delivers published messages
+// to the clients subscribed with their Subscribe, PSubscribe and SSubscribe methods. Commands which are not supported return a
+// RequestError. Blocking commands do not block. The cluster client behaves as a single-node cluster and does not enforce
+// slot constraints.
+package glidetest
+
+import (
+	"sync"
+	"time"
+)
+
+// Server is the in-memory keyspace shared by fake clients. It is safe for concurrent use.
+type Server struct {
+	mu           sync.Mutex
+	offset       time.Duration
+	dbs          map[int64]map[string]*entry
+	clients      map[*base]struct{}
+	nextClientId int64
+}
+
+// entry is a key stored in the keyspace. Its value is one of string, []string, map[string]string, map[string]struct{},
+// map[string]float64 or *stream.
+type entry struct {
+	value    any
+	expireAt time.Time
+}
+
+// NewServer creates an empty server.
+func NewServer() *Server {
+	return &Server{
+		dbs:     make(map[int64]map[string]*entry),
+		clients: make(map[*base]struct{}),
+	}
+}
+
+// NewClient creates a standalone client connected to a new, empty server.
+func NewClient() *Client {
+	return NewServer().NewClient()
+}
+
+// NewClusterClient creates a cluster client connected to a new, empty server.
+func NewClusterClient() *ClusterClient {
+	return NewServer().NewClusterClient()
+}
+
+// NewClient creates a standalone client connected to the server, using database 0.
+func (server *Server) NewClient() *Client {
+	return &Client{base: server.connect()}
+}
+
+// NewClusterClient creates a cluster client connected to the server.
+func (server *Server) NewClusterClient() *ClusterClient {
+	return &ClusterClient{base: server.connect()}
+}
+
+func (server *Server) connect() *base {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.nextClientId++
+	client := &base{server: server, id: server.nextClientId}
+	server.clients[client] = struct{}{}
+	return client
+}
+
+// FastForward advances the clock of the server by the given duration, expiring the keys whose TTL elapses.
+func (server *Server) FastForward(duration time.Duration) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.offset += duration
+}
+
+// Now returns the current time of the server clock, including the time added with [Server.FastForward].
+func (server *Server) Now() time.Time {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.now()
+}
+
+// FlushAll removes all keys from all databases.
+func (server *Server) FlushAll() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.dbs = make(map[int64]map[string]*entry)
+}
+
+func (server *Server) now() time.Time {
+	return time.Now().Add(server.offset)
+}
+
+func (server *Server) db(index int64) map[string]*entry {
+	db, ok := server.dbs[index]
+	if !ok {
+		db = make(map[string]*entry)
+		server.dbs[index] = db
+	}
+	return db
+}
+
+// expired reports whether the entry has an elapsed TTL.
+func (server *Server) expired(e *entry) bool {
+	return !e.expireAt.IsZero() && !server.now().Before(e.expireAt)
+}
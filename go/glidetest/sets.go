@@ -0,0 +1,323 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"maps"
+	"strconv"
+
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+func (client *base) set(key string) (map[string]struct{}, error) {
+	set, _, err := lookupValue[map[string]struct{}](client, key)
+	return set, err
+}
+
+func (client *base) SAdd(ctx context.Context, key string, members []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	set, err := client.set(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if set == nil {
+		set = make(map[string]struct{}, len(members))
+	}
+	var added int64
+	for _, member := range members {
+		if _, found := set[member]; !found {
+			set[member] = struct{}{}
+			added++
+		}
+	}
+	client.store(key, set)
+	return added, nil
+}
+
+func (client *base) SRem(ctx context.Context, key string, members []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	set, err := client.set(key)
+	if err != nil || set == nil {
+		return models.DefaultIntResponse, err
+	}
+	var removed int64
+	for _, member := range members {
+		if _, found := set[member]; found {
+			delete(set, member)
+			removed++
+		}
+	}
+	client.store(key, set)
+	return removed, nil
+}
+
+func (client *base) SMembers(ctx context.Context, key string) (map[string]struct{}, error) {
+	return client.combine(ctx, []string{key}, union)
+}
+
+func (client *base) SCard(ctx context.Context, key string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	set, err := client.set(key)
+	return int64(len(set)), err
+}
+
+func (client *base) SIsMember(ctx context.Context, key string, member string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	set, err := client.set(key)
+	_, found := set[member]
+	return found, err
+}
+
+func (client *base) SMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	set, err := client.set(key)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]bool, len(members))
+	for i, member := range members {
+		_, result[i] = set[member]
+	}
+	return result, nil
+}
+
+// setOperation combines the members of a set with the members of another set.
+type setOperation func(result map[string]struct{}, other map[string]struct{})
+
+func union(result map[string]struct{}, other map[string]struct{}) {
+	for member := range other {
+		result[member] = struct{}{}
+	}
+}
+
+func intersection(result map[string]struct{}, other map[string]struct{}) {
+	for member := range result {
+		if _, found := other[member]; !found {
+			delete(result, member)
+		}
+	}
+}
+
+func difference(result map[string]struct{}, other map[string]struct{}) {
+	for member := range other {
+		delete(result, member)
+	}
+}
+
+func (client *base) combine(ctx context.Context, keys []string, operation setOperation) (map[string]struct{}, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	return client.combineSets(keys, operation)
+}
+
+// combineSets applies the operation to the sets stored at the keys, in order.
+func (client *base) combineSets(keys []string, operation setOperation) (map[string]struct{}, error) {
+	var result map[string]struct{}
+	for i, key := range keys {
+		set, err := client.set(key)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result = maps.Clone(set)
+			if result == nil {
+				result = map[string]struct{}{}
+			}
+		} else {
+			operation(result, set)
+		}
+	}
+	return result, nil
+}
+
+func (client *base) combineAndStore(
+	ctx context.Context,
+	destination string,
+	keys []string,
+	operation setOperation,
+) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	result, err := client.combineSets(keys, operation)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	client.replace(destination, result)
+	return int64(len(result)), nil
+}
+
+func (client *base) SDiff(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return client.combine(ctx, keys, difference)
+}
+
+func (client *base) SDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.combineAndStore(ctx, destination, keys, difference)
+}
+
+func (client *base) SInter(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return client.combine(ctx, keys, intersection)
+}
+
+func (client *base) SInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.combineAndStore(ctx, destination, keys, intersection)
+}
+
+func (client *base) SUnion(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return client.combine(ctx, keys, union)
+}
+
+func (client *base) SUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return client.combineAndStore(ctx, destination, keys, union)
+}
+
+func (client *base) SInterCard(ctx context.Context, keys []string) (int64, error) {
+	return client.SInterCardLimit(ctx, keys, 0)
+}
+
+func (client *base) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
+	result, err := client.combine(ctx, keys, intersection)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if limit > 0 {
+		return min(int64(len(result)), limit), nil
+	}
+	return int64(len(result)), nil
+}
+
+func (client *base) SRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	members, err := client.SRandMemberCount(ctx, key, 1)
+	if err != nil || len(members) == 0 {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(members[0]), nil
+}
+
+func (client *base) SRandMemberCount(ctx context.Context, key string, count int64) ([]string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	set, err := client.set(key)
+	if err != nil {
+		return nil, err
+	}
+	return randomMembers(sortedKeys(set), count), nil
+}
+
+func (client *base) SPop(ctx context.Context, key string) (models.Result[string], error) {
+	popped, err := client.SPopCount(ctx, key, 1)
+	for member := range popped {
+		return models.CreateStringResult(member), nil
+	}
+	return models.CreateNilStringResult(), err
+}
+
+func (client *base) SPopCount(ctx context.Context, key string, count int64) (map[string]struct{}, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	if count < 0 {
+		return nil, &errors.RequestError{Msg: "ERR value is out of range, must be positive"}
+	}
+	set, err := client.set(key)
+	if err != nil {
+		return nil, err
+	}
+	popped := make(map[string]struct{})
+	for _, member := range randomMembers(sortedKeys(set), count) {
+		popped[member] = struct{}{}
+		delete(set, member)
+	}
+	client.store(key, set)
+	return popped, nil
+}
+
+func (client *base) SMove(ctx context.Context, source string, destination string, member string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	set, err := client.set(source)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	target, err := client.set(destination)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	if _, found := set[member]; !found {
+		return false, nil
+	}
+	delete(set, member)
+	client.store(source, set)
+	if target == nil {
+		target = make(map[string]struct{})
+	}
+	target[member] = struct{}{}
+	client.store(destination, target)
+	return true, nil
+}
+
+func (client *base) SScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.SScanWithOptions(ctx, key, cursor, *options.NewBaseScanOptions())
+}
+
+func (client *base) SScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.BaseScanOptions,
+) (string, []string, error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	defer client.release()
+	scan, _, err := parseScanArgs(optionArgs)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	position, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil {
+		return models.DefaultStringResponse, nil, &errors.RequestError{Msg: "ERR invalid cursor"}
+	}
+	set, err := client.set(key)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	nextCursor, members := scan.page(sortedKeys(set), position)
+	result := []string{}
+	for _, member := range members {
+		if scan.matches(member) {
+			result = append(result, member)
+		}
+	}
+	return nextCursor, result, nil
+}
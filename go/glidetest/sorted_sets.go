@@ -0,0 +1,891 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/utils"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+func (client *base) zset(key string) (map[string]float64, error) {
+	zset, _, err := lookupValue[map[string]float64](client, key)
+	return zset, err
+}
+
+// ordered returns the members of the sorted set ordered by score, then lexicographically.
+func ordered(zset map[string]float64) []models.MemberAndScore {
+	members := make([]models.MemberAndScore, 0, len(zset))
+	for member, score := range zset {
+		members = append(members, models.MemberAndScore{Member: member, Score: score})
+	}
+	slices.SortFunc(members, func(a, b models.MemberAndScore) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), strings.Compare(a.Member, b.Member))
+	})
+	return members
+}
+
+func memberNames(members []models.MemberAndScore) []string {
+	names := make([]string, len(members))
+	for i, member := range members {
+		names[i] = member.Member
+	}
+	return names
+}
+
+func (client *base) ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error) {
+	return client.ZAddWithOptions(ctx, key, membersScoreMap, *options.NewZAddOptions())
+}
+
+func (client *base) ZAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersScoreMap map[string]float64,
+	opts options.ZAddOptions,
+) (int64, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	zadd := parseZAddArgs(optionArgs)
+	if zadd.incr {
+		return models.DefaultIntResponse, &errors.RequestError{Msg: "ERR INCR option supports a single increment-element pair"}
+	}
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if zset == nil {
+		zset = make(map[string]float64, len(membersScoreMap))
+	}
+	var count int64
+	for member, score := range membersScoreMap {
+		_, added, changed := zadd.apply(zset, member, score)
+		if added || (zadd.changed && changed) {
+			count++
+		}
+	}
+	client.store(key, zset)
+	return count, nil
+}
+
+func (client *base) ZAddIncr(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+) (models.Result[float64], error) {
+	return client.ZAddIncrWithOptions(ctx, key, member, increment, *options.NewZAddOptions())
+}
+
+func (client *base) ZAddIncrWithOptions(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+	opts options.ZAddOptions,
+) (models.Result[float64], error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	defer client.release()
+	zadd := parseZAddArgs(optionArgs)
+	zadd.incr = true
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	if zset == nil {
+		zset = make(map[string]float64)
+	}
+	applied, _, _ := zadd.apply(zset, member, increment)
+	client.store(key, zset)
+	if !applied {
+		return models.CreateNilFloat64Result(), nil
+	}
+	return models.CreateFloat64Result(zset[member]), nil
+}
+
+// zaddArgs holds the options of the ZADD command.
+type zaddArgs struct {
+	condition constants.ConditionalSet
+	update    options.UpdateOptions
+	changed   bool
+	incr      bool
+}
+
+func parseZAddArgs(args []string) zaddArgs {
+	var zadd zaddArgs
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case string(constants.OnlyIfExists), string(constants.OnlyIfDoesNotExist):
+			zadd.condition = constants.ConditionalSet(args[i])
+		case string(options.ScoreGreaterThanCurrent), string(options.ScoreLessThanCurrent):
+			zadd.update = options.UpdateOptions(args[i])
+		case constants.ChangedKeyword:
+			zadd.changed = true
+		case constants.IncrKeyword:
+			// the increment and the member are passed separately
+			zadd.incr = true
+			i += 2
+		}
+	}
+	return zadd
+}
+
+// apply adds or updates a member according to the options. It reports whether the options allowed the update, whether the
+// member was added, and whether its score changed.
+func (zadd zaddArgs) apply(zset map[string]float64, member string, score float64) (bool, bool, bool) {
+	current, found := zset[member]
+	if (zadd.condition == constants.OnlyIfExists && !found) || (zadd.condition == constants.OnlyIfDoesNotExist && found) {
+		return false, false, false
+	}
+	if zadd.incr {
+		score += current
+	}
+	if found {
+		if (zadd.update == options.ScoreGreaterThanCurrent && score <= current) ||
+			(zadd.update == options.ScoreLessThanCurrent && score >= current) {
+			return false, false, false
+		}
+	}
+	zset[member] = score
+	return true, !found, found && score != current
+}
+
+func (client *base) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+	result, err := client.ZAddIncr(ctx, key, member, increment)
+	if err != nil {
+		return models.DefaultFloatResponse, err
+	}
+	return result.Value(), nil
+}
+
+func (client *base) ZRem(ctx context.Context, key string, members []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil || zset == nil {
+		return models.DefaultIntResponse, err
+	}
+	var removed int64
+	for _, member := range members {
+		if _, found := zset[member]; found {
+			delete(zset, member)
+			removed++
+		}
+	}
+	client.store(key, zset)
+	return removed, nil
+}
+
+func (client *base) ZCard(ctx context.Context, key string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	return int64(len(zset)), err
+}
+
+func (client *base) ZScore(ctx context.Context, key string, member string) (models.Result[float64], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	if score, found := zset[member]; found {
+		return models.CreateFloat64Result(score), nil
+	}
+	return models.CreateNilFloat64Result(), nil
+}
+
+func (client *base) ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return nil, err
+	}
+	scores := make([]models.Result[float64], len(members))
+	for i, member := range members {
+		if score, found := zset[member]; found {
+			scores[i] = models.CreateFloat64Result(score)
+		} else {
+			scores[i] = models.CreateNilFloat64Result()
+		}
+	}
+	return scores, nil
+}
+
+func (client *base) ZPopMin(ctx context.Context, key string) (map[string]float64, error) {
+	return client.zpop(ctx, key, constants.MIN, 1)
+}
+
+func (client *base) ZPopMax(ctx context.Context, key string) (map[string]float64, error) {
+	return client.zpop(ctx, key, constants.MAX, 1)
+}
+
+func (client *base) ZPopMinWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	return client.zpopWithOptions(ctx, key, constants.MIN, options)
+}
+
+func (client *base) ZPopMaxWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	return client.zpopWithOptions(ctx, key, constants.MAX, options)
+}
+
+func (client *base) zpopWithOptions(
+	ctx context.Context,
+	key string,
+	scoreFilter constants.ScoreFilter,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	optionArgs, err := options.ToArgs(false)
+	if err != nil {
+		return nil, err
+	}
+	count := int64(1)
+	if len(optionArgs) == 1 {
+		if count, err = strconv.ParseInt(optionArgs[0], 10, 64); err != nil {
+			return nil, notIntegerError()
+		}
+	}
+	return client.zpop(ctx, key, scoreFilter, count)
+}
+
+func (client *base) zpop(
+	ctx context.Context,
+	key string,
+	scoreFilter constants.ScoreFilter,
+	count int64,
+) (map[string]float64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	popped, err := client.popMembers(key, scoreFilter, count)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]float64, len(popped))
+	for _, member := range popped {
+		result[member.Member] = member.Score
+	}
+	return result, nil
+}
+
+// popMembers removes up to count members with the lowest or highest scores from the sorted set.
+func (client *base) popMembers(
+	key string,
+	scoreFilter constants.ScoreFilter,
+	count int64,
+) ([]models.MemberAndScore, error) {
+	zset, err := client.zset(key)
+	if err != nil {
+		return nil, err
+	}
+	members := ordered(zset)
+	if scoreFilter == constants.MAX {
+		slices.Reverse(members)
+	}
+	members = members[:min(max(count, 0), int64(len(members)))]
+	for _, member := range members {
+		delete(zset, member.Member)
+	}
+	client.store(key, zset)
+	return members, nil
+}
+
+func (client *base) ZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.ZMPopWithOptions(ctx, keys, scoreFilter, *options.NewZMPopOptions())
+}
+
+func (client *base) ZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	opts options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	if _, err := scoreFilter.ToString(); err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+	count := int64(1)
+	if len(optionArgs) == 2 {
+		if count, err = strconv.ParseInt(optionArgs[1], 10, 64); err != nil {
+			return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), notIntegerError()
+		}
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+	defer client.release()
+	for _, key := range keys {
+		popped, err := client.popMembers(key, scoreFilter, count)
+		if err != nil {
+			return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+		}
+		if len(popped) > 0 {
+			return models.CreateKeyWithArrayOfMembersAndScoresResult(
+				models.KeyWithArrayOfMembersAndScores{Key: key, MembersAndScores: popped},
+			), nil
+		}
+	}
+	return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), nil
+}
+
+// BZMPop pops members from the first non-empty sorted set. The fake does not block, and returns nil if all sorted sets
+// are empty.
+func (client *base) BZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.ZMPop(ctx, keys, scoreFilter)
+}
+
+// BZMPopWithOptions pops members from the first non-empty sorted set. The fake does not block, and returns nil if all
+// sorted sets are empty.
+func (client *base) BZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeoutSecs float64,
+	options options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return client.ZMPopWithOptions(ctx, keys, scoreFilter, options)
+}
+
+// BZPopMin pops the member with the lowest score from the first non-empty sorted set. The fake does not block, and
+// returns nil if all sorted sets are empty.
+func (client *base) BZPopMin(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	return client.bzpop(ctx, keys, constants.MIN)
+}
+
+// BZPopMax pops the member with the highest score from the first non-empty sorted set. The fake does not block, and
+// returns nil if all sorted sets are empty.
+func (client *base) BZPopMax(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	return client.bzpop(ctx, keys, constants.MAX)
+}
+
+func (client *base) bzpop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	result, err := client.ZMPop(ctx, keys, scoreFilter)
+	if err != nil || result.IsNil() {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
+	popped := result.Value()
+	return models.CreateKeyWithMemberAndScoreResult(models.KeyWithMemberAndScore{
+		Key:    popped.Key,
+		Member: popped.MembersAndScores[0].Member,
+		Score:  popped.MembersAndScores[0].Score,
+	}), nil
+}
+
+func (client *base) ZRange(ctx context.Context, key string, rangeQuery options.ZRangeQuery) ([]string, error) {
+	members, err := client.zrange(ctx, key, rangeQuery)
+	if err != nil {
+		return nil, err
+	}
+	return memberNames(members), nil
+}
+
+func (client *base) ZRangeWithScores(
+	ctx context.Context,
+	key string,
+	rangeQuery options.ZRangeQueryWithScores,
+) ([]models.MemberAndScore, error) {
+	return client.zrange(ctx, key, rangeQuery)
+}
+
+func (client *base) ZRangeStore(
+	ctx context.Context,
+	destination string,
+	key string,
+	rangeQuery options.ZRangeQuery,
+) (int64, error) {
+	queryArgs, err := rangeQuery.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	members, err := rangeMembers(zset, queryArgs)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	result := make(map[string]float64, len(members))
+	for _, member := range members {
+		result[member.Member] = member.Score
+	}
+	client.replace(destination, result)
+	return int64(len(result)), nil
+}
+
+func (client *base) zrange(
+	ctx context.Context,
+	key string,
+	rangeQuery options.ZRangeQuery,
+) ([]models.MemberAndScore, error) {
+	queryArgs, err := rangeQuery.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return nil, err
+	}
+	return rangeMembers(zset, queryArgs)
+}
+
+// rangeMembers returns the members selected by the arguments of a ZRANGE query.
+func rangeMembers(zset map[string]float64, args []string) ([]models.MemberAndScore, error) {
+	var byScore, byLex, reverse bool
+	offset, count := int64(0), int64(-1)
+	for i := 2; i < len(args); i++ {
+		switch args[i] {
+		case "BYSCORE":
+			byScore = true
+		case "BYLEX":
+			byLex = true
+		case "REV":
+			reverse = true
+		case "LIMIT":
+			offset, _ = strconv.ParseInt(args[i+1], 10, 64)
+			count, _ = strconv.ParseInt(args[i+2], 10, 64)
+			i += 2
+		}
+	}
+
+	members := ordered(zset)
+	if reverse {
+		slices.Reverse(members)
+	}
+	if !byScore && !byLex {
+		start, _ := strconv.ParseInt(args[0], 10, 64)
+		end, _ := strconv.ParseInt(args[1], 10, 64)
+		from, to, nonEmpty := normalizeRange(start, end, len(members))
+		if !nonEmpty {
+			return []models.MemberAndScore{}, nil
+		}
+		return members[from : to+1], nil
+	}
+
+	// reversed queries pass the upper bound first
+	low, high := args[0], args[1]
+	if reverse {
+		low, high = high, low
+	}
+	inRange, err := boundaryFilter(low, high, byLex)
+	if err != nil {
+		return nil, err
+	}
+	result := []models.MemberAndScore{}
+	for _, member := range members {
+		if !inRange(member) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if count >= 0 && int64(len(result)) == count {
+			break
+		}
+		result = append(result, member)
+	}
+	return result, nil
+}
+
+// boundaryFilter returns a function which reports whether a member is within the score or lexicographical range.
+func boundaryFilter(low string, high string, byLex bool) (func(models.MemberAndScore) bool, error) {
+	if byLex {
+		above, err := lexBound(low, true)
+		if err != nil {
+			return nil, err
+		}
+		below, err := lexBound(high, false)
+		if err != nil {
+			return nil, err
+		}
+		return func(member models.MemberAndScore) bool { return above(member.Member) && below(member.Member) }, nil
+	}
+	above, err := scoreBound(low, true)
+	if err != nil {
+		return nil, err
+	}
+	below, err := scoreBound(high, false)
+	if err != nil {
+		return nil, err
+	}
+	return func(member models.MemberAndScore) bool { return above(member.Score) && below(member.Score) }, nil
+}
+
+func scoreBound(bound string, lower bool) (func(float64) bool, error) {
+	exclusive := strings.HasPrefix(bound, "(")
+	bound = strings.TrimPrefix(bound, "(")
+	var value float64
+	switch bound {
+	case "-inf":
+		value = math.Inf(-1)
+	case "+inf", "inf":
+		value = math.Inf(1)
+	default:
+		var err error
+		if value, err = strconv.ParseFloat(bound, 64); err != nil {
+			return nil, &errors.RequestError{Msg: "ERR min or max is not a float"}
+		}
+	}
+	return func(score float64) bool {
+		switch {
+		case lower && exclusive:
+			return score > value
+		case lower:
+			return score >= value
+		case exclusive:
+			return score < value
+		default:
+			return score <= value
+		}
+	}, nil
+}
+
+func lexBound(bound string, lower bool) (func(string) bool, error) {
+	switch {
+	case bound == "-" || bound == "+":
+		// "-" is lower than all members and "+" is greater than all members
+		includesAll := (bound == "-") == lower
+		return func(string) bool { return includesAll }, nil
+	case len(bound) == 0 || (bound[0] != '(' && bound[0] != '['):
+		return nil, &errors.RequestError{Msg: "ERR min or max not valid string range item"}
+	}
+	exclusive, value := bound[0] == '(', bound[1:]
+	return func(member string) bool {
+		comparison := strings.Compare(member, value)
+		if !lower {
+			comparison = -comparison
+		}
+		return comparison > 0 || (comparison == 0 && !exclusive)
+	}, nil
+}
+
+func (client *base) ZRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	rank, _, err := client.zrank(ctx, key, member, false)
+	return rank, err
+}
+
+func (client *base) ZRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (models.Result[int64], models.Result[float64], error) {
+	return client.zrank(ctx, key, member, false)
+}
+
+func (client *base) ZRevRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	rank, _, err := client.zrank(ctx, key, member, true)
+	return rank, err
+}
+
+func (client *base) ZRevRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (models.Result[int64], models.Result[float64], error) {
+	return client.zrank(ctx, key, member, true)
+}
+
+func (client *base) zrank(
+	ctx context.Context,
+	key string,
+	member string,
+	reverse bool,
+) (models.Result[int64], models.Result[float64], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilInt64Result(), models.CreateNilFloat64Result(), err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.CreateNilInt64Result(), models.CreateNilFloat64Result(), err
+	}
+	members := ordered(zset)
+	if reverse {
+		slices.Reverse(members)
+	}
+	for rank, candidate := range members {
+		if candidate.Member == member {
+			return models.CreateInt64Result(int64(rank)), models.CreateFloat64Result(candidate.Score), nil
+		}
+	}
+	return models.CreateNilInt64Result(), models.CreateNilFloat64Result(), nil
+}
+
+func (client *base) ZCount(ctx context.Context, key string, rangeOptions options.ZCountRange) (int64, error) {
+	rangeArgs, err := rangeOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return client.countInRange(ctx, key, rangeArgs, false)
+}
+
+func (client *base) ZLexCount(ctx context.Context, key string, rangeQuery *options.RangeByLex) (int64, error) {
+	return client.countInRange(ctx, key, rangeQuery.ToArgsLexCount(), true)
+}
+
+func (client *base) countInRange(ctx context.Context, key string, rangeArgs []string, byLex bool) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	inRange, err := boundaryFilter(rangeArgs[0], rangeArgs[1], byLex)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	var count int64
+	for member, score := range zset {
+		if inRange(models.MemberAndScore{Member: member, Score: score}) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (client *base) ZRemRangeByRank(ctx context.Context, key string, start int64, stop int64) (int64, error) {
+	return client.removeRange(ctx, key, []string{utils.IntToString(start), utils.IntToString(stop)})
+}
+
+func (client *base) ZRemRangeByScore(ctx context.Context, key string, rangeQuery options.RangeByScore) (int64, error) {
+	rangeArgs, err := rangeQuery.ToArgsRemRange()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return client.removeRange(ctx, key, append(rangeArgs, "BYSCORE"))
+}
+
+func (client *base) ZRemRangeByLex(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
+	rangeArgs, err := rangeQuery.ToArgsRemRange()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return client.removeRange(ctx, key, append(rangeArgs, "BYLEX"))
+}
+
+// removeRange removes the members selected by the arguments of a ZRANGE query.
+func (client *base) removeRange(ctx context.Context, key string, rangeArgs []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	members, err := rangeMembers(zset, rangeArgs)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	for _, member := range members {
+		delete(zset, member.Member)
+	}
+	client.store(key, zset)
+	return int64(len(members)), nil
+}
+
+func (client *base) ZRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	members, err := client.ZRandMemberWithCount(ctx, key, 1)
+	if err != nil || len(members) == 0 {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(members[0]), nil
+}
+
+func (client *base) ZRandMemberWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	members, err := client.ZRandMemberWithCountWithScores(ctx, key, count)
+	if err != nil {
+		return nil, err
+	}
+	return memberNames(members), nil
+}
+
+func (client *base) ZRandMemberWithCountWithScores(
+	ctx context.Context,
+	key string,
+	count int64,
+) ([]models.MemberAndScore, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	zset, err := client.zset(key)
+	if err != nil {
+		return nil, err
+	}
+	names := randomMembers(sortedKeys(zset), count)
+	members := make([]models.MemberAndScore, len(names))
+	for i, name := range names {
+		members[i] = models.MemberAndScore{Member: name, Score: zset[name]}
+	}
+	return members, nil
+}
+
+func (client *base) ZDiff(ctx context.Context, keys []string) ([]string, error) {
+	members, err := client.ZDiffWithScores(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	return memberNames(members), nil
+}
+
+func (client *base) ZDiffWithScores(ctx context.Context, keys []string) ([]models.MemberAndScore, error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	diff, err := client.zdiff(keys)
+	if err != nil {
+		return nil, err
+	}
+	return ordered(diff), nil
+}
+
+func (client *base) ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	diff, err := client.zdiff(keys)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	client.replace(destination, diff)
+	return int64(len(diff)), nil
+}
+
+// zdiff returns the members of the first sorted set which are not in the other sorted sets.
+func (client *base) zdiff(keys []string) (map[string]float64, error) {
+	diff := map[string]float64{}
+	for i, key := range keys {
+		zset, err := client.zset(key)
+		if err != nil {
+			return nil, err
+		}
+		for member, score := range zset {
+			if i == 0 {
+				diff[member] = score
+			} else {
+				delete(diff, member)
+			}
+		}
+	}
+	return diff, nil
+}
+
+func (client *base) ZScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.ZScanWithOptions(ctx, key, cursor, *options.NewZScanOptions())
+}
+
+func (client *base) ZScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.ZScanOptions,
+) (string, []string, error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	defer client.release()
+	scan, optionArgs, err := parseScanArgs(optionArgs)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	position, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil {
+		return models.DefaultStringResponse, nil, &errors.RequestError{Msg: "ERR invalid cursor"}
+	}
+	zset, err := client.zset(key)
+	if err != nil {
+		return models.DefaultStringResponse, nil, err
+	}
+	noScores := slices.Contains(optionArgs, constants.NoScoresKeyword)
+	nextCursor, members := scan.page(memberNames(ordered(zset)), position)
+	result := []string{}
+	for _, member := range members {
+		if scan.matches(member) {
+			result = append(result, member)
+			if !noScores {
+				result = append(result, utils.FloatToString(zset[member]))
+			}
+		}
+	}
+	return nextCursor, result, nil
+}
@@ -0,0 +1,454 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// streamId is the ID of a stream entry.
+type streamId struct {
+	ms, seq uint64
+}
+
+func (id streamId) String() string {
+	return fmt.Sprintf("%d-%d", id.ms, id.seq)
+}
+
+func (id streamId) compare(other streamId) int {
+	if id.ms != other.ms {
+		return compareUint(id.ms, other.ms)
+	}
+	return compareUint(id.seq, other.seq)
+}
+
+func compareUint(a uint64, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// parseStreamId parses a full or partial stream ID. The missing sequence number of a partial ID is set to defaultSeq.
+func parseStreamId(id string, defaultSeq uint64) (streamId, error) {
+	msPart, seqPart, hasSeq := strings.Cut(id, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return streamId{}, invalidStreamIdError()
+	}
+	if !hasSeq {
+		return streamId{ms, defaultSeq}, nil
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return streamId{}, invalidStreamIdError()
+	}
+	return streamId{ms, seq}, nil
+}
+
+func invalidStreamIdError() error {
+	return &errors.RequestError{Msg: "ERR Invalid stream ID specified as stream command argument"}
+}
+
+type streamEntry struct {
+	id     streamId
+	fields [][]string
+}
+
+type stream struct {
+	entries []streamEntry
+	lastId  streamId
+}
+
+func (s *stream) clone() *stream {
+	return &stream{entries: slices.Clone(s.entries), lastId: s.lastId}
+}
+
+// nextId generates the ID of a new entry. The requested ID may be "*", "<ms>-*" or an explicit ID.
+func (s *stream) nextId(requested string, nowMs uint64) (streamId, error) {
+	var id streamId
+	switch msPart, seqPart, _ := strings.Cut(requested, "-"); {
+	case requested == "*":
+		id = streamId{max(nowMs, s.lastId.ms), 0}
+		if id.ms == s.lastId.ms {
+			id.seq = s.lastId.seq + 1
+		}
+	case seqPart == "*":
+		ms, err := strconv.ParseUint(msPart, 10, 64)
+		if err != nil {
+			return streamId{}, invalidStreamIdError()
+		}
+		id = streamId{ms, 0}
+		if ms == s.lastId.ms {
+			id.seq = s.lastId.seq + 1
+		}
+	default:
+		var err error
+		if id, err = parseStreamId(requested, 0); err != nil {
+			return streamId{}, err
+		}
+	}
+	if id.compare(s.lastId) <= 0 {
+		if id == (streamId{}) {
+			return streamId{}, &errors.RequestError{Msg: "ERR The ID specified in XADD must be greater than 0-0"}
+		}
+		return streamId{}, &errors.RequestError{
+			Msg: "ERR The ID specified in XADD is equal or smaller than the target stream top item",
+		}
+	}
+	return id, nil
+}
+
+// trim removes entries according to the arguments of XTRIM, and returns the number of removed entries.
+func (s *stream) trim(args []string) (int64, error) {
+	if len(args) < 2 {
+		return 0, syntaxError()
+	}
+	method, threshold := args[0], args[1]
+	if threshold == "=" || threshold == "~" {
+		if len(args) < 3 {
+			return 0, syntaxError()
+		}
+		threshold = args[2]
+	}
+	removed := 0
+	switch method {
+	case constants.MaxLenKeyword:
+		maxLen, err := strconv.Atoi(threshold)
+		if err != nil || maxLen < 0 {
+			return 0, notIntegerError()
+		}
+		removed = max(len(s.entries)-maxLen, 0)
+	case constants.MinIdKeyword:
+		minId, err := parseStreamId(threshold, 0)
+		if err != nil {
+			return 0, err
+		}
+		for removed < len(s.entries) && s.entries[removed].id.compare(minId) < 0 {
+			removed++
+		}
+	default:
+		return 0, syntaxError()
+	}
+	s.entries = s.entries[removed:]
+	return int64(removed), nil
+}
+
+func (client *base) stream(key string) (*stream, error) {
+	s, _, err := lookupValue[*stream](client, key)
+	return s, err
+}
+
+func (client *base) XAdd(ctx context.Context, key string, values [][]string) (models.Result[string], error) {
+	return client.XAddWithOptions(ctx, key, values, *options.NewXAddOptions())
+}
+
+func (client *base) XAddWithOptions(
+	ctx context.Context,
+	key string,
+	values [][]string,
+	options options.XAddOptions,
+) (models.Result[string], error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	for _, pair := range values {
+		if len(pair) != 2 {
+			return models.CreateNilStringResult(), fmt.Errorf(
+				"array entry had the wrong length. Expected length 2 but got length %d",
+				len(pair),
+			)
+		}
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+
+	s, err := client.stream(key)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	noMakeStream := len(optionArgs) > 0 && optionArgs[0] == constants.NoMakeStreamKeyword
+	if noMakeStream {
+		optionArgs = optionArgs[1:]
+	}
+	if s == nil {
+		if noMakeStream {
+			return models.CreateNilStringResult(), nil
+		}
+		s = &stream{}
+	}
+	id, err := s.nextId(optionArgs[len(optionArgs)-1], uint64(client.server.now().UnixMilli()))
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	fields := make([][]string, len(values))
+	for i, pair := range values {
+		fields[i] = slices.Clone(pair)
+	}
+	s.entries = append(s.entries, streamEntry{id: id, fields: fields})
+	s.lastId = id
+	if trimArgs := optionArgs[:len(optionArgs)-1]; len(trimArgs) > 0 {
+		if _, err := s.trim(trimArgs); err != nil {
+			return models.CreateNilStringResult(), err
+		}
+	}
+	client.store(key, s)
+	return models.CreateStringResult(id.String()), nil
+}
+
+func (client *base) XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error) {
+	trimArgs, err := options.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	s, err := client.stream(key)
+	if err != nil || s == nil {
+		return models.DefaultIntResponse, err
+	}
+	return s.trim(trimArgs)
+}
+
+func (client *base) XLen(ctx context.Context, key string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	s, err := client.stream(key)
+	if err != nil || s == nil {
+		return models.DefaultIntResponse, err
+	}
+	return int64(len(s.entries)), nil
+}
+
+func (client *base) XDel(ctx context.Context, key string, ids []string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	s, err := client.stream(key)
+	if err != nil || s == nil {
+		return models.DefaultIntResponse, err
+	}
+	deleted := map[streamId]bool{}
+	for _, id := range ids {
+		parsed, err := parseStreamId(id, 0)
+		if err != nil {
+			return models.DefaultIntResponse, err
+		}
+		deleted[parsed] = true
+	}
+	remaining := len(s.entries)
+	s.entries = slices.DeleteFunc(s.entries, func(e streamEntry) bool { return deleted[e.id] })
+	return int64(remaining - len(s.entries)), nil
+}
+
+func (client *base) XRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.XRangeResponse, error) {
+	return client.XRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
+}
+
+func (client *base) XRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) ([]models.XRangeResponse, error) {
+	return client.xrange(ctx, key, string(start), string(end), opts, false)
+}
+
+func (client *base) XRevRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.XRangeResponse, error) {
+	return client.XRevRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
+}
+
+func (client *base) XRevRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) ([]models.XRangeResponse, error) {
+	// XREVRANGE takes the upper bound first
+	return client.xrange(ctx, key, string(end), string(start), opts, true)
+}
+
+func (client *base) xrange(
+	ctx context.Context,
+	key string,
+	start string,
+	end string,
+	opts options.XRangeOptions,
+	reverse bool,
+) ([]models.XRangeResponse, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	count := int64(-1)
+	if len(optionArgs) == 2 {
+		if count, err = strconv.ParseInt(optionArgs[1], 10, 64); err != nil {
+			return nil, notIntegerError()
+		}
+	}
+	low, err := streamBound(start, true)
+	if err != nil {
+		return nil, err
+	}
+	high, err := streamBound(end, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	s, err := client.stream(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []models.XRangeResponse{}
+	if s == nil {
+		return result, nil
+	}
+	entries := slices.Clone(s.entries)
+	if reverse {
+		slices.Reverse(entries)
+	}
+	for _, e := range entries {
+		if count >= 0 && int64(len(result)) == count {
+			break
+		}
+		if e.id.compare(low) >= 0 && e.id.compare(high) <= 0 {
+			result = append(result, models.XRangeResponse{StreamId: e.id.String(), Entries: cloneFields(e.fields)})
+		}
+	}
+	return result, nil
+}
+
+// streamBound converts a range boundary to an inclusive ID.
+func streamBound(bound string, lower bool) (streamId, error) {
+	switch bound {
+	case "-":
+		return streamId{}, nil
+	case "+":
+		return streamId{math.MaxUint64, math.MaxUint64}, nil
+	}
+	exclusive := strings.HasPrefix(bound, "(")
+	defaultSeq := uint64(0)
+	if !lower {
+		defaultSeq = math.MaxUint64
+	}
+	id, err := parseStreamId(strings.TrimPrefix(bound, "("), defaultSeq)
+	if err != nil || !exclusive {
+		return id, err
+	}
+	switch {
+	case lower && id.seq == math.MaxUint64:
+		return streamId{id.ms + 1, 0}, nil
+	case lower:
+		return streamId{id.ms, id.seq + 1}, nil
+	case id.seq == 0:
+		return streamId{id.ms - 1, math.MaxUint64}, nil
+	}
+	return streamId{id.ms, id.seq - 1}, nil
+}
+
+func cloneFields(fields [][]string) [][]string {
+	clone := make([][]string, len(fields))
+	for i, pair := range fields {
+		clone[i] = slices.Clone(pair)
+	}
+	return clone
+}
+
+// XRead reads entries with an ID greater than the given IDs. The fake does not block, and returns nil if there are no new
+// entries.
+func (client *base) XRead(ctx context.Context, keysAndIds map[string]string) (map[string]map[string][][]string, error) {
+	return client.XReadWithOptions(ctx, keysAndIds, *options.NewXReadOptions())
+}
+
+// XReadWithOptions reads entries with an ID greater than the given IDs. The fake does not block, and returns nil if there
+// are no new entries.
+func (client *base) XReadWithOptions(
+	ctx context.Context,
+	keysAndIds map[string]string,
+	options options.XReadOptions,
+) (map[string]map[string][][]string, error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	count := int64(-1)
+	for i := 0; i+1 < len(optionArgs); i += 2 {
+		if optionArgs[i] == constants.CountKeyword {
+			if count, err = strconv.ParseInt(optionArgs[i+1], 10, 64); err != nil {
+				return nil, notIntegerError()
+			}
+		}
+	}
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+
+	var result map[string]map[string][][]string
+	for key, id := range keysAndIds {
+		s, err := client.stream(key)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil || id == "$" {
+			continue
+		}
+		after, err := parseStreamId(id, 0)
+		if err != nil {
+			return nil, err
+		}
+		entries := map[string][][]string{}
+		for _, e := range s.entries {
+			if count > 0 && int64(len(entries)) == count {
+				break
+			}
+			if e.id.compare(after) > 0 {
+				entries[e.id.String()] = cloneFields(e.fields)
+			}
+		}
+		if len(entries) > 0 {
+			if result == nil {
+				result = map[string]map[string][][]string{}
+			}
+			result[key] = entries
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,335 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/utils"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+func (client *base) Set(ctx context.Context, key string, value string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	client.replace(key, value)
+	return ok, nil
+}
+
+func (client *base) SetWithOptions(
+	ctx context.Context,
+	key string,
+	value string,
+	options options.SetOptions,
+) (models.Result[string], error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+
+	var condition constants.ConditionalSet
+	var comparisonValue string
+	var returnOldValue, keepTTL bool
+	var expireAt time.Time
+	for i := 0; i < len(optionArgs); i++ {
+		switch arg := optionArgs[i]; arg {
+		case string(constants.OnlyIfExists), string(constants.OnlyIfDoesNotExist):
+			condition = constants.ConditionalSet(arg)
+		case string(constants.OnlyIfEquals):
+			condition = constants.OnlyIfEquals
+			i++
+			comparisonValue = optionArgs[i]
+		case constants.ReturnOldValue:
+			returnOldValue = true
+		case string(constants.KeepExisting):
+			keepTTL = true
+		default:
+			i++
+			if expireAt, err = client.expiryTime(arg, optionArgs[i]); err != nil {
+				return models.CreateNilStringResult(), err
+			}
+		}
+	}
+
+	e := client.lookup(key)
+	oldValue := models.CreateNilStringResult()
+	if e != nil {
+		current, isString := e.value.(string)
+		if !isString && (returnOldValue || condition == constants.OnlyIfEquals) {
+			return models.CreateNilStringResult(), wrongTypeError()
+		}
+		oldValue = models.CreateStringResult(current)
+	}
+
+	applies := true
+	switch condition {
+	case constants.OnlyIfExists:
+		applies = e != nil
+	case constants.OnlyIfDoesNotExist:
+		applies = e == nil
+	case constants.OnlyIfEquals:
+		applies = e != nil && oldValue.Value() == comparisonValue
+	}
+	if applies {
+		if keepTTL && e != nil {
+			e.value = value
+		} else {
+			client.keyspace()[key] = &entry{value: value, expireAt: expireAt}
+		}
+	}
+
+	if returnOldValue {
+		return oldValue, nil
+	}
+	if !applies {
+		return models.CreateNilStringResult(), nil
+	}
+	return models.CreateStringResult(ok), nil
+}
+
+func (client *base) Get(ctx context.Context, key string) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	return client.get(key)
+}
+
+func (client *base) get(key string) (models.Result[string], error) {
+	value, found, err := lookupValue[string](client, key)
+	if err != nil || !found {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(value), nil
+}
+
+func (client *base) GetEx(ctx context.Context, key string) (models.Result[string], error) {
+	return client.GetExWithOptions(ctx, key, *options.NewGetExOptions())
+}
+
+func (client *base) GetExWithOptions(
+	ctx context.Context,
+	key string,
+	options options.GetExOptions,
+) (models.Result[string], error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+
+	result, err := client.get(key)
+	if err != nil || result.IsNil() {
+		return result, err
+	}
+	e := client.lookup(key)
+	if len(optionArgs) == 1 {
+		e.expireAt = time.Time{}
+	} else if len(optionArgs) == 2 {
+		if e.expireAt, err = client.expiryTime(optionArgs[0], optionArgs[1]); err != nil {
+			return models.CreateNilStringResult(), err
+		}
+	}
+	return result, nil
+}
+
+func (client *base) GetDel(ctx context.Context, key string) (models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	defer client.release()
+	result, err := client.get(key)
+	if err == nil && !result.IsNil() {
+		delete(client.keyspace(), key)
+	}
+	return result, err
+}
+
+func (client *base) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	for key, value := range keyValueMap {
+		client.replace(key, value)
+	}
+	return ok, nil
+}
+
+func (client *base) MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	defer client.release()
+	for key := range keyValueMap {
+		if client.lookup(key) != nil {
+			return false, nil
+		}
+	}
+	for key, value := range keyValueMap {
+		client.replace(key, value)
+	}
+	return true, nil
+}
+
+func (client *base) MGet(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	if err := client.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer client.release()
+	values := make([]models.Result[string], len(keys))
+	for i, key := range keys {
+		// MGET returns nil for keys which do not hold a string, instead of failing
+		if value, err := client.get(key); err == nil {
+			values[i] = value
+		} else {
+			values[i] = models.CreateNilStringResult()
+		}
+	}
+	return values, nil
+}
+
+func (client *base) Incr(ctx context.Context, key string) (int64, error) {
+	return client.IncrBy(ctx, key, 1)
+}
+
+func (client *base) Decr(ctx context.Context, key string) (int64, error) {
+	return client.IncrBy(ctx, key, -1)
+}
+
+func (client *base) DecrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	return client.IncrBy(ctx, key, -amount)
+}
+
+func (client *base) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	value, found, err := lookupValue[string](client, key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	var current int64
+	if found {
+		if current, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return models.DefaultIntResponse, notIntegerError()
+		}
+	}
+	current += amount
+	client.store(key, utils.IntToString(current))
+	return current, nil
+}
+
+func (client *base) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultFloatResponse, err
+	}
+	defer client.release()
+	value, found, err := lookupValue[string](client, key)
+	if err != nil {
+		return models.DefaultFloatResponse, err
+	}
+	var current float64
+	if found {
+		if current, err = strconv.ParseFloat(value, 64); err != nil {
+			return models.DefaultFloatResponse, notFloatError()
+		}
+	}
+	current += amount
+	client.store(key, utils.FloatToString(current))
+	return current, nil
+}
+
+func (client *base) Strlen(ctx context.Context, key string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	value, _, err := lookupValue[string](client, key)
+	return int64(len(value)), err
+}
+
+func (client *base) Append(ctx context.Context, key string, value string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	current, _, err := lookupValue[string](client, key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	client.store(key, current+value)
+	return int64(len(current) + len(value)), nil
+}
+
+func (client *base) SetRange(ctx context.Context, key string, offset int, value string) (int64, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	defer client.release()
+	if offset < 0 {
+		return models.DefaultIntResponse, &errors.RequestError{Msg: "ERR offset is out of range"}
+	}
+	current, _, err := lookupValue[string](client, key)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if value == "" {
+		return int64(len(current)), nil
+	}
+	buffer := []byte(current)
+	if end := offset + len(value); end > len(buffer) {
+		buffer = append(buffer, make([]byte, end-len(buffer))...)
+	}
+	copy(buffer[offset:], value)
+	client.store(key, string(buffer))
+	return int64(len(buffer)), nil
+}
+
+func (client *base) GetRange(ctx context.Context, key string, start int, end int) (string, error) {
+	if err := client.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	defer client.release()
+	value, _, err := lookupValue[string](client, key)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	from, to, nonEmpty := normalizeRange(int64(start), int64(end), len(value))
+	if !nonEmpty {
+		return "", nil
+	}
+	return value[from : to+1], nil
+}
+
+// normalizeRange converts an inclusive range with possibly negative indices to valid indices in a sequence of the given
+// length. It returns false if the range is empty.
+func normalizeRange(start int64, end int64, length int) (int, int, bool) {
+	size := int64(length)
+	if start < 0 {
+		start = max(size+start, 0)
+	}
+	if end < 0 {
+		end = size + end
+	}
+	end = min(end, size-1)
+	if start > end || start >= size {
+		return 0, 0, false
+	}
+	return int(start), int(end), true
+}
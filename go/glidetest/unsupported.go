@@ -0,0 +1,1022 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidetest
+
+import (
+	"context"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+)
+
+// The commands below are not supported by the fake, and fail with a RequestError.
+
+func (client *base) LCS(ctx context.Context, key1 string, key2 string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("LCS")
+}
+
+func (client *base) LCSLen(ctx context.Context, key1 string, key2 string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("LCSLen")
+}
+
+func (client *base) LCSWithOptions(
+	ctx context.Context,
+	key1,
+	key2 string,
+	opts options.LCSIdxOptions,
+) (map[string]any, error) {
+	return nil, unsupportedError("LCSWithOptions")
+}
+
+func (client *base) XAutoClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (models.XAutoClaimResponse, error) {
+	return models.XAutoClaimResponse{}, unsupportedError("XAutoClaim")
+}
+
+func (client *base) XAutoClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimResponse, error) {
+	return models.XAutoClaimResponse{}, unsupportedError("XAutoClaimWithOptions")
+}
+
+func (client *base) XAutoClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (models.XAutoClaimJustIdResponse, error) {
+	return models.XAutoClaimJustIdResponse{}, unsupportedError("XAutoClaimJustId")
+}
+
+func (client *base) XAutoClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimJustIdResponse, error) {
+	return models.XAutoClaimJustIdResponse{}, unsupportedError("XAutoClaimJustIdWithOptions")
+}
+
+func (client *base) XReadGroup(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+) (map[string]map[string][][]string, error) {
+	return nil, unsupportedError("XReadGroup")
+}
+
+func (client *base) XReadGroupWithOptions(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+	options options.XReadGroupOptions,
+) (map[string]map[string][][]string, error) {
+	return nil, unsupportedError("XReadGroupWithOptions")
+}
+
+func (client *base) XPending(ctx context.Context, key string, group string) (models.XPendingSummary, error) {
+	return models.CreateNilXPendingSummary(), unsupportedError("XPending")
+}
+
+func (client *base) XPendingWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	options options.XPendingOptions,
+) ([]models.XPendingDetail, error) {
+	return nil, unsupportedError("XPendingWithOptions")
+}
+
+func (client *base) XGroupSetId(ctx context.Context, key string, group string, id string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("XGroupSetId")
+}
+
+func (client *base) XGroupSetIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupSetIdOptions,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("XGroupSetIdWithOptions")
+}
+
+func (client *base) XGroupCreate(ctx context.Context, key string, group string, id string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("XGroupCreate")
+}
+
+func (client *base) XGroupCreateWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupCreateOptions,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("XGroupCreateWithOptions")
+}
+
+func (client *base) XGroupDestroy(ctx context.Context, key string, group string) (bool, error) {
+	return models.DefaultBoolResponse, unsupportedError("XGroupDestroy")
+}
+
+func (client *base) XGroupCreateConsumer(ctx context.Context, key string, group string, consumer string) (bool, error) {
+	return models.DefaultBoolResponse, unsupportedError("XGroupCreateConsumer")
+}
+
+func (client *base) XGroupDelConsumer(ctx context.Context, key string, group string, consumer string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("XGroupDelConsumer")
+}
+
+func (client *base) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("XAck")
+}
+
+func (client *base) XClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) (map[string][][]string, error) {
+	return nil, unsupportedError("XClaim")
+}
+
+func (client *base) XClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	options options.XClaimOptions,
+) (map[string][][]string, error) {
+	return nil, unsupportedError("XClaimWithOptions")
+}
+
+func (client *base) XClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) ([]string, error) {
+	return nil, unsupportedError("XClaimJustId")
+}
+
+func (client *base) XClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	options options.XClaimOptions,
+) ([]string, error) {
+	return nil, unsupportedError("XClaimJustIdWithOptions")
+}
+
+func (client *base) XInfoStream(ctx context.Context, key string) (map[string]any, error) {
+	return nil, unsupportedError("XInfoStream")
+}
+
+func (client *base) XInfoStreamFullWithOptions(
+	ctx context.Context,
+	key string,
+	options *options.XInfoStreamOptions,
+) (map[string]any, error) {
+	return nil, unsupportedError("XInfoStreamFullWithOptions")
+}
+
+func (client *base) XInfoConsumers(ctx context.Context, key string, group string) ([]models.XInfoConsumerInfo, error) {
+	return nil, unsupportedError("XInfoConsumers")
+}
+
+func (client *base) XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error) {
+	return nil, unsupportedError("XInfoGroups")
+}
+
+func (client *base) ZInter(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	return nil, unsupportedError("ZInter")
+}
+
+func (client *base) ZInterWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options options.ZInterOptions,
+) ([]models.MemberAndScore, error) {
+	return nil, unsupportedError("ZInterWithScores")
+}
+
+func (client *base) ZInterStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("ZInterStore")
+}
+
+func (client *base) ZInterStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options options.ZInterOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("ZInterStoreWithOptions")
+}
+
+func (client *base) ZUnion(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	return nil, unsupportedError("ZUnion")
+}
+
+func (client *base) ZUnionWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options *options.ZUnionOptions,
+) ([]models.MemberAndScore, error) {
+	return nil, unsupportedError("ZUnionWithScores")
+}
+
+func (client *base) ZUnionStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("ZUnionStore")
+}
+
+func (client *base) ZUnionStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zUnionOptions *options.ZUnionOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("ZUnionStoreWithOptions")
+}
+
+func (client *base) ZInterCard(ctx context.Context, keys []string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("ZInterCard")
+}
+
+func (client *base) ZInterCardWithOptions(
+	ctx context.Context,
+	keys []string,
+	options *options.ZInterCardOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("ZInterCardWithOptions")
+}
+
+func (client *base) PfAdd(ctx context.Context, key string, elements []string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("PfAdd")
+}
+
+func (client *base) PfCount(ctx context.Context, keys []string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("PfCount")
+}
+
+func (client *base) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("PfMerge")
+}
+
+func (client *base) Restore(ctx context.Context, key string, ttl int64, value string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("Restore")
+}
+
+func (client *base) RestoreWithOptions(
+	ctx context.Context,
+	key string,
+	ttl int64,
+	value string,
+	option options.RestoreOptions,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("RestoreWithOptions")
+}
+
+func (client *base) ObjectEncoding(ctx context.Context, key string) (models.Result[string], error) {
+	return models.CreateNilStringResult(), unsupportedError("ObjectEncoding")
+}
+
+func (client *base) Dump(ctx context.Context, key string) (models.Result[string], error) {
+	return models.CreateNilStringResult(), unsupportedError("Dump")
+}
+
+func (client *base) ObjectFreq(ctx context.Context, key string) (models.Result[int64], error) {
+	return models.CreateNilInt64Result(), unsupportedError("ObjectFreq")
+}
+
+func (client *base) ObjectIdleTime(ctx context.Context, key string) (models.Result[int64], error) {
+	return models.CreateNilInt64Result(), unsupportedError("ObjectIdleTime")
+}
+
+func (client *base) ObjectRefCount(ctx context.Context, key string) (models.Result[int64], error) {
+	return models.CreateNilInt64Result(), unsupportedError("ObjectRefCount")
+}
+
+func (client *base) Sort(ctx context.Context, key string) ([]models.Result[string], error) {
+	return nil, unsupportedError("Sort")
+}
+
+func (client *base) SortWithOptions(
+	ctx context.Context,
+	key string,
+	sortOptions options.SortOptions,
+) ([]models.Result[string], error) {
+	return nil, unsupportedError("SortWithOptions")
+}
+
+func (client *base) SortStore(ctx context.Context, key string, destination string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("SortStore")
+}
+
+func (client *base) SortStoreWithOptions(
+	ctx context.Context,
+	key string,
+	destination string,
+	sortOptions options.SortOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("SortStoreWithOptions")
+}
+
+func (client *base) SortReadOnly(ctx context.Context, key string) ([]models.Result[string], error) {
+	return nil, unsupportedError("SortReadOnly")
+}
+
+func (client *base) SortReadOnlyWithOptions(
+	ctx context.Context,
+	key string,
+	sortOptions options.SortOptions,
+) ([]models.Result[string], error) {
+	return nil, unsupportedError("SortReadOnlyWithOptions")
+}
+
+func (client *base) Wait(ctx context.Context, numberOfReplicas int64, timeout int64) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("Wait")
+}
+
+func (client *base) UpdateConnectionPassword(ctx context.Context, password string, immediateAuth bool) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("UpdateConnectionPassword")
+}
+
+func (client *base) ResetConnectionPassword(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ResetConnectionPassword")
+}
+
+func (client *base) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("SetBit")
+}
+
+func (client *base) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GetBit")
+}
+
+func (client *base) BitCount(ctx context.Context, key string) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("BitCount")
+}
+
+func (client *base) BitCountWithOptions(ctx context.Context, key string, options options.BitCountOptions) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("BitCountWithOptions")
+}
+
+func (client *base) BitPos(ctx context.Context, key string, bit int64) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("BitPos")
+}
+
+func (client *base) BitPosWithOptions(
+	ctx context.Context,
+	key string,
+	bit int64,
+	options options.BitPosOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("BitPosWithOptions")
+}
+
+func (client *base) BitField(
+	ctx context.Context,
+	key string,
+	subCommands []options.BitFieldSubCommands,
+) ([]models.Result[int64], error) {
+	return nil, unsupportedError("BitField")
+}
+
+func (client *base) BitFieldRO(
+	ctx context.Context,
+	key string,
+	commands []options.BitFieldROCommands,
+) ([]models.Result[int64], error) {
+	return nil, unsupportedError("BitFieldRO")
+}
+
+func (client *base) BitOp(
+	ctx context.Context,
+	bitwiseOperation options.BitOpType,
+	destination string,
+	keys []string,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("BitOp")
+}
+
+func (client *base) GeoAdd(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GeoAdd")
+}
+
+func (client *base) GeoAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+	options options.GeoAddOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GeoAddWithOptions")
+}
+
+func (client *base) GeoHash(ctx context.Context, key string, members []string) ([]string, error) {
+	return nil, unsupportedError("GeoHash")
+}
+
+func (client *base) GeoPos(ctx context.Context, key string, members []string) ([][]float64, error) {
+	return nil, unsupportedError("GeoPos")
+}
+
+func (client *base) GeoDist(ctx context.Context, key string, member1 string, member2 string) (models.Result[float64], error) {
+	return models.CreateNilFloat64Result(), unsupportedError("GeoDist")
+}
+
+func (client *base) GeoDistWithUnit(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+	unit constants.GeoUnit,
+) (models.Result[float64], error) {
+	return models.CreateNilFloat64Result(), unsupportedError("GeoDistWithUnit")
+}
+
+func (client *base) GeoSearch(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) ([]string, error) {
+	return nil, unsupportedError("GeoSearch")
+}
+
+func (client *base) GeoSearchWithInfoOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return nil, unsupportedError("GeoSearchWithInfoOptions")
+}
+
+func (client *base) GeoSearchWithResultOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) ([]string, error) {
+	return nil, unsupportedError("GeoSearchWithResultOptions")
+}
+
+func (client *base) GeoSearchWithFullOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return nil, unsupportedError("GeoSearchWithFullOptions")
+}
+
+func (client *base) GeoSearchStore(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GeoSearchStore")
+}
+
+func (client *base) GeoSearchStoreWithInfoOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	storeInfoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GeoSearchStoreWithInfoOptions")
+}
+
+func (client *base) GeoSearchStoreWithResultOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GeoSearchStoreWithResultOptions")
+}
+
+func (client *base) GeoSearchStoreWithFullOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	storeInfoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("GeoSearchStoreWithFullOptions")
+}
+
+func (client *base) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionLoad")
+}
+
+func (client *base) FunctionFlush(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionFlush")
+}
+
+func (client *base) FunctionFlushSync(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionFlushSync")
+}
+
+func (client *base) FunctionFlushAsync(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionFlushAsync")
+}
+
+func (client *base) FCall(ctx context.Context, function string) (any, error) {
+	return nil, unsupportedError("FCall")
+}
+
+func (client *base) FCallReadOnly(ctx context.Context, function string) (any, error) {
+	return nil, unsupportedError("FCallReadOnly")
+}
+
+func (client *base) FCallWithKeysAndArgs(ctx context.Context, function string, keys []string, args []string) (any, error) {
+	return nil, unsupportedError("FCallWithKeysAndArgs")
+}
+
+func (client *base) FCallReadOnlyWithKeysAndArgs(
+	ctx context.Context,
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	return nil, unsupportedError("FCallReadOnlyWithKeysAndArgs")
+}
+
+func (client *base) InvokeScript(ctx context.Context, script options.Script) (any, error) {
+	return nil, unsupportedError("InvokeScript")
+}
+
+func (client *base) InvokeScriptWithOptions(
+	ctx context.Context,
+	script options.Script,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return nil, unsupportedError("InvokeScriptWithOptions")
+}
+
+func (client *base) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
+	return nil, unsupportedError("ScriptExists")
+}
+
+func (client *base) ScriptFlush(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ScriptFlush")
+}
+
+func (client *base) ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ScriptFlushWithMode")
+}
+
+func (client *base) ScriptShow(ctx context.Context, sha1 string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ScriptShow")
+}
+
+func (client *base) ScriptKill(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ScriptKill")
+}
+
+func (client *Client) CustomCommand(ctx context.Context, args []string) (any, error) {
+	return nil, unsupportedError("CustomCommand")
+}
+
+func (client *Client) ConfigGet(ctx context.Context, args []string) (map[string]string, error) {
+	return nil, unsupportedError("ConfigGet")
+}
+
+func (client *Client) ConfigSet(ctx context.Context, parameters map[string]string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigSet")
+}
+
+func (client *Client) Info(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("Info")
+}
+
+func (client *Client) InfoWithOptions(ctx context.Context, options options.InfoOptions) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("InfoWithOptions")
+}
+
+func (client *Client) Lolwut(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("Lolwut")
+}
+
+func (client *Client) LolwutWithOptions(ctx context.Context, opts options.LolwutOptions) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("LolwutWithOptions")
+}
+
+func (client *Client) LastSave(ctx context.Context) (int64, error) {
+	return models.DefaultIntResponse, unsupportedError("LastSave")
+}
+
+func (client *Client) ConfigResetStat(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigResetStat")
+}
+
+func (client *Client) ConfigRewrite(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigRewrite")
+}
+
+func (client *Client) FunctionStats(ctx context.Context) (map[string]models.FunctionStatsResult, error) {
+	return nil, unsupportedError("FunctionStats")
+}
+
+func (client *Client) FunctionDelete(ctx context.Context, libName string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionDelete")
+}
+
+func (client *Client) FunctionKill(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionKill")
+}
+
+func (client *Client) FunctionList(ctx context.Context, query models.FunctionListQuery) ([]models.LibraryInfo, error) {
+	return nil, unsupportedError("FunctionList")
+}
+
+func (client *Client) FunctionDump(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionDump")
+}
+
+func (client *Client) FunctionRestore(ctx context.Context, payload string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionRestore")
+}
+
+func (client *Client) FunctionRestoreWithPolicy(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionRestoreWithPolicy")
+}
+
+func (client *Client) Exec(ctx context.Context, batch pipeline.StandaloneBatch, raiseOnError bool) ([]any, error) {
+	return nil, unsupportedError("Exec")
+}
+
+func (client *Client) ExecWithOptions(
+	ctx context.Context,
+	batch pipeline.StandaloneBatch,
+	raiseOnError bool,
+	options pipeline.StandaloneBatchOptions,
+) ([]any, error) {
+	return nil, unsupportedError("ExecWithOptions")
+}
+
+func (client *ClusterClient) CustomCommand(ctx context.Context, args []string) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("CustomCommand")
+}
+
+func (client *ClusterClient) CustomCommandWithRoute(
+	ctx context.Context,
+	args []string,
+	route config.Route,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("CustomCommandWithRoute")
+}
+
+func (client *ClusterClient) Scan(
+	ctx context.Context,
+	cursor options.ClusterScanCursor,
+) (options.ClusterScanCursor, []string, error) {
+	return options.ClusterScanCursor{}, nil, unsupportedError("Scan")
+}
+
+func (client *ClusterClient) ScanWithOptions(
+	ctx context.Context,
+	cursor options.ClusterScanCursor,
+	opts options.ClusterScanOptions,
+) (options.ClusterScanCursor, []string, error) {
+	return options.ClusterScanCursor{}, nil, unsupportedError("ScanWithOptions")
+}
+
+func (client *ClusterClient) Info(ctx context.Context) (map[string]string, error) {
+	return nil, unsupportedError("Info")
+}
+
+func (client *ClusterClient) InfoWithOptions(
+	ctx context.Context,
+	options options.ClusterInfoOptions,
+) (models.ClusterValue[string], error) {
+	return models.CreateEmptyClusterValue[string](), unsupportedError("InfoWithOptions")
+}
+
+func (client *ClusterClient) TimeWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	return models.CreateEmptyClusterValue[[]string](), unsupportedError("TimeWithOptions")
+}
+
+func (client *ClusterClient) Lolwut(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("Lolwut")
+}
+
+func (client *ClusterClient) LolwutWithOptions(
+	ctx context.Context,
+	lolwutOptions options.ClusterLolwutOptions,
+) (models.ClusterValue[string], error) {
+	return models.CreateEmptyClusterValue[string](), unsupportedError("LolwutWithOptions")
+}
+
+func (client *ClusterClient) LastSave(ctx context.Context) (models.ClusterValue[int64], error) {
+	return models.CreateEmptyClusterValue[int64](), unsupportedError("LastSave")
+}
+
+func (client *ClusterClient) LastSaveWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	return models.CreateEmptyClusterValue[int64](), unsupportedError("LastSaveWithOptions")
+}
+
+func (client *ClusterClient) ConfigResetStat(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigResetStat")
+}
+
+func (client *ClusterClient) ConfigResetStatWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigResetStatWithOptions")
+}
+
+func (client *ClusterClient) ConfigSet(ctx context.Context, parameters map[string]string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigSet")
+}
+
+func (client *ClusterClient) ConfigSetWithOptions(
+	ctx context.Context,
+	parameters map[string]string,
+	routeOption options.RouteOption,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigSetWithOptions")
+}
+
+func (client *ClusterClient) ConfigGet(ctx context.Context, parameters []string) (map[string]string, error) {
+	return nil, unsupportedError("ConfigGet")
+}
+
+func (client *ClusterClient) ConfigGetWithOptions(
+	ctx context.Context,
+	parameters []string,
+	routeOption options.RouteOption,
+) (models.ClusterValue[map[string]string], error) {
+	return models.CreateEmptyClusterValue[map[string]string](), unsupportedError("ConfigGetWithOptions")
+}
+
+func (client *ClusterClient) ConfigRewrite(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigRewrite")
+}
+
+func (client *ClusterClient) ConfigRewriteWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ConfigRewriteWithOptions")
+}
+
+func (client *ClusterClient) FunctionLoadWithRoute(
+	ctx context.Context,
+	libraryCode string,
+	replace bool,
+	route options.RouteOption,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionLoadWithRoute")
+}
+
+func (client *ClusterClient) FunctionFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionFlushWithRoute")
+}
+
+func (client *ClusterClient) FunctionFlushSyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionFlushSyncWithRoute")
+}
+
+func (client *ClusterClient) FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionFlushAsyncWithRoute")
+}
+
+func (client *ClusterClient) FCallWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("FCallWithRoute")
+}
+
+func (client *ClusterClient) FCallReadOnlyWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("FCallReadOnlyWithRoute")
+}
+
+func (client *ClusterClient) FCallWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("FCallWithArgs")
+}
+
+func (client *ClusterClient) FCallReadOnlyWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("FCallReadOnlyWithArgs")
+}
+
+func (client *ClusterClient) FCallWithArgsWithRoute(
+	ctx context.Context,
+	function string,
+	args []string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("FCallWithArgsWithRoute")
+}
+
+func (client *ClusterClient) FCallReadOnlyWithArgsWithRoute(
+	ctx context.Context,
+	function string,
+	args []string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("FCallReadOnlyWithArgsWithRoute")
+}
+
+func (client *ClusterClient) FunctionStats(ctx context.Context) (map[string]models.FunctionStatsResult, error) {
+	return nil, unsupportedError("FunctionStats")
+}
+
+func (client *ClusterClient) FunctionStatsWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (models.ClusterValue[models.FunctionStatsResult], error) {
+	return models.CreateEmptyClusterValue[models.FunctionStatsResult](), unsupportedError("FunctionStatsWithRoute")
+}
+
+func (client *ClusterClient) FunctionDelete(ctx context.Context, libName string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionDelete")
+}
+
+func (client *ClusterClient) FunctionDeleteWithRoute(
+	ctx context.Context,
+	libName string,
+	route options.RouteOption,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionDeleteWithRoute")
+}
+
+func (client *ClusterClient) FunctionKill(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionKill")
+}
+
+func (client *ClusterClient) FunctionKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionKillWithRoute")
+}
+
+func (client *ClusterClient) FunctionList(ctx context.Context, query models.FunctionListQuery) ([]models.LibraryInfo, error) {
+	return nil, unsupportedError("FunctionList")
+}
+
+func (client *ClusterClient) FunctionListWithRoute(
+	ctx context.Context,
+	query models.FunctionListQuery,
+	route options.RouteOption,
+) (models.ClusterValue[[]models.LibraryInfo], error) {
+	return models.CreateEmptyClusterValue[[]models.LibraryInfo](), unsupportedError("FunctionListWithRoute")
+}
+
+func (client *ClusterClient) FunctionDump(ctx context.Context) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionDump")
+}
+
+func (client *ClusterClient) FunctionDumpWithRoute(
+	ctx context.Context,
+	route config.Route,
+) (models.ClusterValue[string], error) {
+	return models.CreateEmptyClusterValue[string](), unsupportedError("FunctionDumpWithRoute")
+}
+
+func (client *ClusterClient) FunctionRestore(ctx context.Context, payload string) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionRestore")
+}
+
+func (client *ClusterClient) FunctionRestoreWithRoute(
+	ctx context.Context,
+	payload string,
+	route config.Route,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionRestoreWithRoute")
+}
+
+func (client *ClusterClient) FunctionRestoreWithPolicy(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionRestoreWithPolicy")
+}
+
+func (client *ClusterClient) FunctionRestoreWithPolicyWithRoute(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+	route config.Route,
+) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("FunctionRestoreWithPolicyWithRoute")
+}
+
+func (client *ClusterClient) InvokeScriptWithRoute(
+	ctx context.Context,
+	script options.Script,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("InvokeScriptWithRoute")
+}
+
+func (client *ClusterClient) InvokeScriptWithClusterOptions(
+	ctx context.Context,
+	script options.Script,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	return models.CreateEmptyClusterValue[any](), unsupportedError("InvokeScriptWithClusterOptions")
+}
+
+func (client *ClusterClient) ScriptExistsWithRoute(
+	ctx context.Context,
+	sha1s []string,
+	route options.RouteOption,
+) ([]bool, error) {
+	return nil, unsupportedError("ScriptExistsWithRoute")
+}
+
+func (client *ClusterClient) ScriptFlushWithOptions(ctx context.Context, options options.ScriptFlushOptions) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ScriptFlushWithOptions")
+}
+
+func (client *ClusterClient) ScriptKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	return models.DefaultStringResponse, unsupportedError("ScriptKillWithRoute")
+}
+
+func (client *ClusterClient) Exec(ctx context.Context, batch pipeline.ClusterBatch, raiseOnError bool) ([]any, error) {
+	return nil, unsupportedError("Exec")
+}
+
+func (client *ClusterClient) ExecWithOptions(
+	ctx context.Context,
+	batch pipeline.ClusterBatch,
+	raiseOnError bool,
+	options pipeline.ClusterBatchOptions,
+) ([]any, error) {
+	return nil, unsupportedError("ExecWithOptions")
+}
@@ -0,0 +1,149 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package hashstruct
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// field is a struct field mapped to a hash field.
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+var fieldCache sync.Map // map[reflect.Type][]field
+
+// fieldsOf returns the hash fields of a struct type, in the order of the struct fields. Embedded structs without a tag
+// are flattened, like with encoding/json.
+func fieldsOf(structType reflect.Type) []field {
+	if cached, found := fieldCache.Load(structType); found {
+		return cached.([]field)
+	}
+	fields := []field{}
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		tag, tagged := structField.Tag.Lookup("glide")
+		if tag == "-" {
+			continue
+		}
+		if structField.Anonymous && !tagged && structField.Type.Kind() == reflect.Struct {
+			for _, embedded := range fieldsOf(structField.Type) {
+				embedded.index = append([]int{i}, embedded.index...)
+				fields = append(fields, embedded)
+			}
+			continue
+		}
+		if !structField.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = structField.Name
+		}
+		fields = append(fields, field{name: name, index: structField.Index, omitEmpty: options == "omitempty"})
+	}
+	fieldCache.Store(structType, fields)
+	return fields
+}
+
+// structValue returns the struct value pointed to by value, or the value itself if it is a struct.
+func structValue(value any) (reflect.Value, error) {
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Pointer && !reflected.IsNil() {
+		reflected = reflected.Elem()
+	}
+	if reflected.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("hashstruct: expected a struct or a pointer to a struct, got %T", value)
+	}
+	return reflected, nil
+}
+
+// settableStruct returns the struct pointed to by dest.
+func settableStruct(dest any) (reflect.Value, error) {
+	reflected := reflect.ValueOf(dest)
+	if reflected.Kind() != reflect.Pointer || reflected.IsNil() || reflected.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("hashstruct: expected a non-nil pointer to a struct, got %T", dest)
+	}
+	return reflected.Elem(), nil
+}
+
+// encodeValue converts a field value to its string form. It returns false for nil pointers, which are not stored.
+func encodeValue(value reflect.Value) (string, bool, error) {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", false, nil
+		}
+		value = value.Elem()
+	}
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), true, err
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, value.Type().Bits()), true, nil
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return string(value.Bytes()), true, nil
+		}
+	}
+	// structs, maps, slices and arrays are stored as JSON
+	data, err := json.Marshal(value.Interface())
+	return string(data), true, err
+}
+
+// decodeValue parses the string form of a field into value.
+func decodeValue(data string, value reflect.Value) error {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		return decodeValue(data, value.Elem())
+	}
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(data))
+	}
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(data)
+		return nil
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(data)
+		value.SetBool(parsed)
+		return err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(data, 10, value.Type().Bits())
+		value.SetInt(parsed)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		parsed, err := strconv.ParseUint(data, 10, value.Type().Bits())
+		value.SetUint(parsed)
+		return err
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(data, value.Type().Bits())
+		value.SetFloat(parsed)
+		return err
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			value.SetBytes([]byte(data))
+			return nil
+		}
+	}
+	return json.Unmarshal([]byte(data), value.Addr().Interface())
+}
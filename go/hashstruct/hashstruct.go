@@ -0,0 +1,230 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package hashstruct maps Go structs to Valkey hashes.
+//
+// Each exported field of a struct is stored in the hash field named by its `glide` tag, or by the name of the struct
+// field when it has no tag. A field tagged with `glide:"-"` is ignored, and a field tagged with the `omitempty` option
+// is not stored when it holds its zero value:
+//
+//	type User struct {
+//		Name      string            `glide:"name"`
+//		Age       int               `glide:"age,omitempty"`
+//		CreatedAt time.Time         `glide:"created_at"`
+//		Labels    map[string]string `glide:"labels"`
+//		Password  string            `glide:"-"`
+//	}
+//
+// Strings, byte slices, booleans, integers and floats are stored in their usual string form, and values which implement
+// [encoding.TextMarshaler], such as [time.Time], as their text. Other values, such as nested structs, maps and slices,
+// are stored as JSON. Nil pointers are not stored.
+package hashstruct
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+)
+
+// Marshal converts a struct, or a pointer to a struct, to the fields and values of a hash.
+func Marshal(value any) (map[string]string, error) {
+	reflected, err := structValue(value)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for _, field := range fieldsOf(reflected.Type()) {
+		fieldValue := reflected.FieldByIndex(field.index)
+		if field.omitEmpty && fieldValue.IsZero() {
+			continue
+		}
+		encoded, stored, err := encodeValue(fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("hashstruct: field %s: %w", field.name, err)
+		}
+		if stored {
+			values[field.name] = encoded
+		}
+	}
+	return values, nil
+}
+
+// Unmarshal sets the fields of the struct pointed to by dest from the fields and values of a hash. The struct fields
+// which are not in the hash are left unchanged.
+func Unmarshal(values map[string]string, dest any) error {
+	reflected, err := settableStruct(dest)
+	if err != nil {
+		return err
+	}
+	for _, field := range fieldsOf(reflected.Type()) {
+		if data, found := values[field.name]; found {
+			if err := decodeValue(data, reflected.FieldByIndex(field.index)); err != nil {
+				return fmt.Errorf("hashstruct: field %s: %w", field.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Fields returns the names of the hash fields of a struct, or of a pointer to a struct, in the order of the struct
+// fields.
+func Fields(value any) ([]string, error) {
+	reflected := reflect.TypeOf(value)
+	for reflected != nil && reflected.Kind() == reflect.Pointer {
+		reflected = reflected.Elem()
+	}
+	if reflected == nil || reflected.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("hashstruct: expected a struct or a pointer to a struct, got %T", value)
+	}
+	fields := fieldsOf(reflected)
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.name
+	}
+	return names, nil
+}
+
+// HSetStruct stores the fields of a struct in the hash stored at key, with HSET. The hash fields which are not part of
+// the struct, and the struct fields which are not stored, such as empty `omitempty` fields, are left unchanged.
+//
+// Return value:
+//
+//	The number of fields that were added to the hash.
+func HSetStruct(ctx context.Context, client interfaces.HashCommands, key string, value any) (int64, error) {
+	values, err := Marshal(value)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+	return client.HSet(ctx, key, values)
+}
+
+// HGetAllInto reads the hash stored at key with HGETALL, and sets the fields of the struct pointed to by dest.
+//
+// Return value:
+//
+//	false if the key does not exist, in which case dest is left unchanged.
+func HGetAllInto(ctx context.Context, client interfaces.HashCommands, key string, dest any) (bool, error) {
+	if _, err := settableStruct(dest); err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	values, err := client.HGetAll(ctx, key)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return len(values) > 0, Unmarshal(values, dest)
+}
+
+// HMGetInto reads the given fields of the hash stored at key with HMGET, and sets the matching fields of the struct
+// pointed to by dest. Fields are named by their hash field name. When no fields are given, all the fields of the struct
+// are read. The struct fields which are missing from the hash are left unchanged.
+func HMGetInto(ctx context.Context, client interfaces.HashCommands, key string, dest any, fields ...string) error {
+	fields, err := requestedFields(dest, fields)
+	if err != nil {
+		return err
+	}
+	results, err := client.HMGet(ctx, key, fields)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string, len(fields))
+	for i, result := range results {
+		if !result.IsNil() {
+			values[fields[i]] = result.Value()
+		}
+	}
+	return Unmarshal(values, dest)
+}
+
+// requestedFields validates the requested fields against the fields of dest, or returns all of them if none are given.
+func requestedFields(dest any, requested []string) ([]string, error) {
+	if _, err := settableStruct(dest); err != nil {
+		return nil, err
+	}
+	fields, err := Fields(dest)
+	if err != nil || len(requested) == 0 {
+		return fields, err
+	}
+	known := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		known[field] = struct{}{}
+	}
+	for _, field := range requested {
+		if _, found := known[field]; !found {
+			return nil, fmt.Errorf("hashstruct: %T has no field %s", dest, field)
+		}
+	}
+	return requested, nil
+}
+
+// BatchHSetStruct adds an HSET of the fields of a struct to a batch. The result of the command is the number of fields
+// that were added to the hash.
+func BatchHSetStruct[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	key string,
+	value any,
+) (*T, error) {
+	values, err := Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return batch.HSet(key, values), nil
+}
+
+// BatchHMGetFields adds an HMGET of the given fields of a struct to a batch, like [HMGetInto]. The result of the command
+// is decoded with [UnmarshalHMGet] and the returned fields.
+func BatchHMGetFields[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	key string,
+	dest any,
+	fields ...string,
+) (*T, []string, error) {
+	fields, err := requestedFields(dest, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return batch.HMGet(key, fields), fields, nil
+}
+
+// UnmarshalHGetAll sets the fields of the struct pointed to by dest from the result of an HGETALL command in a batch.
+func UnmarshalHGetAll(result any, dest any) error {
+	switch typed := result.(type) {
+	case map[string]string:
+		return Unmarshal(typed, dest)
+	case map[string]any:
+		values := make(map[string]string, len(typed))
+		for name, value := range typed {
+			if text, ok := value.(string); ok {
+				values[name] = text
+			}
+		}
+		return Unmarshal(values, dest)
+	case error:
+		return typed
+	}
+	return fmt.Errorf("hashstruct: unexpected HGETALL result %T", result)
+}
+
+// UnmarshalHMGet sets the fields of the struct pointed to by dest from the result of an HMGET command in a batch, for
+// the fields returned by [BatchHMGetFields].
+func UnmarshalHMGet(result any, fields []string, dest any) error {
+	if err, ok := result.(error); ok {
+		return err
+	}
+	results, ok := result.([]any)
+	if !ok || len(results) != len(fields) {
+		return fmt.Errorf("hashstruct: unexpected HMGET result %v", result)
+	}
+	values := make(map[string]string, len(fields))
+	for i, value := range results {
+		if text, ok := value.(string); ok {
+			values[fields[i]] = text
+		}
+	}
+	return Unmarshal(values, dest)
+}
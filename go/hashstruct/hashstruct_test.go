@@ -0,0 +1,168 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package hashstruct
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type audit struct {
+	CreatedAt time.Time `glide:"created_at"`
+}
+
+type user struct {
+	audit
+	Name     string            `glide:"name"`
+	Age      int               `glide:"age,omitempty"`
+	Score    float64           `glide:"score"`
+	Admin    bool              `glide:"admin"`
+	Address  address           `glide:"address"`
+	Labels   map[string]string `glide:"labels,omitempty"`
+	Nickname *string           `glide:"nickname"`
+	Password string            `glide:"-"`
+	Untagged uint8
+	internal string
+}
+
+func TestMarshal(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	value := user{
+		audit:    audit{CreatedAt: createdAt},
+		Name:     "alice",
+		Score:    1.5,
+		Admin:    true,
+		Address:  address{City: "Paris", Zip: "75001"},
+		Password: "secret",
+		Untagged: 7,
+		internal: "internal",
+	}
+
+	values, err := Marshal(&value)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string]string{
+			"created_at": "2024-05-01T12:30:00Z",
+			"name":       "alice",
+			"score":      "1.5",
+			"admin":      "true",
+			"address":    `{"city":"Paris","zip":"75001"}`,
+			"Untagged":   "7",
+		},
+		values,
+	)
+
+	var decoded user
+	require.NoError(t, Unmarshal(values, &decoded))
+	value.Password = ""
+	value.internal = ""
+	assert.Equal(t, value, decoded)
+}
+
+func TestMarshalPointersAndOmitEmpty(t *testing.T) {
+	nickname := "ally"
+	values, err := Marshal(user{Age: 30, Nickname: &nickname, Labels: map[string]string{"team": "core"}})
+	require.NoError(t, err)
+	assert.Equal(t, "30", values["age"])
+	assert.Equal(t, "ally", values["nickname"])
+	assert.Equal(t, `{"team":"core"}`, values["labels"])
+
+	var decoded user
+	require.NoError(t, Unmarshal(values, &decoded))
+	require.NotNil(t, decoded.Nickname)
+	assert.Equal(t, "ally", *decoded.Nickname)
+	assert.Equal(t, map[string]string{"team": "core"}, decoded.Labels)
+}
+
+func TestInvalidArguments(t *testing.T) {
+	_, err := Marshal("value")
+	assert.Error(t, err)
+
+	var decoded user
+	assert.Error(t, Unmarshal(map[string]string{}, decoded))
+	assert.Error(t, Unmarshal(map[string]string{"age": "old"}, &decoded))
+
+	_, err = Fields(42)
+	assert.Error(t, err)
+}
+
+func TestFields(t *testing.T) {
+	fields, err := Fields(&user{})
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{"created_at", "name", "age", "score", "admin", "address", "labels", "nickname", "Untagged"},
+		fields,
+	)
+}
+
+func TestHSetStructAndHGetAllInto(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+
+	added, err := HSetStruct(ctx, client, "user:1", user{Name: "alice", Age: 30, Score: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), added)
+
+	var decoded user
+	found, err := HGetAllInto(ctx, client, "user:1", &decoded)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, user{Name: "alice", Age: 30, Score: 2}, decoded)
+
+	found, err = HGetAllInto(ctx, client, "missing", &decoded)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestHMGetInto(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+	_, err := HSetStruct(ctx, client, "user:1", user{Name: "alice", Age: 30, Score: 2})
+	require.NoError(t, err)
+
+	var decoded user
+	require.NoError(t, HMGetInto(ctx, client, "user:1", &decoded, "name", "age"))
+	assert.Equal(t, user{Name: "alice", Age: 30}, decoded)
+
+	assert.Error(t, HMGetInto(ctx, client, "user:1", &decoded, "unknown"))
+
+	decoded = user{}
+	require.NoError(t, HMGetInto(ctx, client, "user:1", &decoded))
+	assert.Equal(t, user{Name: "alice", Age: 30, Score: 2}, decoded)
+}
+
+func TestBatch(t *testing.T) {
+	batch := pipeline.NewStandaloneBatch(false)
+
+	_, err := BatchHSetStruct(&batch.BaseBatch, "user:1", user{Name: "alice"})
+	require.NoError(t, err)
+	_, fields, err := BatchHMGetFields(&batch.BaseBatch, "user:1", &user{}, "name", "score")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "score"}, fields)
+	require.Len(t, batch.Commands, 2)
+	assert.Equal(t, []string{"user:1", "name", "score"}, batch.Commands[1].Args)
+
+	var decoded user
+	require.NoError(t, UnmarshalHMGet([]any{"alice", nil}, fields, &decoded))
+	assert.Equal(t, user{Name: "alice"}, decoded)
+
+	decoded = user{}
+	require.NoError(t, UnmarshalHGetAll(map[string]any{"name": "bob", "age": "40"}, &decoded))
+	assert.Equal(t, user{Name: "bob", Age: 40}, decoded)
+
+	assert.Error(t, UnmarshalHMGet([]any{"alice"}, fields, &decoded))
+	assert.Error(t, UnmarshalHGetAll([]any{}, &decoded))
+}
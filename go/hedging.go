@@ -0,0 +1,203 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+)
+
+// hedgingWindow is the number of recent reads whose latencies determine a percentile hedging delay, which is recomputed
+// every hedgingSamples reads.
+const (
+	hedgingWindow  = 1000
+	hedgingSamples = 100
+)
+
+// hedgedReads sends the hedged reads of a client, and tracks their latencies and statistics. It is shared by the client
+// and its views.
+type hedgedReads struct {
+	config *config.HedgingConfiguration
+	// readFrom is the read strategy of the client, which determines the node of the duplicate reads.
+	readFrom config.ReadFrom
+
+	mu sync.Mutex
+	// latencies is a ring buffer of the latencies of the recent reads.
+	latencies []time.Duration
+	next      int
+	// observed is the number of reads observed since the delay was last computed.
+	observed int
+	delay    time.Duration
+
+	reads       atomic.Int64
+	hedgedReads atomic.Int64
+	hedgeWins   atomic.Int64
+}
+
+func newHedgedReads(hedging *config.HedgingConfiguration, readFrom config.ReadFrom) *hedgedReads {
+	if hedging == nil {
+		return nil
+	}
+	return &hedgedReads{config: hedging, readFrom: readFrom, delay: hedging.GetDelay()}
+}
+
+// hedgeDelay returns the current hedging delay.
+func (hedging *hedgedReads) hedgeDelay() time.Duration {
+	hedging.mu.Lock()
+	defer hedging.mu.Unlock()
+	return hedging.delay
+}
+
+// observe records the latency of a read, and recomputes a percentile hedging delay once enough reads were observed.
+func (hedging *hedgedReads) observe(latency time.Duration) {
+	percentile := hedging.config.GetPercentile()
+	if percentile == 0 {
+		return
+	}
+	hedging.mu.Lock()
+	defer hedging.mu.Unlock()
+	if len(hedging.latencies) < hedgingWindow {
+		hedging.latencies = append(hedging.latencies, latency)
+	} else {
+		hedging.latencies[hedging.next] = latency
+		hedging.next = (hedging.next + 1) % hedgingWindow
+	}
+	hedging.observed++
+	if hedging.observed < hedgingSamples {
+		return
+	}
+	hedging.observed = 0
+	sorted := slices.Clone(hedging.latencies)
+	slices.Sort(sorted)
+	index := int(math.Ceil(percentile/100*float64(len(sorted)))) - 1
+	hedging.delay = sorted[max(index, 0)]
+}
+
+// duplicateReadFrom returns the read strategy of the duplicate of a read sent with the given context, which sends it to
+// another node than the original read.
+func (hedging *hedgedReads) duplicateReadFrom(ctx context.Context) config.ReadFrom {
+	readFrom := hedging.readFrom
+	if options := getRequestOptions(ctx); options.readFrom != nil {
+		readFrom = *options.readFrom
+	}
+	if readFrom == config.Primary {
+		return config.PreferReplica
+	}
+	return config.Primary
+}
+
+// readFromProtobuf returns the read strategy of a connection request, which only distinguishes whether reads are sent to
+// the primary.
+func readFromProtobuf(readFrom protobuf.ReadFrom) config.ReadFrom {
+	if readFrom == protobuf.ReadFrom_Primary {
+		return config.Primary
+	}
+	return config.PreferReplica
+}
+
+// HedgingStatistics returns the statistics of the hedged reads of the client, which are shared with its views. All the
+// fields are zero if the client is not configured with hedged reads.
+//
+// Return value:
+//
+// The statistics of the hedged reads of the client.
+func (client *baseClient) HedgingStatistics() models.HedgingStatistics {
+	if client.hedging == nil {
+		return models.HedgingStatistics{}
+	}
+	return models.HedgingStatistics{
+		Reads:       client.hedging.reads.Load(),
+		HedgedReads: client.hedging.hedgedReads.Load(),
+		HedgeWins:   client.hedging.hedgeWins.Load(),
+		Delay:       client.hedging.hedgeDelay(),
+	}
+}
+
+// hedges reports whether a command sent by the client is hedged.
+func (client *baseClient) hedges(requestType C.RequestType, args []string, route config.Route) bool {
+	if client.hedging == nil || client.dedicated || client.requestTimeout > 0 {
+		return false
+	}
+	request := config.CommandRequest{
+		Name:   commandName(requestType, args),
+		Args:   args,
+		Route:  route,
+		Custom: requestType == C.CustomCommand,
+	}
+	return client.hedging.config.Hedges(&request)
+}
+
+// hedgedResponse is the outcome of one of the reads of a hedged read.
+type hedgedResponse struct {
+	response  *C.struct_CommandResponse
+	err       error
+	duplicate bool
+}
+
+// submitHedged sends a read, and a duplicate of it to another node if it did not complete within the hedging delay. The
+// first successful response is returned, and the other read is canceled.
+func (client *baseClient) submitHedged(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+) (*C.struct_CommandResponse, error) {
+	hedging := client.hedging
+	hedging.reads.Add(1)
+	start := time.Now()
+	responses := make(chan hedgedResponse, 2)
+	submit := func(ctx context.Context, duplicate bool) {
+		response, err := client.submitCommand(ctx, requestType, args, nil)
+		responses <- hedgedResponse{response: response, err: err, duplicate: duplicate}
+	}
+
+	readCtx, cancelRead := context.WithCancel(ctx)
+	defer cancelRead()
+	go submit(readCtx, false)
+	timer := time.NewTimer(hedging.hedgeDelay())
+	defer timer.Stop()
+	select {
+	case result := <-responses:
+		if result.err == nil {
+			hedging.observe(time.Since(start))
+		}
+		return result.response, result.err
+	case <-timer.C:
+	}
+
+	hedging.hedgedReads.Add(1)
+	duplicateCtx, cancelDuplicate := context.WithCancel(WithRequestOptions(ctx, WithReadFrom(hedging.duplicateReadFrom(ctx))))
+	defer cancelDuplicate()
+	go submit(duplicateCtx, true)
+	result := <-responses
+	if result.err != nil {
+		// the other read may still succeed
+		result = <-responses
+	} else {
+		cancelRead()
+		cancelDuplicate()
+		go func() {
+			// free the response of the other read if it completed before being canceled
+			if loser := <-responses; loser.response != nil {
+				C.free_command_response(loser.response)
+			}
+		}()
+	}
+	if result.err == nil {
+		hedging.observe(time.Since(start))
+		if result.duplicate {
+			hedging.hedgeWins.Add(1)
+		}
+	}
+	return result.response, result.err
+}
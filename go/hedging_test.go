@@ -0,0 +1,62 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHedgedReads_PercentileDelay(t *testing.T) {
+	hedging := newHedgedReads(config.NewHedgingConfiguration().WithDelay(time.Second).WithPercentile(90), config.Primary)
+	// the fixed delay applies until enough reads were observed
+	for i := 1; i < hedgingSamples; i++ {
+		hedging.observe(time.Duration(i) * time.Millisecond)
+	}
+	assert.Equal(t, time.Second, hedging.hedgeDelay())
+	hedging.observe(hedgingSamples * time.Millisecond)
+	assert.Equal(t, 90*time.Millisecond, hedging.hedgeDelay())
+
+	// only the recent reads are kept
+	for i := 0; i < hedgingWindow; i++ {
+		hedging.observe(time.Millisecond)
+	}
+	assert.Len(t, hedging.latencies, hedgingWindow)
+	assert.Equal(t, time.Millisecond, hedging.hedgeDelay())
+
+	// a fixed delay is not updated
+	fixed := newHedgedReads(config.NewHedgingConfiguration(), config.Primary)
+	for i := 0; i < hedgingSamples; i++ {
+		fixed.observe(time.Second)
+	}
+	assert.Equal(t, 10*time.Millisecond, fixed.hedgeDelay())
+}
+
+func TestHedgedReads_DuplicateReadFrom(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, config.PreferReplica, newHedgedReads(config.NewHedgingConfiguration(), config.Primary).duplicateReadFrom(ctx))
+	hedging := newHedgedReads(config.NewHedgingConfiguration(), config.PreferReplica)
+	assert.Equal(t, config.Primary, hedging.duplicateReadFrom(ctx))
+	// the read strategy of the context overrides the read strategy of the client
+	primaryCtx := WithRequestOptions(ctx, WithReadFrom(config.Primary))
+	assert.Equal(t, config.PreferReplica, hedging.duplicateReadFrom(primaryCtx))
+}
+
+func TestHedgingStatistics(t *testing.T) {
+	client := &Client{&baseClient{clientConnection: &clientConnection{}}}
+	assert.Equal(t, models.HedgingStatistics{}, client.HedgingStatistics())
+	assert.Zero(t, client.HedgingStatistics().HedgeRate())
+
+	client.hedging = newHedgedReads(config.NewHedgingConfiguration(), config.PreferReplica)
+	client.hedging.reads.Add(4)
+	client.hedging.hedgedReads.Add(1)
+	view := client.WithNamespace("tenant")
+	statistics := view.HedgingStatistics()
+	assert.Equal(t, models.HedgingStatistics{Reads: 4, HedgedReads: 1, Delay: 10 * time.Millisecond}, statistics)
+	assert.Equal(t, 0.25, statistics.HedgeRate())
+}
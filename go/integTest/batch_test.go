@@ -14,8 +14,8 @@ import (
 	glide "github.com/itayporezky/valkey-glide/go/v2"
 	"github.com/itayporezky/valkey-glide/go/v4/config"
 	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/models"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
 	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
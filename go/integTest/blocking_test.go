@@ -0,0 +1,57 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestBlockingConnections_Automatic() {
+	config := suite.defaultClientConfig().WithRequestTimeout(500 * time.Millisecond).WithBlockingConnections(1)
+	client, err := suite.client(config)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	popped := make(chan []string)
+	go func() {
+		// the block timeout exceeds the request timeout, which is extended by it
+		result, err := client.BLPop(ctx, []string{key}, 2)
+		assert.NoError(suite.T(), err)
+		popped <- result
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	// the shared connection is not blocked by BLPOP
+	_, err = client.Get(ctx, uuid.NewString())
+	require.NoError(suite.T(), err)
+	time.Sleep(600 * time.Millisecond)
+	_, err = client.LPush(ctx, key, []string{"value"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{key, "value"}, <-popped)
+}
+
+func (suite *GlideTestSuite) TestBlockingConnections_Blocking() {
+	client := suite.defaultClusterClient()
+	blocking := client.Blocking()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	popped := make(chan []string)
+	go func() {
+		result, err := blocking.BLPop(ctx, []string{key}, 0)
+		assert.NoError(suite.T(), err)
+		popped <- result
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	suite.verifyOK(blocking.Set(ctx, uuid.NewString(), "value"))
+	_, err := client.LPush(ctx, key, []string{"value"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{key, "value"}, <-popped)
+}
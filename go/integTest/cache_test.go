@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/cache"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cachedUser struct {
+	Name string
+	Age  int
+}
+
+func (suite *GlideTestSuite) TestCache_GetOrLoad() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := uuid.NewString()
+		users := cache.New[cachedUser](client).WithLease(time.Second)
+		calls := 0
+		loader := func(ctx context.Context) (cachedUser, error) {
+			calls++
+			return cachedUser{Name: "alice", Age: 30}, nil
+		}
+
+		for i := 0; i < 3; i++ {
+			value, err := users.GetOrLoad(ctx, key, time.Minute, loader)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), cachedUser{Name: "alice", Age: 30}, value)
+		}
+		assert.Equal(suite.T(), 1, calls)
+
+		ttl, err := client.TTL(ctx, key)
+		require.NoError(suite.T(), err)
+		assert.Greater(suite.T(), ttl, int64(0))
+
+		// the lease is released once the value is cached
+		exists, err := client.Exists(ctx, []string{"lease:{" + key + "}"})
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(0), exists)
+	})
+}
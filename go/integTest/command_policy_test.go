@@ -0,0 +1,60 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestCommandPolicy_ReadOnly() {
+	policy := config.NewCommandPolicy().WithReadOnly(true)
+	client, err := suite.client(suite.defaultClientConfig().WithCommandPolicy(policy))
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(suite.defaultClient().Set(ctx, key, "value"))
+
+	result, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+
+	var rejected *config.CommandRejectedError
+	_, err = client.Set(ctx, key, "other")
+	require.ErrorAs(suite.T(), err, &rejected)
+	assert.Equal(suite.T(), "SET", rejected.Command)
+
+	_, err = client.CustomCommand(ctx, []string{"FLUSHALL"})
+	require.ErrorAs(suite.T(), err, &rejected)
+
+	batch := pipeline.NewStandaloneBatch(false).Get(key).Del([]string{key})
+	_, err = client.Exec(ctx, *batch, false)
+	require.ErrorAs(suite.T(), err, &rejected)
+	assert.Equal(suite.T(), "DEL", rejected.Command)
+
+	// rejected commands are not sent to the server
+	result, err = client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestCommandPolicy_DenyList() {
+	policy := config.NewCommandPolicy().WithDeniedCommands("FLUSHDB").WithDeniedCategories("@admin")
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithCommandPolicy(policy))
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+
+	var rejected *config.CommandRejectedError
+	_, err = client.FlushDB(ctx)
+	require.ErrorAs(suite.T(), err, &rejected)
+	_, err = client.ConfigSet(ctx, map[string]string{"timeout": "0"})
+	require.ErrorAs(suite.T(), err, &rejected)
+	assert.Equal(suite.T(), "CONFIG SET", rejected.Command)
+
+	suite.verifyOK(client.Set(ctx, uuid.NewString(), "value"))
+}
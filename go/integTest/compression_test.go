@@ -0,0 +1,58 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestCompression_StringsAndHashes() {
+	compression := config.NewCompressionConfiguration().WithThreshold(100)
+	client, err := suite.client(suite.defaultClientConfig().WithCompression(compression))
+	require.NoError(suite.T(), err)
+	plain := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	hashKey := uuid.NewString()
+	value := strings.Repeat(`{"name":"value"}`, 100)
+
+	suite.verifyOK(client.Set(ctx, key, value))
+	stored, err := plain.Strlen(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Less(suite.T(), stored, int64(len(value)))
+
+	result, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), value, result.Value())
+
+	_, err = client.HSet(ctx, hashKey, map[string]string{"large": value, "small": "small"})
+	require.NoError(suite.T(), err)
+	field, err := client.HGet(ctx, hashKey, "large")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), value, field.Value())
+	all, err := client.HGetAll(ctx, hashKey)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{"large": value, "small": "small"}, all)
+
+	// values written without compression are read as they are
+	legacyKey := uuid.NewString()
+	suite.verifyOK(plain.Set(ctx, legacyKey, value))
+	values, err := client.MGet(ctx, []string{key, legacyKey, uuid.NewString()})
+	require.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		[]models.Result[string]{models.CreateStringResult(value), models.CreateStringResult(value), models.CreateNilStringResult()},
+		values,
+	)
+
+	statistics := compression.Statistics()
+	assert.Equal(suite.T(), int64(2), statistics.CompressedValues)
+	assert.Greater(suite.T(), statistics.SavedBytes(), int64(0))
+}
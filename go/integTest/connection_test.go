@@ -10,8 +10,8 @@ import (
 
 	glide "github.com/itayporezky/valkey-glide/go/v2"
 	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
 	"github.com/stretchr/testify/assert"
 )
 
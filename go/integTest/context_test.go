@@ -6,7 +6,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
 	"github.com/stretchr/testify/assert"
 )
@@ -0,0 +1,53 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestEncryption_StringsAndHashes() {
+	keyring := config.NewStaticKeyring("v1", map[string][]byte{"v1": bytes.Repeat([]byte{1}, 32)})
+	encryption := config.NewEncryptionConfiguration(keyring).WithHashFieldEncryption(true)
+	client, err := suite.client(suite.defaultClientConfig().WithEncryption(encryption))
+	require.NoError(suite.T(), err)
+	plain := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	hashKey := uuid.NewString()
+
+	suite.verifyOK(client.Set(ctx, key, "alice@example.com"))
+	stored, err := plain.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), stored.Value(), "alice")
+	result, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "alice@example.com", result.Value())
+
+	_, err = client.HSet(ctx, hashKey, map[string]string{"email": "alice@example.com", "name": "alice"})
+	require.NoError(suite.T(), err)
+	fields, err := plain.HKeys(ctx, hashKey)
+	require.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), fields, "email")
+	field, err := client.HGet(ctx, hashKey, "email")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "alice@example.com", field.Value())
+	all, err := client.HGetAll(ctx, hashKey)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{"email": "alice@example.com", "name": "alice"}, all)
+
+	// values encrypted with an unknown key fail with a typed error
+	other := config.NewStaticKeyring("v2", map[string][]byte{"v2": bytes.Repeat([]byte{2}, 32)})
+	otherClient, err := suite.client(suite.defaultClientConfig().WithEncryption(config.NewEncryptionConfiguration(other)))
+	require.NoError(suite.T(), err)
+	_, err = otherClient.Get(ctx, key)
+	var decryptionErr *config.DecryptionError
+	require.ErrorAs(suite.T(), err, &decryptionErr)
+	assert.Equal(suite.T(), "v1", decryptionErr.KeyId)
+}
@@ -0,0 +1,86 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/failover"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startCluster starts another cluster without replicas, which is stopped at the end of the test, and returns the address
+// of one of its nodes.
+func (suite *GlideTestSuite) startCluster() config.NodeAddress {
+	args := []string{"start", "--cluster-mode", "-r", "0"}
+	if suite.tls {
+		args = append([]string{"--tls"}, args...)
+	}
+	output := runClusterManager(suite, args, false)
+	for _, line := range strings.Split(output, "\n") {
+		if folder, found := strings.CutPrefix(line, "CLUSTER_FOLDER="); found {
+			suite.T().Cleanup(func() {
+				runClusterManager(suite, []string{"stop", "--cluster-folder", folder}, true)
+			})
+		}
+	}
+	return extractAddresses(suite, output)[0]
+}
+
+func (suite *GlideTestSuite) TestFailover_TwoClusters() {
+	ctx := context.Background()
+	address := suite.startCluster()
+	primary, err := suite.clusterClient(config.NewClusterClientConfiguration().
+		WithAddress(&address).
+		WithUseTLS(suite.tls).
+		WithRequestTimeout(5 * time.Second))
+	require.NoError(suite.T(), err)
+	secondary := suite.defaultClusterClient()
+	events := make(chan failover.Event, 10)
+	client := failover.NewClient(primary, secondary, failover.NewConfiguration().
+		WithHealthCheck(100*time.Millisecond, 200*time.Millisecond).
+		WithThresholds(2, 2).
+		WithFailback(true, time.Second).
+		WithListener(func(event failover.Event) { events <- event }))
+	defer client.Close()
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "primary"))
+	value, err := primary.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "primary", value.Value())
+
+	// the client fails over to the secondary cluster while the nodes of the primary cluster are paused
+	_, err = primary.CustomCommandWithRoute(ctx, []string{"CLIENT", "PAUSE", "3000", "ALL"}, config.AllNodes)
+	require.NoError(suite.T(), err)
+	select {
+	case event := <-events:
+		assert.Equal(suite.T(), failover.Primary, event.From)
+		assert.Equal(suite.T(), failover.Secondary, event.To)
+		assert.Equal(suite.T(), failover.Unhealthy, event.Reason)
+	case <-time.After(5 * time.Second):
+		suite.T().Fatal("the client did not fail over")
+	}
+	suite.verifyOK(client.Set(ctx, key, "secondary"))
+	value, err = secondary.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "secondary", value.Value())
+
+	// the client fails back once the primary cluster was healthy for the failback delay
+	select {
+	case event := <-events:
+		assert.Equal(suite.T(), failover.Secondary, event.From)
+		assert.Equal(suite.T(), failover.Primary, event.To)
+		assert.Equal(suite.T(), failover.Failback, event.Reason)
+	case <-time.After(10 * time.Second):
+		suite.T().Fatal("the client did not fail back")
+	}
+	value, err = client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "primary", value.Value())
+}
@@ -22,7 +22,7 @@ import (
 	glide "github.com/itayporezky/valkey-glide/go/v2"
 	"github.com/itayporezky/valkey-glide/go/v4/config"
 	"github.com/itayporezky/valkey-glide/go/v4/constants"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/models"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +62,16 @@ var (
 	pubsubtest       = flag.Bool("pubsub", false, "Set to true to run pubsub tests")
 	longTimeoutTests = flag.Bool("long-timeout-tests", false, "Set to true to run tests with longer timeouts")
 	otelTest         = flag.Bool("otel-test", false, "Set to true to run opentelemetry tests")
+	sentinelTest     = flag.Bool(
+		"sentinel-test",
+		false,
+		"Set to true to run sentinel tests, which start local valkey-server and valkey-sentinel processes",
+	)
+	unixSocketTest = flag.Bool(
+		"unix-socket-test",
+		false,
+		"Set to true to run Unix domain socket tests, which start a local valkey-server process",
+	)
 )
 
 func (suite *GlideTestSuite) SetupSuite() {
@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/hashstruct"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type hashUser struct {
+	Name      string            `glide:"name"`
+	Age       int               `glide:"age,omitempty"`
+	Active    bool              `glide:"active"`
+	CreatedAt time.Time         `glide:"created_at"`
+	Labels    map[string]string `glide:"labels"`
+}
+
+func (suite *GlideTestSuite) TestHashStruct_HSetStructAndHGetAllInto() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := uuid.NewString()
+		value := hashUser{
+			Name:      "alice",
+			Age:       30,
+			Active:    true,
+			CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+			Labels:    map[string]string{"team": "core"},
+		}
+
+		added, err := hashstruct.HSetStruct(ctx, client, key, value)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(5), added)
+
+		var decoded hashUser
+		found, err := hashstruct.HGetAllInto(ctx, client, key, &decoded)
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), found)
+		assert.Equal(suite.T(), value, decoded)
+
+		var partial hashUser
+		require.NoError(suite.T(), hashstruct.HMGetInto(ctx, client, key, &partial, "name", "active"))
+		assert.Equal(suite.T(), hashUser{Name: "alice", Active: true}, partial)
+	})
+}
+
+func (suite *GlideTestSuite) TestHashStruct_Batch() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	batch := pipeline.NewStandaloneBatch(true)
+
+	_, err := hashstruct.BatchHSetStruct(&batch.BaseBatch, key, hashUser{Name: "bob", Age: 40})
+	require.NoError(suite.T(), err)
+	batch.HGetAll(key)
+	_, fields, err := hashstruct.BatchHMGetFields(&batch.BaseBatch, key, &hashUser{}, "age")
+	require.NoError(suite.T(), err)
+
+	results, err := client.Exec(ctx, *batch, true)
+	require.NoError(suite.T(), err)
+
+	var decoded hashUser
+	require.NoError(suite.T(), hashstruct.UnmarshalHGetAll(results[1], &decoded))
+	assert.Equal(suite.T(), "bob", decoded.Name)
+	assert.Equal(suite.T(), 40, decoded.Age)
+
+	var partial hashUser
+	require.NoError(suite.T(), hashstruct.UnmarshalHMGet(results[2], fields, &partial))
+	assert.Equal(suite.T(), hashUser{Age: 40}, partial)
+}
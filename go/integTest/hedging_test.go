@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestHedgedReads_SlowPrimary() {
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().
+		WithHedgedReads(config.NewHedgingConfiguration().WithDelay(50 * time.Millisecond)))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+	ctx := context.Background()
+	key := uuid.NewString()
+	primary := config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err = client.CustomCommandWithRoute(ctx, []string{"WAIT", "1", "1000"}, primary)
+	require.NoError(suite.T(), err)
+
+	// reads which complete within the delay are not hedged
+	value, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+	assert.Equal(suite.T(), int64(1), client.HedgingStatistics().Reads)
+	assert.Zero(suite.T(), client.HedgingStatistics().HedgedReads)
+
+	// the read sent to the paused primary is hedged to a replica, which responds first
+	_, err = client.CustomCommandWithRoute(ctx, []string{"CLIENT", "PAUSE", "500", "ALL"}, primary)
+	require.NoError(suite.T(), err)
+	start := time.Now()
+	value, err = client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+	assert.Less(suite.T(), time.Since(start), 400*time.Millisecond)
+	statistics := client.HedgingStatistics()
+	assert.Equal(suite.T(), int64(2), statistics.Reads)
+	assert.Equal(suite.T(), int64(1), statistics.HedgedReads)
+	assert.Equal(suite.T(), int64(1), statistics.HedgeWins)
+
+	// writes are not hedged
+	time.Sleep(500 * time.Millisecond)
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	assert.Equal(suite.T(), int64(2), client.HedgingStatistics().Reads)
+}
@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestConnectionEvents_Reconnect() {
+	var mu sync.Mutex
+	var received []models.ConnectionEventType
+	listener := func(event models.ConnectionEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, event.Type)
+	}
+	client, err := suite.client(suite.defaultClientConfig().WithEventListener(listener))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+	ctx := context.Background()
+	assert.Equal(suite.T(), models.Connected, client.State().Status)
+
+	_, _ = client.CustomCommand(ctx, []string{"CLIENT", "KILL", "TYPE", "normal"})
+	require.Eventually(suite.T(), func() bool {
+		_, err := client.Ping(ctx)
+		mu.Lock()
+		defer mu.Unlock()
+		return err == nil && len(received) > 0 && received[len(received)-1] == models.Reconnected
+	}, 10*time.Second, 100*time.Millisecond)
+
+	mu.Lock()
+	assert.Equal(suite.T(), models.Disconnected, received[0])
+	mu.Unlock()
+	state := client.State()
+	assert.Equal(suite.T(), models.Connected, state.Status)
+	assert.Empty(suite.T(), state.DisconnectedNodes)
+
+	client.Close()
+	assert.Equal(suite.T(), models.Closed, client.State().Status)
+}
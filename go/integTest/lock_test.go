@@ -0,0 +1,116 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/lock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestLock_AcquireAndRelease() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		name := uuid.NewString()
+		locker := lock.NewLocker(client).WithAutoRenewal(false)
+
+		handle, err := locker.Acquire(ctx, name, 10*time.Second)
+		require.NoError(suite.T(), err)
+		_, err = locker.Acquire(ctx, name, 10*time.Second)
+		assert.ErrorIs(suite.T(), err, lock.ErrNotAcquired)
+
+		require.NoError(suite.T(), handle.Release(ctx))
+		assert.ErrorIs(suite.T(), handle.Release(ctx), lock.ErrNotHeld)
+
+		next, err := locker.Acquire(ctx, name, 10*time.Second)
+		require.NoError(suite.T(), err)
+		assert.Greater(suite.T(), next.FencingToken(), handle.FencingToken())
+		require.NoError(suite.T(), next.Release(ctx))
+	})
+}
+
+func (suite *GlideTestSuite) TestLock_ReleaseChecksOwner() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		name := uuid.NewString()
+		handle, err := lock.NewLocker(client).WithAutoRenewal(false).Acquire(ctx, name, 10*time.Second)
+		require.NoError(suite.T(), err)
+
+		// simulates the expiry of the lease and the acquisition of the lock by another owner
+		suite.verifyOK(client.Set(ctx, "lock:{"+name+"}", "other"))
+
+		assert.ErrorIs(suite.T(), handle.Extend(ctx, 10*time.Second), lock.ErrNotHeld)
+		assert.ErrorIs(suite.T(), handle.Release(ctx), lock.ErrNotHeld)
+		value, err := client.Get(ctx, "lock:{"+name+"}")
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "other", value.Value())
+	})
+}
+
+func (suite *GlideTestSuite) TestLock_Extend() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		name := uuid.NewString()
+		handle, err := lock.NewLocker(client).WithAutoRenewal(false).Acquire(ctx, name, time.Second)
+		require.NoError(suite.T(), err)
+
+		require.NoError(suite.T(), handle.Extend(ctx, 30*time.Second))
+		ttl, err := client.PTTL(ctx, "lock:{"+name+"}")
+		require.NoError(suite.T(), err)
+		assert.Greater(suite.T(), ttl, int64(1000))
+		require.NoError(suite.T(), handle.Release(ctx))
+	})
+}
+
+func (suite *GlideTestSuite) TestLock_AutoRenewal() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		name := uuid.NewString()
+		handle, err := lock.NewLocker(client).Acquire(ctx, name, 300*time.Millisecond)
+		require.NoError(suite.T(), err)
+
+		time.Sleep(time.Second)
+		value, err := client.Get(ctx, "lock:{"+name+"}")
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), handle.Token(), value.Value())
+
+		// the renewal reports the loss of the lock
+		_, err = client.Del(ctx, []string{"lock:{" + name + "}"})
+		require.NoError(suite.T(), err)
+		select {
+		case <-handle.Lost():
+		case <-time.After(time.Second):
+			assert.Fail(suite.T(), "the lock was not reported as lost")
+		}
+		assert.ErrorIs(suite.T(), handle.Release(ctx), lock.ErrNotHeld)
+	})
+}
+
+func (suite *GlideTestSuite) TestLock_Quorum() {
+	clients := []interfaces.BaseClientCommands{}
+	for db := 0; db < 3; db++ {
+		client, err := suite.client(suite.defaultClientConfig().WithDatabaseId(db))
+		require.NoError(suite.T(), err)
+		clients = append(clients, client)
+	}
+	ctx := context.Background()
+	name := uuid.NewString()
+	locker := lock.NewQuorumLocker(clients...).WithAutoRenewal(false)
+
+	// the lock is held on one instance only, so a quorum can still be reached
+	suite.verifyOK(clients[0].Set(ctx, "lock:{"+name+"}", "other"))
+	handle, err := locker.Acquire(ctx, name, 10*time.Second)
+	require.NoError(suite.T(), err)
+	_, err = locker.Acquire(ctx, name, 10*time.Second)
+	assert.ErrorIs(suite.T(), err, lock.ErrNotAcquired)
+	require.NoError(suite.T(), handle.Release(ctx))
+
+	value, err := clients[0].Get(ctx, "lock:{"+name+"}")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "other", value.Value())
+}
@@ -0,0 +1,171 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMiddleware records the commands and batches it sees.
+type recordingMiddleware struct {
+	mu       sync.Mutex
+	commands []config.CommandRequest
+	results  []config.CommandResult
+	batches  []config.BatchRequest
+}
+
+func (recorder *recordingMiddleware) middleware() *config.Middleware {
+	return &config.Middleware{
+		AfterCommand: func(ctx context.Context, request *config.CommandRequest, result *config.CommandResult) {
+			recorder.mu.Lock()
+			defer recorder.mu.Unlock()
+			recorder.commands = append(recorder.commands, *request)
+			recorder.results = append(recorder.results, *result)
+		},
+		AfterBatch: func(ctx context.Context, request *config.BatchRequest, result *config.BatchResult) {
+			recorder.mu.Lock()
+			defer recorder.mu.Unlock()
+			recorder.batches = append(recorder.batches, *request)
+		},
+	}
+}
+
+// prefixMiddleware prepends a prefix to the first argument of every key-value command.
+func prefixMiddleware(prefix string) *config.Middleware {
+	return &config.Middleware{
+		BeforeCommand: func(ctx context.Context, request *config.CommandRequest) *config.CommandResult {
+			if request.Name == "SET" || request.Name == "GET" {
+				request.Args[0] = prefix + request.Args[0]
+			}
+			return nil
+		},
+	}
+}
+
+func (suite *GlideTestSuite) TestMiddleware_SeesCommandsAndResults() {
+	recorder := &recordingMiddleware{}
+	client, err := suite.client(suite.defaultClientConfig().WithMiddleware(recorder.middleware()))
+	require.NoError(suite.T(), err)
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	result, err := client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+	_, err = client.CustomCommand(context.Background(), []string{"incr", key})
+	assert.Error(suite.T(), err)
+
+	require.Len(suite.T(), recorder.commands, 3)
+	assert.Equal(suite.T(), config.CommandRequest{Name: "SET", Args: []string{key, "value"}}, recorder.commands[0])
+	assert.Equal(suite.T(), "OK", recorder.results[0].Value)
+	assert.Positive(suite.T(), recorder.results[0].Latency)
+	assert.Equal(suite.T(), "GET", recorder.commands[1].Name)
+	assert.Equal(suite.T(), "value", recorder.results[1].Value)
+	assert.Equal(suite.T(), "INCR", recorder.commands[2].Name)
+	assert.True(suite.T(), recorder.commands[2].Custom)
+	assert.IsType(suite.T(), &errors.RequestError{}, recorder.results[2].Err)
+}
+
+func (suite *GlideTestSuite) TestMiddleware_RewritesRequests() {
+	prefix := "{tenant}:"
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithMiddleware(prefixMiddleware(prefix)))
+	require.NoError(suite.T(), err)
+	plainClient := suite.defaultClusterClient()
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	result, err := plainClient.Get(context.Background(), prefix+key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+	result, err = client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestMiddleware_ShortCircuitsAndRewritesResults() {
+	injected := &errors.RequestError{Msg: "injected fault"}
+	order := []string{}
+	client, err := suite.client(suite.defaultClientConfig().
+		WithMiddleware(&config.Middleware{
+			BeforeCommand: func(ctx context.Context, request *config.CommandRequest) *config.CommandResult {
+				order = append(order, "before first")
+				return nil
+			},
+			AfterCommand: func(ctx context.Context, request *config.CommandRequest, result *config.CommandResult) {
+				order = append(order, "after first")
+				if value, ok := result.Value.(string); ok {
+					result.Value = strings.ToUpper(value)
+				}
+			},
+		}).
+		WithMiddleware(&config.Middleware{
+			BeforeCommand: func(ctx context.Context, request *config.CommandRequest) *config.CommandResult {
+				order = append(order, "before second")
+				switch request.Args[0] {
+				case "fault":
+					return &config.CommandResult{Err: injected}
+				case "cached":
+					return &config.CommandResult{Value: "cached value"}
+				}
+				return nil
+			},
+			AfterCommand: func(ctx context.Context, request *config.CommandRequest, result *config.CommandResult) {
+				order = append(order, "after second")
+			},
+		}))
+	require.NoError(suite.T(), err)
+
+	_, err = client.Get(context.Background(), "fault")
+	assert.Equal(suite.T(), injected, err)
+	assert.Equal(suite.T(), []string{"before first", "before second", "after second", "after first"}, order)
+
+	result, err := client.Get(context.Background(), "cached")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "CACHED VALUE", result.Value())
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	result, err = client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "VALUE", result.Value())
+}
+
+func (suite *GlideTestSuite) TestMiddleware_Batch() {
+	recorder := &recordingMiddleware{}
+	client, err := suite.client(suite.defaultClientConfig().
+		WithMiddleware(recorder.middleware()).
+		WithMiddleware(&config.Middleware{
+			BeforeBatch: func(ctx context.Context, request *config.BatchRequest) *config.BatchResult {
+				if !request.IsAtomic {
+					return &config.BatchResult{Values: []any{"short-circuited"}}
+				}
+				return nil
+			},
+		}))
+	require.NoError(suite.T(), err)
+	key := uuid.NewString()
+
+	batch := pipeline.NewStandaloneBatch(true).Set(key, "value").Get(key)
+	result, err := client.Exec(context.Background(), *batch, true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"OK", "value"}, result)
+
+	result, err = client.Exec(context.Background(), *pipeline.NewStandaloneBatch(false).Get(key), true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"short-circuited"}, result)
+
+	require.Len(suite.T(), recorder.batches, 2)
+	assert.True(suite.T(), recorder.batches[0].IsAtomic)
+	assert.Equal(suite.T(), "SET", recorder.batches[0].Commands[0].Name)
+	assert.Equal(suite.T(), []string{key}, recorder.batches[0].Commands[1].Args)
+	assert.Empty(suite.T(), recorder.commands)
+}
@@ -0,0 +1,107 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestNamespace_Standalone() {
+	client := suite.defaultClient()
+	prefix := uuid.NewString() + ":"
+	view := client.WithNamespace(prefix)
+	ctx := context.Background()
+
+	suite.verifyOK(view.Set(ctx, "a", "1"))
+	suite.verifyOK(view.Set(ctx, "b", "2"))
+	stored, err := client.Get(ctx, prefix+"a")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "1", stored.Value())
+
+	values, err := view.MGet(ctx, []string{"a", "b", "c"})
+	require.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		[]models.Result[string]{models.CreateStringResult("1"), models.CreateStringResult("2"), models.CreateNilStringResult()},
+		values,
+	)
+
+	suite.verifyOK(view.Rename(ctx, "b", "c"))
+	exists, err := client.Exists(ctx, []string{prefix + "c"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), exists)
+
+	_, err = view.SAdd(ctx, "s1", []string{"x"})
+	require.NoError(suite.T(), err)
+	_, err = view.SAdd(ctx, "s2", []string{"y"})
+	require.NoError(suite.T(), err)
+	count, err := view.SUnionStore(ctx, "s3", []string{"s1", "s2"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), count)
+
+	_, err = view.RPush(ctx, "list", []string{"value"})
+	require.NoError(suite.T(), err)
+	popped, err := view.BLPop(ctx, []string{"list"}, 1)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"list", "value"}, popped)
+
+	var keys []string
+	cursor := int64(0)
+	for {
+		next, found, err := view.Scan(ctx, cursor)
+		require.NoError(suite.T(), err)
+		keys = append(keys, found...)
+		if cursor, err = strconv.ParseInt(next, 10, 64); err != nil || cursor == 0 {
+			break
+		}
+	}
+	assert.ElementsMatch(suite.T(), []string{"a", "c", "s1", "s2", "s3"}, keys)
+
+	// the middlewares and the namespace of a nested view
+	nested := view.WithNamespace("nested:")
+	suite.verifyOK(nested.Set(ctx, "key", "value"))
+	stored, err = client.Get(ctx, prefix+"nested:key")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", stored.Value())
+}
+
+func (suite *GlideTestSuite) TestNamespace_Cluster() {
+	client := suite.defaultClusterClient()
+	// the hash tag keeps the keys of the namespace in a single slot
+	prefix := "{" + uuid.NewString() + "}:"
+	view := client.WithNamespace(prefix)
+	ctx := context.Background()
+
+	suite.verifyOK(view.MSet(ctx, map[string]string{"a": "1", "b": "2"}))
+	values, err := view.MGet(ctx, []string{"a", "b"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []models.Result[string]{models.CreateStringResult("1"), models.CreateStringResult("2")}, values)
+
+	_, err = view.ZAdd(ctx, "z1", map[string]float64{"x": 1})
+	require.NoError(suite.T(), err)
+	_, err = view.ZAdd(ctx, "z2", map[string]float64{"x": 2})
+	require.NoError(suite.T(), err)
+	count, err := view.ZInterStore(ctx, "z3", options.KeyArray{Keys: []string{"z1", "z2"}})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), count)
+	score, err := client.ZScore(ctx, prefix+"z3", "x")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(3), score.Value())
+
+	var keys []string
+	cursor := options.NewClusterScanCursor()
+	for !cursor.HasFinished() {
+		next, found, err := view.Scan(ctx, *cursor)
+		require.NoError(suite.T(), err)
+		keys = append(keys, found...)
+		cursor = &next
+	}
+	assert.ElementsMatch(suite.T(), []string{"a", "b", "z1", "z2", "z3"}, keys)
+}
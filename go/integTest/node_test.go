@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	glide "github.com/itayporezky/valkey-glide/go/v2"
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestNode_ForEachPrimary() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := "{" + uuid.NewString() + "}"
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	owner, err := client.NodeForKey(ctx, key)
+	require.NoError(suite.T(), err)
+
+	var addresses []string
+	found := false
+	err = client.ForEachPrimary(ctx, func(address string, node *glide.Client) error {
+		addresses = append(addresses, address)
+		info, err := node.InfoWithOptions(ctx, options.InfoOptions{Sections: []constants.Section{constants.Server}})
+		if err != nil {
+			return err
+		}
+		port := address[strings.LastIndex(address, ":")+1:]
+		assert.Contains(suite.T(), info, "tcp_port:"+port)
+
+		cursor := int64(0)
+		for {
+			next, keys, err := node.ScanWithOptions(ctx, cursor, *options.NewScanOptions().SetMatch(key))
+			if err != nil {
+				return err
+			}
+			if len(keys) > 0 {
+				assert.Equal(suite.T(), owner, address)
+				found = true
+			}
+			if next == "0" {
+				break
+			}
+			cursor, _ = strconv.ParseInt(next, 10, 64)
+		}
+		return nil
+	})
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), found)
+
+	topology, err := client.Topology(ctx)
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), addresses, len(topology.Shards))
+}
+
+func (suite *GlideTestSuite) TestNode_Redirect() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	// the commands on the keys of other nodes are redirected to the node of the key
+	err := client.ForEachPrimary(ctx, func(address string, node *glide.Client) error {
+		value, err := node.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		assert.Equal(suite.T(), "value", value.Value())
+		return nil
+	})
+	require.NoError(suite.T(), err)
+}
@@ -14,7 +14,7 @@ import (
 	"time"
 
 	glide "github.com/itayporezky/valkey-glide/go/v2"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -0,0 +1,111 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verifyRateLimit checks that the limiter allows `limit` requests for a new key and rejects the next one.
+func (suite *GlideTestSuite) verifyRateLimit(limiter *ratelimit.Limiter, limit int64) {
+	ctx := context.Background()
+	key := uuid.NewString()
+	for i := int64(1); i <= limit; i++ {
+		result, err := limiter.Allow(ctx, key)
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), result.Allowed)
+		assert.Equal(suite.T(), limit-i, result.Remaining)
+		assert.Zero(suite.T(), result.RetryAfter)
+	}
+	result, err := limiter.Allow(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), result.Allowed)
+	assert.Zero(suite.T(), result.Remaining)
+	assert.Greater(suite.T(), result.RetryAfter, time.Duration(0))
+
+	// other keys are limited independently
+	result, err = limiter.Allow(ctx, uuid.NewString())
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), result.Allowed)
+}
+
+func (suite *GlideTestSuite) TestRateLimit_FixedWindow() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		limiter, err := ratelimit.NewFixedWindow(client, 3, time.Minute)
+		require.NoError(suite.T(), err)
+		suite.verifyRateLimit(limiter, 3)
+	})
+}
+
+func (suite *GlideTestSuite) TestRateLimit_SlidingLog() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		limiter, err := ratelimit.NewSlidingLog(client, 3, time.Minute)
+		require.NoError(suite.T(), err)
+		suite.verifyRateLimit(limiter, 3)
+	})
+}
+
+func (suite *GlideTestSuite) TestRateLimit_TokenBucket() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		limiter, err := ratelimit.NewTokenBucket(client, 1, time.Minute, 3)
+		require.NoError(suite.T(), err)
+		suite.verifyRateLimit(limiter, 3)
+	})
+}
+
+func (suite *GlideTestSuite) TestRateLimit_GCRA() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		limiter, err := ratelimit.NewGCRA(client, 1, time.Minute, 3)
+		require.NoError(suite.T(), err)
+		suite.verifyRateLimit(limiter, 3)
+	})
+}
+
+func (suite *GlideTestSuite) TestRateLimit_WindowExpires() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := uuid.NewString()
+		limiter, err := ratelimit.NewSlidingLog(client, 1, 200*time.Millisecond)
+		require.NoError(suite.T(), err)
+
+		result, err := limiter.Allow(ctx, key)
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), result.Allowed)
+		result, err = limiter.Allow(ctx, key)
+		require.NoError(suite.T(), err)
+		assert.False(suite.T(), result.Allowed)
+		assert.LessOrEqual(suite.T(), result.RetryAfter, 200*time.Millisecond)
+
+		time.Sleep(result.RetryAfter + 10*time.Millisecond)
+		result, err = limiter.Allow(ctx, key)
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), result.Allowed)
+	})
+}
+
+func (suite *GlideTestSuite) TestRateLimit_AllowN() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := uuid.NewString()
+		limiter, err := ratelimit.NewTokenBucket(client, 1, time.Minute, 5)
+		require.NoError(suite.T(), err)
+
+		result, err := limiter.AllowN(ctx, key, 4)
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), result.Allowed)
+		assert.Equal(suite.T(), int64(1), result.Remaining)
+
+		// the request is rejected in full
+		result, err = limiter.AllowN(ctx, key, 2)
+		require.NoError(suite.T(), err)
+		assert.False(suite.T(), result.Allowed)
+		assert.Equal(suite.T(), int64(1), result.Remaining)
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	glide "github.com/itayporezky/valkey-glide/go/v2"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestRequestOptions_Timeout() {
+	client, err := suite.client(suite.defaultClientConfig().WithRequestTimeout(200 * time.Millisecond))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	_, err = client.CustomCommand(context.Background(), []string{"DEBUG", "sleep", "0.5"})
+	suite.IsType(&errors.TimeoutError{}, err)
+	time.Sleep(time.Second)
+
+	// the request timeout of the context overrides the request timeout of the client
+	ctx := glide.WithRequestOptions(context.Background(), glide.WithRequestTimeout(2*time.Second))
+	result, err := client.CustomCommand(ctx, []string{"DEBUG", "sleep", "0.5"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "OK", result)
+}
+
+func (suite *GlideTestSuite) TestRequestOptions_Cancellation() {
+	client := suite.defaultClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.CustomCommand(ctx, []string{"DEBUG", "sleep", "1"})
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.Less(suite.T(), time.Since(start), 500*time.Millisecond)
+
+	// the client is still usable once the server replied to the canceled command
+	result, err := client.Ping(context.Background())
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "PONG", result)
+}
+
+func (suite *GlideTestSuite) TestRequestOptions_ReadFrom() {
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(config.Primary))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+	ctx := context.Background()
+	_, err = client.ConfigResetStatWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+	require.NoError(suite.T(), err)
+
+	// the read strategy of the context overrides the read strategy of the client
+	replicaCtx := glide.WithRequestOptions(ctx, glide.WithReadFrom(config.PreferReplica))
+	for i := 0; i < 3; i++ {
+		_, err = client.Get(replicaCtx, "foo")
+		require.NoError(suite.T(), err)
+	}
+
+	info, err := client.InfoWithOptions(ctx, options.ClusterInfoOptions{
+		InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Replication, constants.Commandstats}},
+		RouteOption: &options.RouteOption{Route: config.AllNodes},
+	})
+	require.NoError(suite.T(), err)
+	replicaCalls := 0
+	for _, value := range info.MultiValue() {
+		if strings.Contains(value, "cmdstat_get:calls=") {
+			assert.Contains(suite.T(), value, "role:slave")
+			replicaCalls++
+		}
+	}
+	assert.Positive(suite.T(), replicaCalls)
+}
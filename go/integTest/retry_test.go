@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sleepServer blocks the server of the default client for the given duration, in the background.
+func (suite *GlideTestSuite) sleepServer(seconds string) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		client, err := suite.client(suite.defaultClientConfig().WithRequestTimeout(5 * time.Second))
+		if assert.NoError(suite.T(), err) {
+			defer client.Close()
+			_, err = client.CustomCommand(context.Background(), []string{"DEBUG", "sleep", seconds})
+			assert.NoError(suite.T(), err)
+		}
+	}()
+	// wait for the server to block
+	time.Sleep(50 * time.Millisecond)
+	return done
+}
+
+func (suite *GlideTestSuite) TestRetryPolicy_RetriesTimeouts() {
+	client, err := suite.client(suite.defaultClientConfig().
+		WithRequestTimeout(200 * time.Millisecond).
+		WithRetryPolicy(config.NewRetryPolicy().WithBackoff(10*time.Millisecond, 10*time.Millisecond)))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	// the read times out while the server sleeps, and is retried
+	done := suite.sleepServer("0.3")
+	value, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+	<-done
+
+	// writes which are not idempotent are not retried
+	done = suite.sleepServer("0.3")
+	_, err = client.Incr(ctx, uuid.NewString())
+	assert.IsType(suite.T(), &errors.TimeoutError{}, err)
+	<-done
+}
+
+func (suite *GlideTestSuite) TestCircuitBreaker_FailsFast() {
+	client, err := suite.client(suite.defaultClientConfig().
+		WithRequestTimeout(100 * time.Millisecond).
+		WithCircuitBreaker(config.NewCircuitBreakerConfiguration().WithFailureThreshold(2).WithOpenDuration(time.Second)))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	done := suite.sleepServer("0.5")
+	for range 2 {
+		_, err = client.Get(ctx, key)
+		assert.IsType(suite.T(), &errors.TimeoutError{}, err)
+	}
+	// the commands fail fast once the circuit breaker opened
+	start := time.Now()
+	_, err = client.Get(ctx, key)
+	assert.IsType(suite.T(), &config.CircuitOpenError{}, err)
+	assert.Less(suite.T(), time.Since(start), 50*time.Millisecond)
+	<-done
+
+	// the node is probed once the open duration elapsed
+	time.Sleep(time.Second)
+	_, err = client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+}
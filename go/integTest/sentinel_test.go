@@ -0,0 +1,118 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	glide "github.com/itayporezky/valkey-glide/go/v2"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sentinelMasterName = "glide-primary"
+
+// freePort returns a local port which is not in use.
+func (suite *GlideTestSuite) freePort() int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(suite.T(), err)
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// startProcess starts a server process, which is killed at the end of the test.
+func (suite *GlideTestSuite) startProcess(name string, args ...string) {
+	cmd := exec.Command(name, args...)
+	require.NoError(suite.T(), cmd.Start())
+	suite.T().Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+}
+
+// waitForPort waits until a local server accepts connections.
+func (suite *GlideTestSuite) waitForPort(port int) {
+	require.Eventually(suite.T(), func() bool {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+// startSentinelDeployment starts a primary, a replica and a sentinel monitoring them, and returns the ports of the
+// primary, of the replica and of the sentinel.
+func (suite *GlideTestSuite) startSentinelDeployment() (int, int, int) {
+	dir := suite.T().TempDir()
+	primary, replica, sentinel := suite.freePort(), suite.freePort(), suite.freePort()
+
+	suite.startProcess("valkey-server", "--port", strconv.Itoa(primary), "--save", "", "--dir", dir)
+	suite.waitForPort(primary)
+	suite.startProcess(
+		"valkey-server", "--port", strconv.Itoa(replica), "--save", "", "--dir", dir,
+		"--replicaof", "127.0.0.1", strconv.Itoa(primary),
+	)
+	suite.waitForPort(replica)
+
+	sentinelConfig := filepath.Join(dir, "sentinel.conf")
+	content := fmt.Sprintf(
+		"port %d\nsentinel monitor %s 127.0.0.1 %d 1\nsentinel down-after-milliseconds %[2]s 1000\n"+
+			"sentinel failover-timeout %[2]s 5000\n",
+		sentinel, sentinelMasterName, primary,
+	)
+	require.NoError(suite.T(), os.WriteFile(sentinelConfig, []byte(content), 0o600))
+	suite.startProcess("valkey-sentinel", sentinelConfig)
+	suite.waitForPort(sentinel)
+	return primary, replica, sentinel
+}
+
+func (suite *GlideTestSuite) TestSentinel_Failover() {
+	if !*sentinelTest {
+		suite.T().Skip("Sentinel tests are disabled")
+	}
+	_, replica, sentinel := suite.startSentinelDeployment()
+	ctx := context.Background()
+
+	sentinelConfig := config.NewSentinelConfiguration(sentinelMasterName).
+		WithAddress(&config.NodeAddress{Host: "127.0.0.1", Port: sentinel})
+	clientConfig := config.NewClientConfiguration().WithSentinel(sentinelConfig).WithReadFrom(config.PreferReplica)
+	client, err := glide.NewClient(clientConfig)
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "before"))
+
+	sentinelClient, err := glide.NewClient(
+		config.NewClientConfiguration().WithAddress(&config.NodeAddress{Host: "127.0.0.1", Port: sentinel}),
+	)
+	require.NoError(suite.T(), err)
+	defer sentinelClient.Close()
+	_, err = sentinelClient.CustomCommand(ctx, []string{"SENTINEL", "FAILOVER", sentinelMasterName})
+	require.NoError(suite.T(), err)
+
+	// writes succeed once the client follows the failover to the previous replica
+	require.Eventually(suite.T(), func() bool {
+		result, err := client.CustomCommand(ctx, []string{"SET", key, "after"})
+		return err == nil && result == "OK"
+	}, 20*time.Second, 100*time.Millisecond)
+
+	promoted, err := glide.NewClient(
+		config.NewClientConfiguration().WithAddress(&config.NodeAddress{Host: "127.0.0.1", Port: replica}),
+	)
+	require.NoError(suite.T(), err)
+	defer promoted.Close()
+	value, err := promoted.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "after", value.Value())
+}
@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/shadow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestShadow_StandaloneToCluster() {
+	ctx := context.Background()
+	standalone := suite.defaultClient()
+	cluster := suite.defaultClusterClient()
+	var mu sync.Mutex
+	var divergences []shadow.Divergence
+	// the writes are mirrored before returning, since the test accesses the cluster directly
+	client := shadow.NewClient(standalone, cluster, shadow.NewConfiguration().
+		WithMode(shadow.Synchronous).
+		WithSampleRate(1).
+		WithDivergenceHandler(func(divergence shadow.Divergence) {
+			mu.Lock()
+			defer mu.Unlock()
+			divergences = append(divergences, divergence)
+		}))
+	defer client.Close()
+
+	// the writes are mirrored to the cluster, and the reads of the same values do not diverge
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err := client.HSet(ctx, "{"+key+"}:hash", map[string]string{"field": "value"})
+	require.NoError(suite.T(), err)
+	value, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+	fields, err := client.HGetAll(ctx, "{"+key+"}:hash")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{"field": "value"}, fields)
+	value, err = cluster.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	// the reads of values which differ are reported, and return the value of the standalone server
+	suite.verifyOK(cluster.Set(ctx, key, "diverged"))
+	value, err = client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(suite.T(), divergences, 1)
+	assert.Equal(suite.T(), "GET", divergences[0].Command)
+	assert.Equal(suite.T(), key, divergences[0].Key)
+	assert.Equal(suite.T(), "value", divergences[0].Authoritative.(models.Result[string]).Value())
+	assert.Equal(suite.T(), "diverged", divergences[0].Shadow.(models.Result[string]).Value())
+}
@@ -15,8 +15,8 @@ import (
 	"github.com/itayporezky/valkey-glide/go/v4/constants"
 
 	"github.com/google/uuid"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
-	"github.com/itayporezky/valkey-glide/go/v4/internal/interfaces"
 	"github.com/itayporezky/valkey-glide/go/v4/models"
 	"github.com/itayporezky/valkey-glide/go/v4/options"
 	"github.com/stretchr/testify/assert"
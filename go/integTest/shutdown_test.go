@@ -0,0 +1,56 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestCloseWithContext_DrainsRequests() {
+	client, err := suite.client(suite.defaultClientConfig())
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	done := make(chan error)
+	go func() {
+		_, err := client.BLPop(ctx, []string{key}, 0.5)
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	abandoned, err := client.CloseWithContext(closeCtx)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), abandoned)
+	assert.NoError(suite.T(), <-done)
+
+	_, err = client.Get(ctx, key)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestCloseWithContext_AbandonsRequests() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	done := make(chan error)
+	go func() {
+		_, err := client.BLPop(ctx, []string{key}, 0)
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	closeCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	abandoned, err := client.CloseWithContext(closeCtx)
+	assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+	assert.Equal(suite.T(), 1, abandoned)
+	assert.Error(suite.T(), <-done)
+}
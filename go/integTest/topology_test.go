@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	glide "github.com/itayporezky/valkey-glide/go/v2"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestTopology() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+
+	topology, err := client.Topology(ctx)
+	require.NoError(suite.T(), err)
+	require.NotEmpty(suite.T(), topology.Shards)
+	covered := int32(0)
+	for _, shard := range topology.Shards {
+		assert.NotEmpty(suite.T(), shard.Primary)
+		for _, slotRange := range shard.SlotRanges {
+			covered += slotRange.End - slotRange.Start + 1
+		}
+	}
+	assert.Equal(suite.T(), int32(16384), covered)
+
+	key := uuid.NewString()
+	response, err := client.CustomCommand(ctx, []string{"CLUSTER", "KEYSLOT", key})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(glide.KeySlot(key)), response.SingleValue())
+
+	// the node of a key is the node answering the commands routed by the key
+	node, err := client.NodeForKey(ctx, key)
+	require.NoError(suite.T(), err)
+	route := config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+	myself, err := client.CustomCommandWithRoute(ctx, []string{"CLUSTER", "MYID"}, route)
+	require.NoError(suite.T(), err)
+	byAddress, err := config.NewByAddressRouteWithHost(node)
+	require.NoError(suite.T(), err)
+	nodeID, err := client.CustomCommandWithRoute(ctx, []string{"CLUSTER", "MYID"}, byAddress)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), myself.SingleValue(), nodeID.SingleValue())
+}
@@ -0,0 +1,77 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	glide "github.com/itayporezky/valkey-glide/go/v2"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestTransaction_ConcurrentIncrements() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	options := *pipeline.NewTransactionOptions().WithMaxAttempts(100).WithBackoff(time.Millisecond, 20*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.TransactionWithOptions(ctx, []string{key}, func(tx *glide.StandaloneTransaction) error {
+				value, err := tx.Get(ctx, key)
+				if err != nil {
+					return err
+				}
+				count, _ := strconv.Atoi(value.Value())
+				tx.Batch.Set(key, strconv.Itoa(count+1))
+				return nil
+			}, options)
+			assert.NoError(suite.T(), err)
+		}()
+	}
+	wg.Wait()
+
+	value, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "10", value.Value())
+}
+
+func (suite *GlideTestSuite) TestTransaction_Aborted() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	options := *pipeline.NewTransactionOptions().WithMaxAttempts(2)
+
+	attempts := 0
+	_, err := client.TransactionWithOptions(ctx, []string{key}, func(tx *glide.ClusterTransaction) error {
+		attempts++
+		// the key is modified by another connection after it is watched
+		suite.verifyOK(client.Set(ctx, key, strconv.Itoa(attempts)))
+		tx.Batch.Set(key, "transaction")
+		return nil
+	}, options)
+	var aborted *pipeline.TransactionAbortedError
+	require.ErrorAs(suite.T(), err, &aborted)
+	assert.Equal(suite.T(), 2, aborted.Attempts)
+	assert.Equal(suite.T(), 2, attempts)
+
+	result, err := client.Transaction(ctx, []string{key}, func(tx *glide.ClusterTransaction) error {
+		tx.Batch.Set(key, "transaction")
+		return nil
+	})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"OK"}, result)
+
+	value, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "transaction", value.Value())
+}
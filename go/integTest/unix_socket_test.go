@@ -0,0 +1,50 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	glide "github.com/itayporezky/valkey-glide/go/v2"
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *GlideTestSuite) TestUnixSocket_Connection() {
+	if !*unixSocketTest {
+		suite.T().Skip("Unix domain socket tests are disabled")
+	}
+	dir := suite.T().TempDir()
+	socket := filepath.Join(dir, "valkey.sock")
+	suite.startProcess("valkey-server", "--port", "0", "--unixsocket", socket, "--save", "", "--dir", dir)
+	require.Eventually(suite.T(), func() bool {
+		conn, err := net.Dial("unix", socket)
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, 10*time.Second, 50*time.Millisecond)
+	ctx := context.Background()
+
+	client, err := glide.NewClient(config.NewClientConfiguration().WithAddress(config.NewUnixSocketAddress(socket)))
+	require.NoError(suite.T(), err)
+	defer client.Close()
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	value, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	// the client reconnects over the socket once its connection is killed
+	_, _ = client.CustomCommand(ctx, []string{"CLIENT", "KILL", "TYPE", "normal"})
+	require.Eventually(suite.T(), func() bool {
+		value, err := client.Get(ctx, key)
+		return err == nil && value.Value() == "value"
+	}, 10*time.Second, 100*time.Millisecond)
+}
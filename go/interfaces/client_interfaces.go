@@ -9,6 +9,10 @@ import (
 	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
 )
 
+// BaseClientCommands is the set of commands supported by both standalone and cluster clients.
+//
+// Application code may depend on it instead of a concrete client, so that the client could be replaced by a test double,
+// such as the in-memory fake provided by the glidetest package.
 type BaseClientCommands interface {
 	StringCommands
 	HashCommands
@@ -30,6 +34,7 @@ type BaseClientCommands interface {
 	Close()
 }
 
+// GlideClientCommands is the set of commands supported by the standalone `glide.Client`.
 type GlideClientCommands interface {
 	BaseClientCommands
 	GenericCommands
@@ -48,6 +53,7 @@ type GlideClientCommands interface {
 	) ([]any, error)
 }
 
+// GlideClusterClientCommands is the set of commands supported by the `glide.ClusterClient`.
 type GlideClusterClientCommands interface {
 	BaseClientCommands
 	GenericClusterCommands
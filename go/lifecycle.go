@@ -0,0 +1,190 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+)
+
+// lifecycleEventBuffer is the number of events which may wait for the listener, after which further events are dropped.
+const lifecycleEventBuffer = 256
+
+// lifecycleTracker keeps the state of the connections of a client from its connection lifecycle events, and passes the
+// events to the listener of the client.
+type lifecycleTracker struct {
+	mu sync.Mutex
+	// disconnected holds the addresses of the nodes whose connection is lost.
+	disconnected        map[string]bool
+	lastEvent           *models.ConnectionEvent
+	lastTopologyRefresh time.Time
+	// events queues the events for the listener, or is nil without a listener.
+	events chan models.ConnectionEvent
+	closed bool
+}
+
+func newLifecycleTracker(listener config.ConnectionEventListener) *lifecycleTracker {
+	tracker := &lifecycleTracker{disconnected: make(map[string]bool)}
+	if listener != nil {
+		tracker.events = make(chan models.ConnectionEvent, lifecycleEventBuffer)
+		go tracker.dispatch(listener)
+	}
+	return tracker
+}
+
+// dispatch passes the events to the listener in order, until the tracker is closed.
+func (tracker *lifecycleTracker) dispatch(listener config.ConnectionEventListener) {
+	for event := range tracker.events {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Println("panic in connection event listener", r)
+				}
+			}()
+			listener(event)
+		}()
+	}
+}
+
+// record updates the state of the connections with an event, and queues it for the listener.
+func (tracker *lifecycleTracker) record(event models.ConnectionEvent) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	if tracker.closed {
+		return
+	}
+	switch event.Type {
+	case models.Disconnected, models.ReconnectAttempt:
+		tracker.disconnected[event.Address] = true
+	case models.Reconnected:
+		delete(tracker.disconnected, event.Address)
+	case models.TopologyRefreshed:
+		// the connections to the nodes of the refreshed topology are recreated, so the nodes which are disconnected are
+		// the ones which could not be connected to, and the nodes which left the topology are forgotten
+		clear(tracker.disconnected)
+		for _, address := range event.Unreachable {
+			tracker.disconnected[address] = true
+		}
+		tracker.lastTopologyRefresh = event.Time
+	}
+	tracker.lastEvent = &event
+
+	if tracker.events != nil {
+		select {
+		case tracker.events <- event:
+		default:
+			log.Printf("Dropped the connection event %v of %s, since the listener is too slow\n", event.Type, event.Address)
+		}
+	}
+}
+
+// reset forgets the disconnected nodes, when the client is connected to other nodes.
+func (tracker *lifecycleTracker) reset() {
+	if tracker == nil {
+		return
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	clear(tracker.disconnected)
+}
+
+// close stops passing the events to the listener.
+func (tracker *lifecycleTracker) close() {
+	if tracker == nil {
+		return
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	if tracker.closed {
+		return
+	}
+	tracker.closed = true
+	if tracker.events != nil {
+		close(tracker.events)
+	}
+}
+
+// topologyRefreshed returns the time of the last refresh of the slot map of a cluster, or the zero time.
+func (tracker *lifecycleTracker) topologyRefreshed() time.Time {
+	if tracker == nil {
+		return time.Time{}
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	return tracker.lastTopologyRefresh
+}
+
+// state returns the state of the connections, which are assumed to be connected when they are not tracked.
+func (tracker *lifecycleTracker) state() models.ClientState {
+	if tracker == nil {
+		return models.ClientState{Status: models.Connected}
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	state := models.ClientState{Status: models.Connected, LastTopologyRefresh: tracker.lastTopologyRefresh}
+	for address := range tracker.disconnected {
+		state.DisconnectedNodes = append(state.DisconnectedNodes, address)
+	}
+	slices.Sort(state.DisconnectedNodes)
+	if len(state.DisconnectedNodes) > 0 {
+		state.Status = models.Degraded
+	}
+	if tracker.lastEvent != nil {
+		event := *tracker.lastEvent
+		state.LastEvent = &event
+	}
+	return state
+}
+
+// State returns a snapshot of the state of the connections of the client, which is updated by its connection lifecycle
+// events. A client is degraded while the connection to some of its nodes is lost and being reestablished.
+//
+// Return value:
+//
+// The state of the connections of the client.
+func (client *baseClient) State() models.ClientState {
+	state := client.lifecycle.state()
+	client.mu.Lock()
+	if client.coreClient == nil || client.closing {
+		state.Status = models.Closed
+	}
+	client.mu.Unlock()
+	return state
+}
+
+// lifecycleEvent converts an event received from the core into a connection lifecycle event.
+func lifecycleEvent(kind C.LifecycleEventKind, address string, attempt uint64, detail string) models.ConnectionEvent {
+	event := models.ConnectionEvent{Address: address, Attempt: int(attempt), Time: time.Now()}
+	switch kind {
+	case C.LifecycleDisconnected:
+		event.Type = models.Disconnected
+	case C.LifecycleReconnectAttempt:
+		event.Type = models.ReconnectAttempt
+	case C.LifecycleReconnected:
+		event.Type = models.Reconnected
+	case C.LifecycleTopologyRefreshed:
+		event.Type = models.TopologyRefreshed
+		if detail != "" {
+			event.Unreachable = strings.Split(detail, ",")
+		}
+		return event
+	case C.LifecycleSubscribed:
+		event.Type = models.Subscribed
+		event.Channel = detail
+		return event
+	}
+	if detail != "" {
+		event.Cause = errors.New(detail)
+	}
+	return event
+}
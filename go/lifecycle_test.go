@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifecycleTracker_State(t *testing.T) {
+	tracker := newLifecycleTracker(nil)
+	assert.Equal(t, models.ClientState{Status: models.Connected}, tracker.state())
+
+	cause := errors.New("connection refused")
+	tracker.record(models.ConnectionEvent{Type: models.Disconnected, Address: "node2:6379", Cause: cause})
+	tracker.record(models.ConnectionEvent{Type: models.ReconnectAttempt, Address: "node1:6379", Attempt: 1})
+	state := tracker.state()
+	assert.Equal(t, models.Degraded, state.Status)
+	assert.Equal(t, []string{"node1:6379", "node2:6379"}, state.DisconnectedNodes)
+	assert.Equal(t, models.ReconnectAttempt, state.LastEvent.Type)
+
+	tracker.record(models.ConnectionEvent{Type: models.Reconnected, Address: "node1:6379", Attempt: 2})
+	assert.Equal(t, []string{"node2:6379"}, tracker.state().DisconnectedNodes)
+
+	refreshed := time.Now()
+	tracker.record(models.ConnectionEvent{Type: models.TopologyRefreshed, Attempt: 1, Time: refreshed})
+	state = tracker.state()
+	assert.Equal(t, models.Connected, state.Status)
+	assert.Empty(t, state.DisconnectedNodes)
+	assert.Equal(t, refreshed, state.LastTopologyRefresh)
+}
+
+func TestLifecycleTracker_TopologyRefreshed(t *testing.T) {
+	tracker := newLifecycleTracker(nil)
+	tracker.record(models.ConnectionEvent{Type: models.Disconnected, Address: "node1:6379"})
+	tracker.record(models.ConnectionEvent{Type: models.Disconnected, Address: "node2:6379"})
+	tracker.record(models.ConnectionEvent{Type: models.Disconnected, Address: "node3:6379"})
+
+	// node1 is still unreachable, node2 was connected to by the refresh, and node3 left the topology
+	tracker.record(models.ConnectionEvent{Type: models.TopologyRefreshed, Unreachable: []string{"node1:6379", "node4:6379"}})
+	state := tracker.state()
+	assert.Equal(t, models.Degraded, state.Status)
+	assert.Equal(t, []string{"node1:6379", "node4:6379"}, state.DisconnectedNodes)
+
+	tracker.record(models.ConnectionEvent{Type: models.Reconnected, Address: "node1:6379"})
+	tracker.record(models.ConnectionEvent{Type: models.TopologyRefreshed})
+	assert.Equal(t, models.Connected, tracker.state().Status)
+}
+
+func TestLifecycleTracker_Listener(t *testing.T) {
+	received := make(chan models.ConnectionEvent, 3)
+	tracker := newLifecycleTracker(func(event models.ConnectionEvent) {
+		if event.Type == models.ReconnectAttempt {
+			panic("the listener panics")
+		}
+		received <- event
+	})
+
+	tracker.record(models.ConnectionEvent{Type: models.Disconnected, Address: "node:6379"})
+	tracker.record(models.ConnectionEvent{Type: models.ReconnectAttempt, Address: "node:6379", Attempt: 1})
+	tracker.record(models.ConnectionEvent{Type: models.Reconnected, Address: "node:6379", Attempt: 1})
+
+	// the events are passed in order, and a panic of the listener does not stop the following events
+	assert.Equal(t, models.Disconnected, (<-received).Type)
+	assert.Equal(t, models.Reconnected, (<-received).Type)
+
+	tracker.close()
+	tracker.record(models.ConnectionEvent{Type: models.Disconnected, Address: "node:6379"})
+	assert.Equal(t, models.Connected, tracker.state().Status)
+}
+
+func TestState_ClosedClient(t *testing.T) {
+	client := &baseClient{clientConnection: &clientConnection{lifecycle: newLifecycleTracker(nil)}}
+	assert.Equal(t, models.Closed, client.State().Status)
+
+	// the dedicated connections of transactions are not tracked
+	client = &baseClient{clientConnection: &clientConnection{}}
+	assert.Equal(t, models.Closed, client.State().Status)
+}
@@ -0,0 +1,395 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package lock implements a distributed lock on top of the Valkey GLIDE clients.
+//
+// A lock is a key set with NX and a TTL to a random token which identifies its owner. Releasing and extending the lock
+// run a Lua script which checks the token, so that a client never releases or extends a lock acquired by another client
+// after its own lease expired. Each acquisition also returns a fencing token, incremented with INCR, which increases with
+// every acquisition of the lock and can be checked by the resources protected by the lock:
+//
+//	locker := lock.NewLocker(client)
+//	handle, err := locker.Acquire(ctx, "orders", 10*time.Second)
+//	if err != nil {
+//		return err
+//	}
+//	defer handle.Release(context.Background())
+//
+// A [Locker] created with [NewQuorumLocker] acquires the lock on a majority of several independent instances, in the
+// manner of the Redlock algorithm, and tolerates the failure of a minority of them.
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+var (
+	// ErrNotAcquired is returned by [Locker.Acquire] when the lock is held by another owner after all attempts.
+	ErrNotAcquired = errors.New("lock: not acquired")
+	// ErrNotHeld is returned by [Lock.Release] and [Lock.Extend] when the lock is no longer held by its owner, typically
+	// because its lease expired.
+	ErrNotHeld = errors.New("lock: not held")
+)
+
+// releaseScript deletes the lock key if it still holds the token of the owner.
+var releaseScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+})
+
+// extendScript resets the TTL of the lock key if it still holds the token of the owner.
+var extendScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+})
+
+// Locker acquires locks on one instance, or on a quorum of several instances.
+type Locker struct {
+	clients     []interfaces.BaseClientCommands
+	keyPrefix   string
+	retryCount  int
+	retryDelay  time.Duration
+	autoRenewal bool
+	fencing     bool
+}
+
+// NewLocker creates a locker which acquires locks with the given client. The client may be a standalone or a cluster
+// client.
+func NewLocker(client interfaces.BaseClientCommands) *Locker {
+	return NewQuorumLocker(client)
+}
+
+// NewQuorumLocker creates a locker which acquires locks on a majority of the given clients. Each client should be
+// connected to an independent instance.
+func NewQuorumLocker(clients ...interfaces.BaseClientCommands) *Locker {
+	return &Locker{
+		clients:     clients,
+		keyPrefix:   "lock:",
+		retryCount:  0,
+		retryDelay:  100 * time.Millisecond,
+		autoRenewal: true,
+		fencing:     true,
+	}
+}
+
+// WithKeyPrefix sets the prefix of the lock keys. The lock named `name` is stored at `<prefix>{name}`, and its fencing
+// counter at `<prefix>{name}:fence`, so that both keys hash to the same slot. The default prefix is "lock:".
+func (locker *Locker) WithKeyPrefix(prefix string) *Locker {
+	locker.keyPrefix = prefix
+	return locker
+}
+
+// WithRetry sets how many more times Acquire tries to acquire a lock held by another owner, and the delay between
+// attempts. By default, Acquire fails after a single attempt.
+func (locker *Locker) WithRetry(count int, delay time.Duration) *Locker {
+	locker.retryCount = count
+	locker.retryDelay = delay
+	return locker
+}
+
+// WithAutoRenewal sets whether acquired locks are extended in the background until they are released. Auto-renewal is
+// enabled by default.
+func (locker *Locker) WithAutoRenewal(autoRenewal bool) *Locker {
+	locker.autoRenewal = autoRenewal
+	return locker
+}
+
+// WithFencing sets whether acquisitions increment the fencing counter of the lock. The counter is never deleted, so
+// lockers of many short-lived names, such as the names derived from cache keys, should disable it when the fencing
+// tokens are not used. The fencing token of the locks acquired without fencing is 0. Fencing is enabled by default.
+func (locker *Locker) WithFencing(fencing bool) *Locker {
+	locker.fencing = fencing
+	return locker
+}
+
+func (locker *Locker) quorum() int {
+	return len(locker.clients)/2 + 1
+}
+
+// Acquire acquires the lock with the given name for the given TTL. It returns [ErrNotAcquired] if the lock is held by
+// another owner after all attempts.
+func (locker *Locker) Acquire(ctx context.Context, name string, ttl time.Duration) (*Lock, error) {
+	if len(locker.clients) == 0 {
+		return nil, errors.New("lock: no clients")
+	}
+	if ttl < time.Millisecond {
+		return nil, errors.New("lock: the TTL must be at least one millisecond")
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	lock := &Lock{
+		locker: locker,
+		name:   name,
+		key:    locker.keyPrefix + "{" + name + "}",
+		token:  token,
+		ttl:    ttl,
+		lost:   make(chan struct{}),
+	}
+
+	for attempt := 0; ; attempt++ {
+		acquired, err := lock.tryAcquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			if locker.autoRenewal {
+				lock.startRenewal()
+			}
+			return lock, nil
+		}
+		if attempt >= locker.retryCount {
+			return nil, ErrNotAcquired
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(locker.retryDelay):
+		}
+	}
+}
+
+func newToken() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// Lock is a handle to an acquired lock.
+type Lock struct {
+	locker *Locker
+	name   string
+	key    string
+	token  string
+	ttl    time.Duration
+	fence  int64
+
+	mu       sync.Mutex
+	expireAt time.Time
+	released bool
+	stop     chan struct{}
+	done     chan struct{}
+	lost     chan struct{}
+	lostOnce sync.Once
+	stopOnce sync.Once
+}
+
+// Name returns the name of the lock.
+func (lock *Lock) Name() string {
+	return lock.name
+}
+
+// Token returns the random token which identifies the owner of the lock.
+func (lock *Lock) Token() string {
+	return lock.token
+}
+
+// FencingToken returns the fencing token of this acquisition of the lock. Fencing tokens increase with every acquisition,
+// so a resource protected by the lock can reject the requests of an owner whose lease expired, by rejecting tokens lower
+// than the highest token it has seen. With a quorum locker, the token is the highest counter among the instances which
+// granted the lock. It is 0 if the locker was created without fencing, see [Locker.WithFencing].
+func (lock *Lock) FencingToken() int64 {
+	return lock.fence
+}
+
+// Validity returns the time until the lease of the lock expires, unless it is extended.
+func (lock *Lock) Validity() time.Duration {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	return max(time.Until(lock.expireAt), 0)
+}
+
+// Lost returns a channel which is closed when the background renewal fails to extend the lock, meaning that the lock
+// may be acquired by another owner.
+func (lock *Lock) Lost() <-chan struct{} {
+	return lock.lost
+}
+
+// drift is the allowance for clock drift between instances, following the Redlock algorithm.
+func (lock *Lock) drift() time.Duration {
+	return lock.ttl/100 + 2*time.Millisecond
+}
+
+// tryAcquire makes a single attempt to acquire the lock on a quorum of instances. On failure, the lock is released from
+// the instances which granted it.
+func (lock *Lock) tryAcquire(ctx context.Context) (bool, error) {
+	start := time.Now()
+	setOptions := options.NewSetOptions().
+		SetConditionalSet(constants.OnlyIfDoesNotExist).
+		SetExpiry(options.NewExpiry().SetType(constants.Milliseconds).SetCount(uint64(lock.ttl.Milliseconds())))
+
+	var acquired []interfaces.BaseClientCommands
+	var failures int
+	var lastErr error
+	for _, client := range lock.locker.clients {
+		result, err := client.SetWithOptions(ctx, lock.key, lock.token, *setOptions)
+		if err != nil {
+			failures++
+			lastErr = err
+			continue
+		}
+		if !result.IsNil() {
+			acquired = append(acquired, client)
+		}
+	}
+
+	validity := lock.ttl - time.Since(start) - lock.drift()
+	if len(acquired) < lock.locker.quorum() || validity <= 0 {
+		lock.releaseFrom(context.WithoutCancel(ctx), acquired)
+		// the error is only reported if the lock could have been acquired without it
+		if lastErr != nil && len(acquired)+failures >= lock.locker.quorum() {
+			return false, lastErr
+		}
+		return false, nil
+	}
+
+	for _, client := range acquired {
+		if !lock.locker.fencing {
+			break
+		}
+		fence, err := client.Incr(ctx, lock.key+":fence")
+		if err != nil {
+			lock.releaseFrom(context.WithoutCancel(ctx), acquired)
+			return false, err
+		}
+		lock.fence = max(lock.fence, fence)
+	}
+	lock.expireAt = start.Add(validity)
+	return true, nil
+}
+
+// Extend resets the lease of the lock to the given TTL. It returns [ErrNotHeld] if the lock is no longer held by its
+// owner on a quorum of instances.
+func (lock *Lock) Extend(ctx context.Context, ttl time.Duration) error {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	if lock.released {
+		return ErrNotHeld
+	}
+	return lock.extend(ctx, ttl)
+}
+
+func (lock *Lock) extend(ctx context.Context, ttl time.Duration) error {
+	start := time.Now()
+	scriptOptions := options.NewScriptOptions().
+		WithKeys([]string{lock.key}).
+		WithArgs([]string{lock.token, strconv.FormatInt(ttl.Milliseconds(), 10)})
+	extended, err := lock.invoke(ctx, extendScript(), scriptOptions, lock.locker.clients)
+	if err != nil {
+		return err
+	}
+	validity := ttl - time.Since(start) - lock.drift()
+	if extended < lock.locker.quorum() || validity <= 0 {
+		return ErrNotHeld
+	}
+	lock.expireAt = start.Add(validity)
+	return nil
+}
+
+// Release stops the background renewal of the lock and releases it. It returns [ErrNotHeld] if the lock was no longer
+// held by its owner on a quorum of instances, in which case it may have been acquired by another owner.
+func (lock *Lock) Release(ctx context.Context) error {
+	lock.stopRenewal()
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	if lock.released {
+		return ErrNotHeld
+	}
+	lock.released = true
+	released, err := lock.releaseFrom(ctx, lock.locker.clients)
+	if err != nil {
+		return err
+	}
+	if released < lock.locker.quorum() {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// releaseFrom releases the lock from the given instances, and returns on how many of them it was held.
+func (lock *Lock) releaseFrom(ctx context.Context, clients []interfaces.BaseClientCommands) (int, error) {
+	scriptOptions := options.NewScriptOptions().WithKeys([]string{lock.key}).WithArgs([]string{lock.token})
+	return lock.invoke(ctx, releaseScript(), scriptOptions, clients)
+}
+
+// invoke runs an owner-checked script on the given instances, and returns how many of them returned 1. The last error is
+// returned if the script failed on too many instances to reach the quorum.
+func (lock *Lock) invoke(
+	ctx context.Context,
+	script *options.Script,
+	scriptOptions *options.ScriptOptions,
+	clients []interfaces.BaseClientCommands,
+) (int, error) {
+	var succeeded, failures int
+	var lastErr error
+	for _, client := range clients {
+		result, err := client.InvokeScriptWithOptions(ctx, *script, *scriptOptions)
+		if err != nil {
+			failures++
+			lastErr = err
+			continue
+		}
+		if result == int64(1) {
+			succeeded++
+		}
+	}
+	if lastErr != nil && succeeded < lock.locker.quorum() && succeeded+failures >= lock.locker.quorum() {
+		return succeeded, lastErr
+	}
+	return succeeded, nil
+}
+
+// startRenewal extends the lock every third of its TTL until it is released, or until an extension fails.
+func (lock *Lock) startRenewal() {
+	lock.stop = make(chan struct{})
+	lock.done = make(chan struct{})
+	go func() {
+		defer close(lock.done)
+		ticker := time.NewTicker(lock.ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-lock.stop:
+				return
+			case <-ticker.C:
+				lock.mu.Lock()
+				ctx, cancel := context.WithTimeout(context.Background(), lock.ttl/3)
+				err := lock.extend(ctx, lock.ttl)
+				cancel()
+				lock.mu.Unlock()
+				if err != nil {
+					lock.lostOnce.Do(func() { close(lock.lost) })
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (lock *Lock) stopRenewal() {
+	if lock.stop == nil {
+		return
+	}
+	// a released lock is not reported as lost
+	lock.lostOnce.Do(func() {})
+	lock.stopOnce.Do(func() { close(lock.stop) })
+	<-lock.done
+}
@@ -0,0 +1,123 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package lock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquire(t *testing.T) {
+	ctx := context.Background()
+	server := glidetest.NewServer()
+	client := server.NewClient()
+	locker := NewLocker(client).WithAutoRenewal(false)
+
+	lock, err := locker.Acquire(ctx, "resource", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "resource", lock.Name())
+	assert.Equal(t, int64(1), lock.FencingToken())
+	assert.Greater(t, lock.Validity(), 9*time.Second)
+
+	token, err := client.Get(ctx, "lock:{resource}")
+	require.NoError(t, err)
+	assert.Equal(t, lock.Token(), token.Value())
+	ttl, err := client.PTTL(ctx, "lock:{resource}")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), ttl)
+
+	_, err = locker.Acquire(ctx, "resource", 10*time.Second)
+	assert.ErrorIs(t, err, ErrNotAcquired)
+
+	server.FastForward(10 * time.Second)
+	lock, err = locker.Acquire(ctx, "resource", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), lock.FencingToken())
+}
+
+func TestAcquireWithoutFencing(t *testing.T) {
+	ctx := context.Background()
+	server := glidetest.NewServer()
+	client := server.NewClient()
+	locker := NewLocker(client).WithAutoRenewal(false).WithFencing(false)
+
+	lock, err := locker.Acquire(ctx, "resource", 10*time.Second)
+	require.NoError(t, err)
+	assert.Zero(t, lock.FencingToken())
+
+	// no key remains once the lease expired
+	server.FastForward(10 * time.Second)
+	size, err := client.DBSize(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, size)
+}
+
+func TestAcquireWithKeyPrefix(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+
+	_, err := NewLocker(client).WithKeyPrefix("app:").WithAutoRenewal(false).Acquire(ctx, "resource", time.Second)
+	require.NoError(t, err)
+
+	exists, err := client.Exists(ctx, []string{"app:{resource}", "app:{resource}:fence"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), exists)
+}
+
+func TestAcquireRetry(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+	_, err := client.Set(ctx, "lock:{resource}", "other")
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = NewLocker(client).WithRetry(2, 10*time.Millisecond).Acquire(ctx, "resource", time.Second)
+	assert.ErrorIs(t, err, ErrNotAcquired)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = NewLocker(client).WithRetry(2, time.Second).Acquire(canceled, "resource", time.Second)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAcquireInvalidArguments(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewQuorumLocker().Acquire(ctx, "resource", time.Second)
+	assert.Error(t, err)
+
+	_, err = NewLocker(glidetest.NewClient()).Acquire(ctx, "resource", time.Microsecond)
+	assert.Error(t, err)
+}
+
+func TestQuorumAcquire(t *testing.T) {
+	ctx := context.Background()
+	clients := []interfaces.BaseClientCommands{
+		glidetest.NewClient(),
+		glidetest.NewClient(),
+		glidetest.NewClient(),
+	}
+	locker := NewQuorumLocker(clients...).WithAutoRenewal(false)
+
+	// a minority of unavailable instances does not prevent the acquisition
+	clients[2].Close()
+	lock, err := locker.Acquire(ctx, "resource", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), lock.FencingToken())
+
+	_, err = locker.Acquire(ctx, "resource", time.Second)
+	assert.ErrorIs(t, err, ErrNotAcquired)
+
+	// the acquisition fails when the quorum can not be reached because of unavailable instances
+	clients[1].Close()
+	_, err = locker.Acquire(ctx, "other", time.Second)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotAcquired)
+}
@@ -0,0 +1,284 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include <stdlib.h>
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+)
+
+// goResponses tracks the command responses allocated on the Go side, which must not be released by the core.
+// liveGoResponses counts them, so that the responses of the core are released without a lookup while no response is
+// allocated on the Go side, which is always the case for the clients without middlewares.
+var (
+	goResponses     sync.Map
+	liveGoResponses atomic.Int64
+)
+
+// freeCommandResponse releases a command response, whether it was allocated by the core or by [createCommandResponse].
+func freeCommandResponse(response *C.struct_CommandResponse) {
+	if liveGoResponses.Load() > 0 {
+		if _, ok := goResponses.LoadAndDelete(response); ok {
+			liveGoResponses.Add(-1)
+			freeResponseContent(response)
+			C.free(unsafe.Pointer(response))
+			return
+		}
+	}
+	C.free_command_response(response)
+}
+
+// createCommandResponse allocates a command response holding the given value, so that it could be consumed by the response
+// handlers as if it was received from the core. The value must be of one of the types produced by [parseInterface].
+func createCommandResponse(value any) (*C.struct_CommandResponse, error) {
+	response := allocResponses(1)
+	if err := fillResponse(response, value); err != nil {
+		freeResponseContent(response)
+		C.free(unsafe.Pointer(response))
+		return nil, err
+	}
+	// count the response before publishing it, so that it is never released by the core
+	liveGoResponses.Add(1)
+	goResponses.Store(response, struct{}{})
+	return response, nil
+}
+
+func allocResponses(count int) *C.struct_CommandResponse {
+	// calloc with a count of 1 returns a valid pointer for empty collections
+	return (*C.struct_CommandResponse)(C.calloc(C.size_t(max(count, 1)), C.size_t(unsafe.Sizeof(C.struct_CommandResponse{}))))
+}
+
+func fillString(response *C.struct_CommandResponse, responseType C.ResponseType, value string) {
+	response.response_type = uint32(responseType)
+	// allocate one extra byte, so that empty strings are not represented by a nil pointer
+	response.string_value = (*C.char)(C.malloc(C.size_t(len(value) + 1)))
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(response.string_value)), len(value)), value)
+	response.string_value_len = C.long(len(value))
+}
+
+func fillResponse(response *C.struct_CommandResponse, value any) error {
+	switch value := value.(type) {
+	case nil:
+		response.response_type = uint32(C.Null)
+	case string:
+		fillString(response, C.String, value)
+	case int64:
+		response.response_type = uint32(C.Int)
+		response.int_value = C.int64_t(value)
+	case int:
+		response.response_type = uint32(C.Int)
+		response.int_value = C.int64_t(value)
+	case float64:
+		response.response_type = uint32(C.Float)
+		response.float_value = C.double(value)
+	case bool:
+		response.response_type = uint32(C.Bool)
+		response.bool_value = C._Bool(value)
+	case error:
+		fillString(response, C.Error, value.Error())
+	case []any:
+		response.response_type = uint32(C.Array)
+		response.array_value = allocResponses(len(value))
+		response.array_value_len = C.long(len(value))
+		items := unsafe.Slice(response.array_value, len(value))
+		for i, item := range value {
+			if err := fillResponse(&items[i], item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		response.response_type = uint32(C.Map)
+		response.array_value = allocResponses(len(value))
+		response.array_value_len = C.long(len(value))
+		entries := unsafe.Slice(response.array_value, len(value))
+		i := 0
+		for key, item := range value {
+			entries[i].map_key = allocResponses(1)
+			entries[i].map_value = allocResponses(1)
+			fillString(entries[i].map_key, C.String, key)
+			if err := fillResponse(entries[i].map_value, item); err != nil {
+				return err
+			}
+			i++
+		}
+	case map[string]struct{}:
+		response.response_type = uint32(C.Sets)
+		response.sets_value = allocResponses(len(value))
+		response.sets_value_len = C.long(len(value))
+		members := unsafe.Slice(response.sets_value, len(value))
+		i := 0
+		for member := range value {
+			fillString(&members[i], C.String, member)
+			i++
+		}
+	default:
+		return &errors.RequestError{Msg: fmt.Sprintf("Unsupported response value type %T", value)}
+	}
+	return nil
+}
+
+// freeResponseContent releases the memory referenced by a response allocated by [createCommandResponse], but not the
+// response itself.
+func freeResponseContent(response *C.struct_CommandResponse) {
+	if response.string_value != nil {
+		C.free(unsafe.Pointer(response.string_value))
+	}
+	if response.array_value != nil {
+		items := unsafe.Slice(response.array_value, response.array_value_len)
+		for i := range items {
+			if items[i].map_key != nil {
+				freeResponseContent(items[i].map_key)
+				C.free(unsafe.Pointer(items[i].map_key))
+			}
+			if items[i].map_value != nil {
+				freeResponseContent(items[i].map_value)
+				C.free(unsafe.Pointer(items[i].map_value))
+			}
+			freeResponseContent(&items[i])
+		}
+		C.free(unsafe.Pointer(response.array_value))
+	}
+	if response.sets_value != nil {
+		members := unsafe.Slice(response.sets_value, response.sets_value_len)
+		for i := range members {
+			freeResponseContent(&members[i])
+		}
+		C.free(unsafe.Pointer(response.sets_value))
+	}
+}
+
+// interceptCommand executes a command through the middlewares registered on the client.
+func (client *baseClient) interceptCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	request := &config.CommandRequest{
+		Name:   commandName(requestType, args),
+		Args:   slices.Clone(args),
+		Route:  route,
+		Custom: requestType == C.CustomCommand,
+	}
+
+	var result *config.CommandResult
+	entered := 0
+	for _, middleware := range client.middlewares {
+		entered++
+		if middleware.BeforeCommand != nil {
+			if result = middleware.BeforeCommand(ctx, request); result != nil {
+				break
+			}
+		}
+	}
+
+	needsValue := result != nil
+	for _, middleware := range client.middlewares[:entered] {
+		needsValue = needsValue || middleware.AfterCommand != nil
+	}
+	if !needsValue {
+		return client.dispatchCommand(ctx, requestType, request.Args, request.Route)
+	}
+
+	if result == nil {
+		start := time.Now()
+		response, err := client.dispatchCommand(ctx, requestType, request.Args, request.Route)
+		result = &config.CommandResult{Latency: time.Since(start), Err: err}
+		if err == nil {
+			result.Value, result.Err = handleInterfaceResponse(response)
+		}
+	}
+
+	for i := entered - 1; i >= 0; i-- {
+		if client.middlewares[i].AfterCommand != nil {
+			client.middlewares[i].AfterCommand(ctx, request, result)
+		}
+	}
+
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return createCommandResponse(result.Value)
+}
+
+// interceptBatch executes a batch through the middlewares registered on the client.
+func (client *baseClient) interceptBatch(
+	ctx context.Context,
+	batch pipeline.Batch,
+	raiseOnError bool,
+	options *pipeline.BatchOptions,
+) ([]any, error) {
+	request := &config.BatchRequest{
+		Commands:     make([]config.CommandRequest, len(batch.Commands)),
+		IsAtomic:     batch.IsAtomic,
+		RaiseOnError: raiseOnError,
+	}
+	if options != nil && options.Route != nil {
+		request.Route = *options.Route
+	}
+	for i, cmd := range batch.Commands {
+		requestType := C.RequestType(cmd.RequestType)
+		request.Commands[i] = config.CommandRequest{
+			Name:   commandName(requestType, cmd.Args),
+			Args:   slices.Clone(cmd.Args),
+			Custom: requestType == C.CustomCommand,
+		}
+	}
+
+	var result *config.BatchResult
+	entered := 0
+	for _, middleware := range client.middlewares {
+		entered++
+		if middleware.BeforeBatch != nil {
+			if result = middleware.BeforeBatch(ctx, request); result != nil {
+				break
+			}
+		}
+	}
+
+	if result == nil && len(request.Commands) != len(batch.Commands) {
+		result = &config.BatchResult{Err: &errors.RequestError{Msg: "Middleware must not add or remove batch commands"}}
+	}
+	if result == nil {
+		// copy the commands, so that the batch of the caller is not affected by the rewritten arguments
+		commands := make([]pipeline.Cmd, len(batch.Commands))
+		for i, cmd := range batch.Commands {
+			cmd.Args = request.Commands[i].Args
+			commands[i] = cmd
+		}
+		batch.Commands = commands
+		if request.Route != nil {
+			routedOptions := pipeline.BatchOptions{}
+			if options != nil {
+				routedOptions = *options
+			}
+			routedOptions.Route = &request.Route
+			options = &routedOptions
+		}
+
+		start := time.Now()
+		values, err := client.submitBatch(ctx, batch, raiseOnError, options)
+		result = &config.BatchResult{Values: values, Err: err, Latency: time.Since(start)}
+	}
+
+	for i := entered - 1; i >= 0; i-- {
+		if client.middlewares[i].AfterBatch != nil {
+			client.middlewares[i].AfterBatch(ctx, request, result)
+		}
+	}
+
+	return result.Values, result.Err
+}
@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCommandResponse_RoundTrip(t *testing.T) {
+	values := []any{
+		nil,
+		"",
+		"value",
+		"with\x00null",
+		int64(42),
+		3.5,
+		true,
+		[]any{"a", int64(1), nil, []any{"nested"}},
+		map[string]any{"field": "value", "count": int64(2), "list": []any{"x"}},
+		map[string]struct{}{"a": {}, "b": {}},
+	}
+
+	for _, value := range values {
+		response, err := createCommandResponse(value)
+		assert.NoError(t, err)
+		result, err := handleInterfaceResponse(response)
+		assert.NoError(t, err)
+		assert.Equal(t, value, result)
+	}
+	assert.Zero(t, liveGoResponses.Load())
+}
+
+func TestCreateCommandResponse_Conversions(t *testing.T) {
+	response, err := createCommandResponse(7)
+	assert.NoError(t, err)
+	intResult, err := handleIntResponse(response)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), intResult)
+
+	response, err = createCommandResponse("OK")
+	assert.NoError(t, err)
+	okResult, err := handleOkResponse(response)
+	assert.NoError(t, err)
+	assert.Equal(t, OK, okResult)
+
+	response, err = createCommandResponse([]any{"a", &errors.RequestError{Msg: "failure"}})
+	assert.NoError(t, err)
+	arrayResult, err := handleAnyArrayOrNilResponse(response)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"a", &errors.RequestError{Msg: "failure"}}, arrayResult)
+
+	_, err = createCommandResponse(struct{}{})
+	assert.IsType(t, &errors.RequestError{}, err)
+}
+
+func TestCommandName(t *testing.T) {
+	names := make(map[string]bool, len(requestTypeNames))
+	for requestType, name := range requestTypeNames {
+		assert.Equal(t, name, commandName(requestType, []string{"key"}))
+		names[name] = true
+	}
+	assert.True(t, names["GET"])
+	assert.True(t, names["CONFIG SET"])
+}
+
+func TestCommandPolicyCoversRequestTypes(t *testing.T) {
+	categories := []string{
+		"read", "write", "keyspace", "string", "hash", "list", "set", "sortedset", "stream", "bitmap", "geo",
+		"hyperloglog", "pubsub", "scripting", "transaction", "connection", "admin", "dangerous", "fast", "slow",
+	}
+	// unknown commands have no categories, so they are not allowed by any category
+	policy := config.NewCommandPolicy().WithAllowedCategories(categories...)
+	for requestType, name := range requestTypeNames {
+		assert.NoError(t, policy.Check(&config.CommandRequest{Name: commandName(requestType, nil)}), name)
+	}
+}
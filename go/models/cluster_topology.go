@@ -0,0 +1,37 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// SlotRange is a range of hash slots of a cluster, including its first and last slots.
+type SlotRange struct {
+	Start int32
+	End   int32
+}
+
+// ClusterShard is a shard of a cluster, with its nodes and the slots it serves.
+type ClusterShard struct {
+	// Primary is the address of the primary of the shard, in the "host:port" format.
+	Primary string
+	// Replicas are the addresses of the replicas of the shard, in the "host:port" format.
+	Replicas []string
+	// SlotRanges are the slot ranges served by the shard, in ascending order.
+	SlotRanges []SlotRange
+}
+
+// ClusterTopology is a snapshot of the slot map of a cluster, as known by a client.
+type ClusterTopology struct {
+	// Shards are the shards of the cluster, ordered by their first slot.
+	Shards []ClusterShard
+}
+
+// ShardForSlot returns the shard serving a hash slot, or false when the slot is not covered by the topology.
+func (topology ClusterTopology) ShardForSlot(slot int32) (ClusterShard, bool) {
+	for _, shard := range topology.Shards {
+		for _, slotRange := range shard.SlotRanges {
+			if slotRange.Start <= slot && slot <= slotRange.End {
+				return shard, true
+			}
+		}
+	}
+	return ClusterShard{}, false
+}
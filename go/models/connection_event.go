@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"time"
+)
+
+// ConnectionEventType is the type of a [ConnectionEvent].
+type ConnectionEventType int
+
+const (
+	// Disconnected - The connection to a node was lost, and the client reconnects to it.
+	Disconnected ConnectionEventType = iota
+	// ReconnectAttempt - The client attempts to reconnect to a node, following its BackoffStrategy.
+	ReconnectAttempt
+	// Reconnected - The connection to a node was reestablished.
+	Reconnected
+	// TopologyRefreshed - The slot map of the cluster was refreshed.
+	TopologyRefreshed
+	// Subscribed - The client subscribed to a pub/sub channel, when connecting or after reconnecting.
+	Subscribed
+)
+
+func (eventType ConnectionEventType) String() string {
+	switch eventType {
+	case Disconnected:
+		return "Disconnected"
+	case ReconnectAttempt:
+		return "ReconnectAttempt"
+	case Reconnected:
+		return "Reconnected"
+	case TopologyRefreshed:
+		return "TopologyRefreshed"
+	case Subscribed:
+		return "Subscribed"
+	}
+	return "Unknown"
+}
+
+// ConnectionEvent is a connection lifecycle event of a client.
+type ConnectionEvent struct {
+	Type ConnectionEventType
+	// Address is the address of the node, or empty for the events of the whole cluster.
+	Address string
+	// Attempt is the number of the reconnect attempt, starting from 1, or of the topology refresh attempt.
+	Attempt int
+	// Cause is the error which led to a disconnection or to a failed reconnect attempt, or nil.
+	Cause error
+	// Channel is the channel of a subscription.
+	Channel string
+	// Unreachable holds the addresses of the nodes of a refreshed topology which could not be connected to.
+	Unreachable []string
+	Time        time.Time
+}
+
+// ConnectionStatus is the status of the connections of a client.
+type ConnectionStatus int
+
+const (
+	// Connected - The client is connected to all the known nodes.
+	Connected ConnectionStatus = iota
+	// Degraded - The connection to some of the nodes is lost, and the client reconnects to them.
+	Degraded
+	// Closed - The client is closed, or is closing.
+	Closed
+)
+
+func (status ConnectionStatus) String() string {
+	switch status {
+	case Connected:
+		return "Connected"
+	case Degraded:
+		return "Degraded"
+	case Closed:
+		return "Closed"
+	}
+	return "Unknown"
+}
+
+// ClientState is a snapshot of the state of the connections of a client, as returned by the State method of the clients.
+type ClientState struct {
+	Status ConnectionStatus
+	// DisconnectedNodes are the addresses of the nodes whose connection is lost, sorted.
+	DisconnectedNodes []string
+	// LastEvent is the last connection lifecycle event of the client, or nil.
+	LastEvent *ConnectionEvent
+	// LastTopologyRefresh is the time of the last refresh of the slot map of a cluster, or the zero time.
+	LastTopologyRefresh time.Time
+}
@@ -0,0 +1,28 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"time"
+)
+
+// HedgingStatistics reports the hedged reads of a client, as returned by the HedgingStatistics method of the clients.
+type HedgingStatistics struct {
+	// Reads is the number of reads eligible to hedging.
+	Reads int64
+	// HedgedReads is the number of reads which did not complete within the hedging delay, and for which a duplicate read
+	// was sent to another node.
+	HedgedReads int64
+	// HedgeWins is the number of hedged reads whose duplicate read responded first.
+	HedgeWins int64
+	// Delay is the current hedging delay.
+	Delay time.Duration
+}
+
+// HedgeRate returns the fraction of the eligible reads which were hedged.
+func (statistics HedgingStatistics) HedgeRate() float64 {
+	if statistics.Reads == 0 {
+		return 0
+	}
+	return float64(statistics.HedgedReads) / float64(statistics.Reads)
+}
@@ -0,0 +1,386 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+)
+
+// WithNamespace returns a view of the client which prefixes every key with the given prefix. The view implements the
+// same commands as the client, and shares its connection, so closing the view closes the client.
+//
+// Keys are prefixed in the arguments of the commands, of the batches executed by the view, of the scripts it invokes,
+// and of the slot key routes, and stripped from the keys returned by SCAN, KEYS, RANDOMKEY, BLPOP, BRPOP, BZPOPMIN,
+// BZPOPMAX, LMPOP, BLMPOP, ZMPOP, BZMPOP, XREAD and XREADGROUP. SCAN and KEYS only return the keys of the namespace, but
+// RANDOMKEY may return a key outside of it, which is returned as it is. The patterns of SORT are prefixed as well, so
+// BY and GET refer to keys of the namespace.
+//
+// The namespace is applied after the middlewares of the client, which see the keys without the prefix. Calling
+// WithNamespace on a view appends the prefix to the namespace of the view.
+func (client *Client) WithNamespace(prefix string) *Client {
+	return &Client{client.withNamespace(prefix)}
+}
+
+// WithNamespace returns a view of the client which prefixes every key with the given prefix. The view implements the
+// same commands as the client, and shares its connection, so closing the view closes the client.
+//
+// Keys are prefixed in the arguments of the commands, of the batches executed by the view, of the scripts it invokes,
+// and of the slot key routes, and stripped from the keys returned by SCAN, KEYS, RANDOMKEY, BLPOP, BRPOP, BZPOPMIN,
+// BZPOPMAX, LMPOP, BLMPOP, ZMPOP, BZMPOP, XREAD and XREADGROUP. SCAN and KEYS only return the keys of the namespace, but
+// RANDOMKEY may return a key outside of it, which is returned as it is. The patterns of SORT are prefixed as well, so
+// BY and GET refer to keys of the namespace.
+//
+// The prefix changes the hash slot of the keys, unless they have a hash tag. A prefix with a hash tag, such as
+// "{tenant}:", maps all the keys of the namespace to a single slot.
+//
+// The namespace is applied after the middlewares of the client, which see the keys without the prefix. Calling
+// WithNamespace on a view appends the prefix to the namespace of the view.
+func (client *ClusterClient) WithNamespace(prefix string) *ClusterClient {
+	return &ClusterClient{client.withNamespace(prefix)}
+}
+
+func (client *baseClient) withNamespace(prefix string) *baseClient {
+	middlewares := client.middlewares
+	if client.namespace != "" {
+		// the namespace middleware of a view is its last middleware
+		middlewares = middlewares[:len(middlewares)-1]
+	}
+	view := *client
+	view.namespace = client.namespace + prefix
+	view.middlewares = append(slices.Clip(middlewares), namespaceMiddleware(view.namespace))
+	return &view
+}
+
+// namespacedKeys returns the keys with the prefix of the namespace.
+func (client *baseClient) namespacedKeys(keys []string) []string {
+	if client.namespace == "" {
+		return keys
+	}
+	namespaced := make([]string, len(keys))
+	for i, key := range keys {
+		namespaced[i] = client.namespace + key
+	}
+	return namespaced
+}
+
+// namespacedScanArgs restricts the pattern of the arguments of a scan to the keys of the namespace.
+func namespacedScanArgs(namespace string, args []string) []string {
+	for i := 0; i+1 < len(args); i++ {
+		if strings.EqualFold(args[i], "MATCH") {
+			args = slices.Clone(args)
+			args[i+1] = escapePattern(namespace) + args[i+1]
+			return args
+		}
+	}
+	return append(slices.Clip(args), "MATCH", escapePattern(namespace)+"*")
+}
+
+// stripNamespace removes the prefix of the namespace from the keys.
+func (client *baseClient) stripNamespace(keys []string) []string {
+	for i, key := range keys {
+		keys[i] = strings.TrimPrefix(key, client.namespace)
+	}
+	return keys
+}
+
+// escapePattern escapes the glob-style special characters of a prefix.
+func escapePattern(prefix string) string {
+	var escaped strings.Builder
+	for _, char := range prefix {
+		if strings.ContainsRune(`*?[]\`, char) {
+			escaped.WriteByte('\\')
+		}
+		escaped.WriteRune(char)
+	}
+	return escaped.String()
+}
+
+// keySpec returns the indexes of the keys in the arguments of a command, excluding the command name.
+type keySpec func(args []string) []int
+
+// firstKeys returns a spec for commands whose first count arguments are keys.
+func firstKeys(count int) keySpec {
+	return func(args []string) []int {
+		indexes := make([]int, 0, count)
+		for i := 0; i < count && i < len(args); i++ {
+			indexes = append(indexes, i)
+		}
+		return indexes
+	}
+}
+
+// keyAt returns a spec for commands with a single key at the given index.
+func keyAt(index int) keySpec {
+	return func(args []string) []int {
+		if index < len(args) {
+			return []int{index}
+		}
+		return nil
+	}
+}
+
+// keysFrom returns a spec for commands whose arguments are all keys, from the given index and until the given number of
+// trailing arguments.
+func keysFrom(first int, trailing int) keySpec {
+	return func(args []string) []int {
+		var indexes []int
+		for i := first; i < len(args)-trailing; i++ {
+			indexes = append(indexes, i)
+		}
+		return indexes
+	}
+}
+
+// numKeysAt returns a spec for commands whose keys follow their count at the given index, after the given number of
+// leading keys.
+func numKeysAt(index int, leading int) keySpec {
+	return func(args []string) []int {
+		indexes := firstKeys(leading)(args)
+		if index >= len(args) {
+			return indexes
+		}
+		count, err := strconv.Atoi(args[index])
+		if err != nil {
+			return indexes
+		}
+		for i := index + 1; i <= index+count && i < len(args); i++ {
+			indexes = append(indexes, i)
+		}
+		return indexes
+	}
+}
+
+// everyOtherKey is the spec of commands with key and value pairs.
+func everyOtherKey(args []string) []int {
+	var indexes []int
+	for i := 0; i < len(args); i += 2 {
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+// streamKeys is the spec of XREAD and XREADGROUP, whose keys follow STREAMS and are followed by as many IDs.
+func streamKeys(args []string) []int {
+	for i, arg := range args {
+		if strings.EqualFold(arg, "STREAMS") {
+			var indexes []int
+			for key := i + 1; key < i+1+(len(args)-i-1)/2; key++ {
+				indexes = append(indexes, key)
+			}
+			return indexes
+		}
+	}
+	return nil
+}
+
+// sortKeys is the spec of SORT and SORT_RO, whose BY and GET patterns and STORE destination refer to keys.
+func sortKeys(args []string) []int {
+	indexes := firstKeys(1)(args)
+	for i := 1; i+1 < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "BY", "STORE":
+			indexes = append(indexes, i+1)
+			i++
+		case "GET":
+			if args[i+1] != "#" {
+				indexes = append(indexes, i+1)
+			}
+			i++
+		case "LIMIT":
+			i += 2
+		}
+	}
+	return indexes
+}
+
+// keySpecs maps the commands with keys to the spec of their keys. The names of the subcommands are included for
+// commands sent via a request type, and not for commands sent via CustomCommand.
+var keySpecs = func() map[string]keySpec {
+	specs := map[string]keySpec{
+		"BITOP":          keysFrom(1, 0),
+		"BLMOVE":         firstKeys(2),
+		"BLMPOP":         numKeysAt(1, 0),
+		"BLPOP":          keysFrom(0, 1),
+		"BRPOP":          keysFrom(0, 1),
+		"BRPOPLPUSH":     firstKeys(2),
+		"BZMPOP":         numKeysAt(1, 0),
+		"BZPOPMAX":       keysFrom(0, 1),
+		"BZPOPMIN":       keysFrom(0, 1),
+		"COPY":           firstKeys(2),
+		"DEL":            keysFrom(0, 0),
+		"EVAL":           numKeysAt(1, 0),
+		"EVAL_RO":        numKeysAt(1, 0),
+		"EVALSHA":        numKeysAt(1, 0),
+		"EVALSHA_RO":     numKeysAt(1, 0),
+		"EXISTS":         keysFrom(0, 0),
+		"FCALL":          numKeysAt(1, 0),
+		"FCALL_RO":       numKeysAt(1, 0),
+		"GEOSEARCHSTORE": firstKeys(2),
+		"LCS":            firstKeys(2),
+		"LMOVE":          firstKeys(2),
+		"LMPOP":          numKeysAt(0, 0),
+		"MEMORY":         keyAt(1),
+		"MGET":           keysFrom(0, 0),
+		"MSET":           everyOtherKey,
+		"MSETNX":         everyOtherKey,
+		"OBJECT":         keyAt(1),
+		"PFCOUNT":        keysFrom(0, 0),
+		"PFMERGE":        keysFrom(0, 0),
+		"RENAME":         firstKeys(2),
+		"RENAMENX":       firstKeys(2),
+		"RPOPLPUSH":      firstKeys(2),
+		"SDIFF":          keysFrom(0, 0),
+		"SDIFFSTORE":     keysFrom(0, 0),
+		"SINTER":         keysFrom(0, 0),
+		"SINTERCARD":     numKeysAt(0, 0),
+		"SINTERSTORE":    keysFrom(0, 0),
+		"SMOVE":          firstKeys(2),
+		"SORT":           sortKeys,
+		"SORT_RO":        sortKeys,
+		"SUNION":         keysFrom(0, 0),
+		"SUNIONSTORE":    keysFrom(0, 0),
+		"TOUCH":          keysFrom(0, 0),
+		"UNLINK":         keysFrom(0, 0),
+		"WATCH":          keysFrom(0, 0),
+		"XGROUP":         keyAt(1),
+		"XINFO":          keyAt(1),
+		"XREAD":          streamKeys,
+		"XREADGROUP":     streamKeys,
+		"ZDIFF":          numKeysAt(0, 0),
+		"ZDIFFSTORE":     numKeysAt(1, 1),
+		"ZINTER":         numKeysAt(0, 0),
+		"ZINTERCARD":     numKeysAt(0, 0),
+		"ZINTERSTORE":    numKeysAt(1, 1),
+		"ZMPOP":          numKeysAt(0, 0),
+		"ZRANGESTORE":    firstKeys(2),
+		"ZUNION":         numKeysAt(0, 0),
+		"ZUNIONSTORE":    numKeysAt(1, 1),
+	}
+	for _, name := range []string{
+		"APPEND", "BITCOUNT", "BITFIELD", "BITFIELD_RO", "BITPOS", "DECR", "DECRBY", "DUMP", "EXPIRE", "EXPIREAT",
+		"EXPIRETIME", "GEOADD", "GEODIST", "GEOHASH", "GEOPOS", "GEOSEARCH", "GET", "GETBIT", "GETDEL", "GETEX",
+		"GETRANGE", "HDEL", "HEXISTS", "HGET", "HGETALL", "HINCRBY", "HINCRBYFLOAT", "HKEYS", "HLEN", "HMGET",
+		"HRANDFIELD", "HSCAN", "HSET", "HSETNX", "HSTRLEN", "HVALS", "INCR", "INCRBY", "INCRBYFLOAT", "LINDEX", "LINSERT",
+		"LLEN", "LPOP", "LPOS", "LPUSH", "LPUSHX", "LRANGE", "LREM", "LSET", "LTRIM", "MOVE", "OBJECT ENCODING",
+		"OBJECT FREQ", "OBJECT IDLETIME", "OBJECT REFCOUNT", "PERSIST", "PEXPIRE", "PEXPIREAT", "PEXPIRETIME", "PFADD",
+		"PSETEX", "PTTL", "RESTORE", "RPOP", "RPUSH", "RPUSHX", "SADD", "SCARD", "SET", "SETBIT", "SETEX", "SETNX",
+		"SETRANGE", "SISMEMBER", "SMEMBERS", "SMISMEMBER", "SPOP", "SRANDMEMBER", "SREM", "SSCAN", "STRLEN", "TTL", "TYPE",
+		"XACK", "XADD", "XAUTOCLAIM", "XCLAIM", "XDEL", "XGROUP CREATE", "XGROUP CREATECONSUMER", "XGROUP DELCONSUMER",
+		"XGROUP DESTROY", "XGROUP SETID", "XINFO CONSUMERS", "XINFO GROUPS", "XINFO STREAM", "XLEN", "XPENDING", "XRANGE",
+		"XREVRANGE", "XTRIM", "ZADD", "ZCARD", "ZCOUNT", "ZINCRBY", "ZLEXCOUNT", "ZMSCORE", "ZPOPMAX", "ZPOPMIN",
+		"ZRANDMEMBER", "ZRANGE", "ZRANK", "ZREM", "ZREMRANGEBYLEX", "ZREMRANGEBYRANK", "ZREMRANGEBYSCORE", "ZREVRANK",
+		"ZSCAN", "ZSCORE",
+	} {
+		specs[name] = keyAt(0)
+	}
+	return specs
+}()
+
+// namespacedArgs prefixes the keys in the arguments of a command.
+func namespacedArgs(namespace string, request *config.CommandRequest) {
+	args := request.Args
+	if request.Custom {
+		// the arguments of custom commands include the command name
+		args = args[min(1, len(args)):]
+	}
+	switch request.Name {
+	case "SCAN":
+		if len(args) > 0 {
+			// the pattern follows the cursor
+			offset := len(request.Args) - len(args) + 1
+			request.Args = append(request.Args[:offset:offset], namespacedScanArgs(namespace, args[1:])...)
+		}
+		return
+	case "KEYS":
+		if len(args) > 0 {
+			args[0] = escapePattern(namespace) + args[0]
+		}
+		return
+	}
+	if spec, found := keySpecs[request.Name]; found {
+		for _, index := range spec(args) {
+			args[index] = namespace + args[index]
+		}
+	}
+}
+
+// strippedResult removes the prefix of the namespace from the keys returned by a command.
+func strippedResult(namespace string, request *config.CommandRequest, value any) any {
+	strip := func(item any) any {
+		if key, ok := item.(string); ok {
+			return strings.TrimPrefix(key, namespace)
+		}
+		return item
+	}
+	switch request.Name {
+	case "RANDOMKEY":
+		return strip(value)
+	case "KEYS":
+		if keys, ok := value.([]any); ok {
+			for i, key := range keys {
+				keys[i] = strip(key)
+			}
+		}
+	case "SCAN":
+		if scan, ok := value.([]any); ok && len(scan) == 2 {
+			scan[1] = strippedResult(namespace, &config.CommandRequest{Name: "KEYS"}, scan[1])
+		}
+	case "BLPOP", "BRPOP", "BZPOPMIN", "BZPOPMAX", "LMPOP", "BLMPOP", "ZMPOP", "BZMPOP", "XREAD", "XREADGROUP":
+		switch value := value.(type) {
+		case []any:
+			if len(value) > 0 {
+				value[0] = strip(value[0])
+			}
+		case map[string]any:
+			stripped := make(map[string]any, len(value))
+			for key, item := range value {
+				stripped[strings.TrimPrefix(key, namespace)] = item
+			}
+			return stripped
+		}
+	}
+	return value
+}
+
+// namespacedRoute prefixes the key of a slot key route.
+func namespacedRoute(namespace string, route config.Route) config.Route {
+	if keyRoute, ok := route.(*config.SlotKeyRoute); ok {
+		return config.NewSlotKeyRoute(keyRoute.SlotType, namespace+keyRoute.SlotKey)
+	}
+	return route
+}
+
+// namespaceMiddleware returns the middleware applying a namespace to the commands of a view.
+func namespaceMiddleware(namespace string) *config.Middleware {
+	return &config.Middleware{
+		BeforeCommand: func(ctx context.Context, request *config.CommandRequest) *config.CommandResult {
+			namespacedArgs(namespace, request)
+			request.Route = namespacedRoute(namespace, request.Route)
+			return nil
+		},
+		AfterCommand: func(ctx context.Context, request *config.CommandRequest, result *config.CommandResult) {
+			if result.Err == nil {
+				result.Value = strippedResult(namespace, request, result.Value)
+			}
+		},
+		BeforeBatch: func(ctx context.Context, request *config.BatchRequest) *config.BatchResult {
+			for i := range request.Commands {
+				namespacedArgs(namespace, &request.Commands[i])
+			}
+			request.Route = namespacedRoute(namespace, request.Route)
+			return nil
+		},
+		AfterBatch: func(ctx context.Context, request *config.BatchRequest, result *config.BatchResult) {
+			for i, value := range result.Values {
+				if i < len(request.Commands) {
+					result.Values[i] = strippedResult(namespace, &request.Commands[i], value)
+				}
+			}
+		},
+	}
+}
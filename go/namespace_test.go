@@ -0,0 +1,129 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacedArgs(t *testing.T) {
+	commands := []struct {
+		request  config.CommandRequest
+		expected []string
+	}{
+		{config.CommandRequest{Name: "GET", Args: []string{"key"}}, []string{"t:key"}},
+		{config.CommandRequest{Name: "SET", Args: []string{"key", "value", "EX", "10"}}, []string{"t:key", "value", "EX", "10"}},
+		{config.CommandRequest{Name: "MGET", Args: []string{"a", "b"}}, []string{"t:a", "t:b"}},
+		{config.CommandRequest{Name: "MSET", Args: []string{"a", "1", "b", "2"}}, []string{"t:a", "1", "t:b", "2"}},
+		{config.CommandRequest{Name: "RENAME", Args: []string{"a", "b"}}, []string{"t:a", "t:b"}},
+		{config.CommandRequest{Name: "SUNIONSTORE", Args: []string{"d", "a", "b"}}, []string{"t:d", "t:a", "t:b"}},
+		{
+			config.CommandRequest{Name: "ZINTERSTORE", Args: []string{"d", "2", "a", "b", "WEIGHTS", "1", "2"}},
+			[]string{"t:d", "2", "t:a", "t:b", "WEIGHTS", "1", "2"},
+		},
+		{
+			config.CommandRequest{Name: "ZUNION", Args: []string{"2", "a", "b", "WITHSCORES"}},
+			[]string{"2", "t:a", "t:b", "WITHSCORES"},
+		},
+		{config.CommandRequest{Name: "BLMPOP", Args: []string{"0.5", "1", "a", "LEFT"}}, []string{"0.5", "1", "t:a", "LEFT"}},
+		{config.CommandRequest{Name: "BLPOP", Args: []string{"a", "b", "0.5"}}, []string{"t:a", "t:b", "0.5"}},
+		{config.CommandRequest{Name: "BITOP", Args: []string{"AND", "d", "a"}}, []string{"AND", "t:d", "t:a"}},
+		{
+			config.CommandRequest{Name: "XREADGROUP", Args: []string{"GROUP", "g", "c", "STREAMS", "a", "b", ">", ">"}},
+			[]string{"GROUP", "g", "c", "STREAMS", "t:a", "t:b", ">", ">"},
+		},
+		{
+			config.CommandRequest{
+				Name: "SORT",
+				Args: []string{"key", "LIMIT", "0", "10", "BY", "weight_*", "GET", "#", "GET", "data_*->name", "STORE", "dest"},
+			},
+			[]string{"t:key", "LIMIT", "0", "10", "BY", "t:weight_*", "GET", "#", "GET", "t:data_*->name", "STORE", "t:dest"},
+		},
+		{config.CommandRequest{Name: "FCALL", Args: []string{"fn", "1", "a", "arg"}}, []string{"fn", "1", "t:a", "arg"}},
+		{config.CommandRequest{Name: "OBJECT ENCODING", Args: []string{"key"}}, []string{"t:key"}},
+		{config.CommandRequest{Name: "SCAN", Args: []string{"0", "COUNT", "10"}}, []string{"0", "COUNT", "10", "MATCH", "t:*"}},
+		{config.CommandRequest{Name: "SCAN", Args: []string{"0", "MATCH", "user:*"}}, []string{"0", "MATCH", "t:user:*"}},
+		{config.CommandRequest{Name: "PING", Args: []string{"key"}}, []string{"key"}},
+		{config.CommandRequest{Name: "PUBLISH", Args: []string{"channel", "message"}}, []string{"channel", "message"}},
+		{
+			config.CommandRequest{Name: "GET", Args: []string{"GET", "key"}, Custom: true},
+			[]string{"GET", "t:key"},
+		},
+		{
+			config.CommandRequest{Name: "OBJECT", Args: []string{"OBJECT", "ENCODING", "key"}, Custom: true},
+			[]string{"OBJECT", "ENCODING", "t:key"},
+		},
+		{config.CommandRequest{Name: "KEYS", Args: []string{"KEYS", "*"}, Custom: true}, []string{"KEYS", "t:*"}},
+		{config.CommandRequest{Name: "SCAN", Args: []string{"SCAN", "0"}, Custom: true}, []string{"SCAN", "0", "MATCH", "t:*"}},
+	}
+	for _, command := range commands {
+		namespacedArgs("t:", &command.request)
+		assert.Equal(t, command.expected, command.request.Args, command.request.Name)
+	}
+}
+
+func TestNamespacedScanArgs(t *testing.T) {
+	assert.Equal(t, []string{"MATCH", `t\*\[x\]:*`}, namespacedScanArgs("t*[x]:", nil))
+	args := []string{"match", "a*", "COUNT", "5"}
+	assert.Equal(t, []string{"match", "t:a*", "COUNT", "5"}, namespacedScanArgs("t:", args))
+	assert.Equal(t, "a*", args[1])
+}
+
+func TestStrippedResult(t *testing.T) {
+	results := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{"RANDOMKEY", "t:key", "key"},
+		{"RANDOMKEY", "other", "other"},
+		{"RANDOMKEY", nil, nil},
+		{"SCAN", []any{"0", []any{"t:a", "t:b"}}, []any{"0", []any{"a", "b"}}},
+		{"BLPOP", []any{"t:list", "value"}, []any{"list", "value"}},
+		{"BZPOPMIN", []any{"t:zset", "member", 1.5}, []any{"zset", "member", 1.5}},
+		{"LMPOP", map[string]any{"t:list": []any{"t:value"}}, map[string]any{"list": []any{"t:value"}}},
+		{"XREAD", map[string]any{"t:stream": map[string]any{}}, map[string]any{"stream": map[string]any{}}},
+		{"GET", "t:value", "t:value"},
+	}
+	for _, result := range results {
+		assert.Equal(t, result.expected, strippedResult("t:", &config.CommandRequest{Name: result.name}, result.value), result.name)
+	}
+}
+
+func TestWithNamespace(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{&baseClient{clientConnection: &clientConnection{}, middlewares: []*config.Middleware{{}}}}
+
+	view := client.WithNamespace("a:")
+	assert.Same(t, client.clientConnection, view.clientConnection)
+	assert.Equal(t, "a:", view.namespace)
+	require.Len(t, view.middlewares, 2)
+	assert.Len(t, client.middlewares, 1)
+
+	nested := view.WithNamespace("b:")
+	assert.Equal(t, "a:b:", nested.namespace)
+	require.Len(t, nested.middlewares, 2)
+	assert.Equal(t, []string{"x", "y"}, client.namespacedKeys([]string{"x", "y"}))
+	assert.Equal(t, []string{"a:b:x", "a:b:y"}, nested.namespacedKeys([]string{"x", "y"}))
+
+	request := &config.CommandRequest{
+		Name:  "GET",
+		Args:  []string{"key"},
+		Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key"),
+	}
+	assert.Nil(t, nested.middlewares[1].BeforeCommand(ctx, request))
+	assert.Equal(t, []string{"a:b:key"}, request.Args)
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "a:b:key"), request.Route)
+
+	batch := &config.BatchRequest{Commands: []config.CommandRequest{{Name: "RANDOMKEY"}, {Name: "DEL", Args: []string{"key"}}}}
+	assert.Nil(t, nested.middlewares[1].BeforeBatch(ctx, batch))
+	assert.Equal(t, []string{"a:b:key"}, batch.Commands[1].Args)
+	result := &config.BatchResult{Values: []any{"a:b:key", int64(1)}}
+	nested.middlewares[1].AfterBatch(ctx, batch, result)
+	assert.Equal(t, []any{"key", int64(1)}, result.Values)
+}
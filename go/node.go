@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+)
+
+// Node returns a view of the client which sends all its commands to a single node of the cluster, as a standalone client.
+// The view implements the commands of [Client], such as Scan, Info, ConfigSet or DBSize, without routing them, and shares
+// the connection of the client, so closing the view closes the client.
+//
+// The commands, batches and scripts of the view are routed to the node by its address. Commands on keys served by other
+// nodes are redirected to those nodes by the servers, like the commands of the client. The commands of the view which are
+// specific to standalone servers, such as Select or Move, fail on cluster nodes.
+//
+// Parameters:
+//
+//	address - The address of the node, in the "host:port" format, as listed by [ClusterClient.Topology].
+//
+// Return value:
+//
+// A view of the client bound to the node.
+func (client *ClusterClient) Node(address string) (*Client, error) {
+	route, err := config.NewByAddressRouteWithHost(address)
+	if err != nil {
+		return nil, err
+	}
+	view := *client.baseClient
+	view.node = route
+	return &Client{&view}, nil
+}
+
+// ForEachPrimary calls a function with a view of the client bound to each primary of the cluster, one after the other, in
+// the order of the slots they serve. See [ClusterClient.Node] and [ClusterClient.Topology].
+//
+// The primaries are those of the slot map of the client when ForEachPrimary is called. The iteration stops at the first
+// error returned by the function, or when the context is done.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the iteration.
+//	fn - The function called with the address of each primary and a view of the client bound to it.
+//
+// Return value:
+//
+// The first error returned by the function or the error of the context, or nil.
+func (client *ClusterClient) ForEachPrimary(ctx context.Context, fn func(address string, node *Client) error) error {
+	topology, err := client.Topology(ctx)
+	if err != nil {
+		return err
+	}
+	for _, shard := range topology.Shards {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		node, err := client.Node(shard.Primary)
+		if err != nil {
+			return err
+		}
+		if err := fn(shard.Primary, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_PinsRoutes(t *testing.T) {
+	var commandRoute, batchRoute config.Route
+	middleware := &config.Middleware{
+		BeforeCommand: func(ctx context.Context, request *config.CommandRequest) *config.CommandResult {
+			commandRoute = request.Route
+			return &config.CommandResult{Value: int64(3)}
+		},
+		BeforeBatch: func(ctx context.Context, request *config.BatchRequest) *config.BatchResult {
+			batchRoute = request.Route
+			return &config.BatchResult{Values: []any{"OK"}}
+		},
+	}
+	client := &ClusterClient{&baseClient{clientConnection: &clientConnection{}, middlewares: []*config.Middleware{middleware}}}
+
+	node, err := client.Node("node1:6379")
+	require.NoError(t, err)
+	size, err := node.DBSize(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), size)
+	assert.Equal(t, &config.ByAddressRoute{Host: "node1", Port: 6379}, commandRoute)
+
+	_, err = node.Exec(context.Background(), *pipeline.NewStandaloneBatch(false).Set("key", "value"), true)
+	require.NoError(t, err)
+	assert.Equal(t, &config.ByAddressRoute{Host: "node1", Port: 6379}, batchRoute)
+
+	// the client itself is not bound to the node
+	_, err = client.CustomCommand(context.Background(), []string{"DBSIZE"})
+	require.NoError(t, err)
+	assert.Nil(t, commandRoute)
+
+	_, err = client.Node("node1")
+	assert.Error(t, err)
+}
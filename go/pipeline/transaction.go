@@ -0,0 +1,86 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"time"
+)
+
+// TransactionOptions contains the options of the optimistic transactions run by the Transaction methods of the clients.
+type TransactionOptions struct {
+	// MaxAttempts is the maximum number of attempts of a transaction, whose execution is aborted when a watched key is
+	// modified.
+	MaxAttempts int
+	// MinBackoff is the delay before the second attempt, which doubles on every following attempt.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts.
+	MaxBackoff time.Duration
+	// RaiseOnError determines how errors are handled within the batch response, as the raiseOnError argument of Exec.
+	RaiseOnError bool
+}
+
+// NewTransactionOptions creates a new options instance for transactions, which attempts a transaction at most 5 times,
+// with delays from 10 milliseconds to 1 second between attempts.
+//
+// Returns:
+//
+//	A new TransactionOptions instance.
+func NewTransactionOptions() *TransactionOptions {
+	return &TransactionOptions{MaxAttempts: 5, MinBackoff: 10 * time.Millisecond, MaxBackoff: time.Second}
+}
+
+// WithMaxAttempts sets the maximum number of attempts of the transaction.
+//
+// Parameters:
+//
+//	maxAttempts - The maximum number of attempts, including the first one.
+//
+// Returns:
+//
+//	The updated TransactionOptions instance.
+func (options *TransactionOptions) WithMaxAttempts(maxAttempts int) *TransactionOptions {
+	options.MaxAttempts = maxAttempts
+	return options
+}
+
+// WithBackoff sets the delays between the attempts of the transaction. The delays are randomized to between half and
+// all of their value, so that concurrent transactions do not retry in lockstep.
+//
+// Parameters:
+//
+//	minBackoff - The delay before the second attempt, which doubles on every following attempt.
+//	maxBackoff - The maximum delay between attempts.
+//
+// Returns:
+//
+//	The updated TransactionOptions instance.
+func (options *TransactionOptions) WithBackoff(minBackoff time.Duration, maxBackoff time.Duration) *TransactionOptions {
+	options.MinBackoff = minBackoff
+	options.MaxBackoff = maxBackoff
+	return options
+}
+
+// WithRaiseOnError sets how errors are handled within the batch response of the transaction.
+//
+// Parameters:
+//
+//	raiseOnError - Whether the first error in the batch response is returned as the error of the transaction.
+//
+// Returns:
+//
+//	The updated TransactionOptions instance.
+func (options *TransactionOptions) WithRaiseOnError(raiseOnError bool) *TransactionOptions {
+	options.RaiseOnError = raiseOnError
+	return options
+}
+
+// TransactionAbortedError is returned by the Transaction methods of the clients when every attempt of a transaction was
+// aborted, because a watched key was modified.
+type TransactionAbortedError struct {
+	Attempts int
+}
+
+func (e *TransactionAbortedError) Error() string {
+	return fmt.Sprintf("transaction aborted after %d attempts: a watched key was modified", e.Attempts)
+}
@@ -0,0 +1,222 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package ratelimit implements rate limiters on top of the Valkey GLIDE clients.
+//
+// Each algorithm runs as a single Lua script, so that concurrent clients never exceed the limit, and uses the clock of
+// the server, so that the limit does not depend on the clocks of the clients:
+//
+//	limiter, err := ratelimit.NewTokenBucket(client, 100, time.Second, 20)
+//	if err != nil {
+//		return err
+//	}
+//	result, err := limiter.Allow(ctx, "user:42")
+//	if err != nil {
+//		return err
+//	}
+//	if !result.Allowed {
+//		// reject the request, and ask the caller to retry after result.RetryAfter
+//	}
+//
+// The state of the limiter for a key is stored at `<prefix>{key}`, where the prefix defaults to "ratelimit:". The key is
+// wrapped in a hash tag, so that the limiters of a key use the same slot in cluster mode.
+//
+// The scripts are preloaded into the script cache of the client when the first limiter of their algorithm is created,
+// and are invoked by their SHA1 digest with EVALSHA. They are not loaded with SCRIPT LOAD up front: the client sends
+// SCRIPT LOAD and retries when a node replies that it does not know a script, which also covers the nodes added to a
+// cluster, the failovers to replicas and SCRIPT FLUSH, which a load at construction would not.
+package ratelimit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// Result is the outcome of a rate limited request.
+type Result struct {
+	// Allowed reports whether the request is allowed.
+	Allowed bool
+	// Remaining is the number of requests which would still be allowed right now.
+	Remaining int64
+	// RetryAfter is the time after which the request would be allowed. It is zero for allowed requests.
+	RetryAfter time.Duration
+}
+
+// Limiter limits the rate of requests per key, with one of the algorithms of the package.
+type Limiter struct {
+	client    interfaces.BaseClientCommands
+	script    *options.Script
+	keyPrefix string
+	// args returns the arguments of the script for a request of n units.
+	args func(n int64) ([]string, error)
+}
+
+// NewFixedWindow creates a limiter which allows `limit` requests per window. The window starts with the first request,
+// and the counter is reset when it ends, so up to twice the limit may be allowed around the end of a window.
+//
+// An error is returned if the limit is not positive, or if the window is shorter than a millisecond.
+func NewFixedWindow(client interfaces.BaseClientCommands, limit int64, window time.Duration) (*Limiter, error) {
+	if err := validate(limit, window); err != nil {
+		return nil, err
+	}
+	return &Limiter{
+		client:    client,
+		script:    fixedWindowScript(),
+		keyPrefix: defaultKeyPrefix,
+		args: func(n int64) ([]string, error) {
+			return formatArgs(limit, window.Milliseconds(), n), nil
+		},
+	}, nil
+}
+
+// NewSlidingLog creates a limiter which allows `limit` requests in any window of the given duration. Each allowed request
+// is stored in a sorted set, so the memory used per key grows with the limit.
+//
+// An error is returned if the limit is not positive, or if the window is shorter than a millisecond.
+func NewSlidingLog(client interfaces.BaseClientCommands, limit int64, window time.Duration) (*Limiter, error) {
+	if err := validate(limit, window); err != nil {
+		return nil, err
+	}
+	return &Limiter{
+		client:    client,
+		script:    slidingLogScript(),
+		keyPrefix: defaultKeyPrefix,
+		args: func(n int64) ([]string, error) {
+			id, err := newRequestId()
+			if err != nil {
+				return nil, err
+			}
+			return append(formatArgs(limit, window.Milliseconds(), n), id), nil
+		},
+	}, nil
+}
+
+// NewTokenBucket creates a limiter which refills a bucket of `capacity` tokens with `rate` tokens per period. Each request
+// takes a token from the bucket, so bursts of up to `capacity` requests are allowed.
+//
+// An error is returned if the rate or the capacity is not positive, or if the period is shorter than a millisecond.
+func NewTokenBucket(client interfaces.BaseClientCommands, rate int64, period time.Duration, capacity int64) (*Limiter, error) {
+	if err := validate(rate, period); err != nil {
+		return nil, err
+	}
+	if capacity <= 0 {
+		return nil, errors.New("ratelimit: the capacity must be positive")
+	}
+	return &Limiter{
+		client:    client,
+		script:    tokenBucketScript(),
+		keyPrefix: defaultKeyPrefix,
+		args: func(n int64) ([]string, error) {
+			return formatArgs(capacity, rate, period.Milliseconds(), n), nil
+		},
+	}, nil
+}
+
+// NewGCRA creates a limiter which implements the generic cell rate algorithm. It allows `limit` requests per period,
+// evenly spaced, and bursts of up to `burst` requests. The state of a key is a single timestamp.
+//
+// An error is returned if the limit or the burst is not positive, or if the period is shorter than a millisecond.
+func NewGCRA(client interfaces.BaseClientCommands, limit int64, period time.Duration, burst int64) (*Limiter, error) {
+	if err := validate(limit, period); err != nil {
+		return nil, err
+	}
+	if burst <= 0 {
+		return nil, errors.New("ratelimit: the burst must be positive")
+	}
+	emissionInterval := float64(period.Milliseconds()) / float64(limit)
+	return &Limiter{
+		client:    client,
+		script:    gcraScript(),
+		keyPrefix: defaultKeyPrefix,
+		args: func(n int64) ([]string, error) {
+			return []string{
+				strconv.FormatFloat(emissionInterval, 'f', -1, 64),
+				strconv.FormatFloat(emissionInterval*float64(burst), 'f', -1, 64),
+				strconv.FormatInt(n, 10),
+			}, nil
+		},
+	}, nil
+}
+
+const defaultKeyPrefix = "ratelimit:"
+
+// WithKeyPrefix sets the prefix of the keys used by the limiter. Limiters with different settings must not share keys.
+func (limiter *Limiter) WithKeyPrefix(prefix string) *Limiter {
+	limiter.keyPrefix = prefix
+	return limiter
+}
+
+// Allow checks whether a request for the given key is allowed, and records it if it is.
+func (limiter *Limiter) Allow(ctx context.Context, key string) (Result, error) {
+	return limiter.AllowN(ctx, key, 1)
+}
+
+// AllowN checks whether a request of n units for the given key is allowed, and records it if it is. A request is either
+// allowed in full or not at all.
+func (limiter *Limiter) AllowN(ctx context.Context, key string, n int64) (Result, error) {
+	if n <= 0 {
+		return Result{}, errors.New("ratelimit: the number of units must be positive")
+	}
+	args, err := limiter.args(n)
+	if err != nil {
+		return Result{}, err
+	}
+	scriptOptions := options.NewScriptOptions().WithKeys([]string{limiter.keyPrefix + "{" + key + "}"}).WithArgs(args)
+	response, err := limiter.client.InvokeScriptWithOptions(ctx, *limiter.script, *scriptOptions)
+	if err != nil {
+		return Result{}, err
+	}
+	return parseResult(response)
+}
+
+// parseResult converts the reply of a script, `{allowed, remaining, retry_after_ms}`, to a Result.
+func parseResult(response any) (Result, error) {
+	values, ok := response.([]any)
+	if !ok || len(values) != 3 {
+		return Result{}, fmt.Errorf("ratelimit: unexpected script reply %v", response)
+	}
+	integers := make([]int64, len(values))
+	for i, value := range values {
+		if integers[i], ok = value.(int64); !ok {
+			return Result{}, fmt.Errorf("ratelimit: unexpected script reply %v", response)
+		}
+	}
+	return Result{
+		Allowed:    integers[0] == 1,
+		Remaining:  max(integers[1], 0),
+		RetryAfter: time.Duration(max(integers[2], 0)) * time.Millisecond,
+	}, nil
+}
+
+func validate(limit int64, period time.Duration) error {
+	if limit <= 0 {
+		return errors.New("ratelimit: the limit must be positive")
+	}
+	if period < time.Millisecond {
+		return errors.New("ratelimit: the period must be at least one millisecond")
+	}
+	return nil
+}
+
+func formatArgs(values ...int64) []string {
+	args := make([]string, len(values))
+	for i, value := range values {
+		args[i] = strconv.FormatInt(value, 10)
+	}
+	return args
+}
+
+func newRequestId() (string, error) {
+	bytes := make([]byte, 8)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResult(t *testing.T) {
+	result, err := parseResult([]any{int64(1), int64(4), int64(0)})
+	require.NoError(t, err)
+	assert.Equal(t, Result{Allowed: true, Remaining: 4}, result)
+
+	result, err = parseResult([]any{int64(0), int64(-1), int64(1500)})
+	require.NoError(t, err)
+	assert.Equal(t, Result{Allowed: false, Remaining: 0, RetryAfter: 1500 * time.Millisecond}, result)
+
+	_, err = parseResult([]any{int64(1), int64(4)})
+	assert.Error(t, err)
+	_, err = parseResult([]any{int64(1), "4", int64(0)})
+	assert.Error(t, err)
+	_, err = parseResult("OK")
+	assert.Error(t, err)
+}
+
+func TestScriptArguments(t *testing.T) {
+	client := glidetest.NewClient()
+
+	limiter, err := NewFixedWindow(client, 10, time.Minute)
+	require.NoError(t, err)
+	args, err := limiter.args(2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10", "60000", "2"}, args)
+
+	limiter, err = NewSlidingLog(client, 10, time.Minute)
+	require.NoError(t, err)
+	args, err = limiter.args(1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10", "60000", "1"}, args[:3])
+	assert.Len(t, args[3], 16)
+
+	limiter, err = NewTokenBucket(client, 5, time.Second, 20)
+	require.NoError(t, err)
+	args, err = limiter.args(1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20", "5", "1000", "1"}, args)
+
+	limiter, err = NewGCRA(client, 4, time.Second, 2)
+	require.NoError(t, err)
+	args, err = limiter.args(1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"250", "500", "1"}, args)
+}
+
+func TestInvalidArguments(t *testing.T) {
+	ctx := context.Background()
+	client := glidetest.NewClient()
+
+	_, err := NewFixedWindow(client, 0, time.Second)
+	assert.Error(t, err)
+	_, err = NewSlidingLog(client, 10, time.Microsecond)
+	assert.Error(t, err)
+	_, err = NewTokenBucket(client, 10, time.Second, 0)
+	assert.Error(t, err)
+	_, err = NewTokenBucket(client, 0, time.Second, 10)
+	assert.Error(t, err)
+	_, err = NewGCRA(client, 10, time.Second, -1)
+	assert.Error(t, err)
+
+	limiter, err := NewFixedWindow(client, 10, time.Second)
+	require.NoError(t, err)
+	_, err = limiter.AllowN(ctx, "key", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"sync"
+
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// The scripts are created once, by the first limiter of their algorithm. Each script takes the key of the limiter and
+// returns `{allowed, remaining, retry_after_ms}`.
+
+// serverTime is the current time of the server in milliseconds.
+const serverTime = `
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+`
+
+// fixedWindowScript counts the requests of the current window in a string, which expires at the end of the window.
+// ARGV: limit, window_ms, n.
+var fixedWindowScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+local count = tonumber(redis.call("GET", KEYS[1]) or "0")
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+	ttl = window
+end
+if count + n > limit then
+	return {0, limit - count, ttl}
+end
+count = redis.call("INCRBY", KEYS[1], n)
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], window)
+end
+return {1, limit - count, 0}`)
+})
+
+// slidingLogScript stores the allowed requests of the last window in a sorted set, scored by their time.
+// ARGV: limit, window_ms, n, request_id.
+var slidingLogScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(serverTime + `
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+local count = redis.call("ZCARD", KEYS[1])
+if count + n > limit then
+	local retry = window
+	local index = count + n - limit - 1
+	local oldest = redis.call("ZRANGE", KEYS[1], index, index, "WITHSCORES")
+	if oldest[2] then
+		retry = tonumber(oldest[2]) + window - now
+	end
+	return {0, limit - count, retry}
+end
+for i = 1, n do
+	redis.call("ZADD", KEYS[1], now, ARGV[4] .. ":" .. i)
+end
+redis.call("PEXPIRE", KEYS[1], window)
+return {1, limit - count - n, 0}`)
+})
+
+// tokenBucketScript stores the number of tokens of the bucket and the time it was last refilled in a hash.
+// ARGV: capacity, rate, period_ms, n.
+var tokenBucketScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(serverTime + `
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2]) / tonumber(ARGV[3])
+local n = tonumber(ARGV[4])
+local state = redis.call("HMGET", KEYS[1], "tokens", "timestamp")
+local tokens = tonumber(state[1]) or capacity
+local timestamp = tonumber(state[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - timestamp) * rate)
+local allowed = 0
+local retry = 0
+if tokens >= n then
+	tokens = tokens - n
+	allowed = 1
+else
+	retry = math.ceil((n - tokens) / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "timestamp", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(capacity / rate))
+return {allowed, math.floor(tokens), retry}`)
+})
+
+// gcraScript stores the theoretical arrival time of the next request in a string.
+// ARGV: emission_interval_ms, delay_tolerance_ms, n.
+var gcraScript = sync.OnceValue(func() *options.Script {
+	return options.NewScript(serverTime + `
+local interval = tonumber(ARGV[1])
+local tolerance = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+local tat = math.max(tonumber(redis.call("GET", KEYS[1]) or now), now)
+local next_tat = tat + n * interval
+local allow_at = next_tat - tolerance
+if allow_at > now then
+	return {0, math.floor((tolerance - (tat - now)) / interval), math.ceil(allow_at - now)}
+end
+redis.call("SET", KEYS[1], tostring(next_tat), "PX", math.ceil(next_tat - now))
+return {1, math.floor((tolerance - (next_tat - now)) / interval), 0}`)
+})
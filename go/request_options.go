@@ -0,0 +1,103 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+)
+
+// RequestOption overrides the configuration of a client for the commands sent with a context. See [WithRequestOptions].
+type RequestOption func(options *requestOptions)
+
+type requestOptions struct {
+	// timeout is the request timeout of the commands, or zero for the request timeout of the client.
+	timeout time.Duration
+	// readFrom is the read strategy of the commands, or nil for the read strategy of the client.
+	readFrom *config.ReadFrom
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestTimeout overrides the request timeout of the client. Blocking commands keep the timeout derived from their
+// block timeout.
+//
+// Parameters:
+//
+//	timeout - The request timeout of the commands, which is rounded up to milliseconds.
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(options *requestOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithReadFrom overrides the read strategy of the client for the read-only commands. [config.Primary] sends them to the
+// primary, and the other strategies send them preferably to a replica, which falls back to the primary when no replica is
+// available. Commands with an explicit route are not affected.
+//
+// Parameters:
+//
+//	readFrom - The read strategy of the commands.
+func WithReadFrom(readFrom config.ReadFrom) RequestOption {
+	return func(options *requestOptions) {
+		options.readFrom = &readFrom
+	}
+}
+
+// WithRequestOptions returns a copy of the context which carries options overriding the configuration of the client, for
+// the commands sent with the context or with contexts derived from it. The options of a parent context are kept, unless
+// they are overridden. The options apply to commands, and not to batches, scripts and cluster scans.
+//
+// When the context is canceled or its deadline expires, the request is canceled in the client as well, and does not wait
+// for its response anymore. A command may still have been sent to and applied by the server.
+//
+// Parameters:
+//
+//	ctx - The parent context.
+//	options - The options of the commands, such as [WithRequestTimeout] or [WithReadFrom].
+//
+// Return value:
+//
+// A context carrying the options.
+//
+// Example:
+//
+//	ctx = glide.WithRequestOptions(ctx, glide.WithRequestTimeout(2*time.Second), glide.WithReadFrom(config.PreferReplica))
+//	value, err := client.Get(ctx, "key")
+func WithRequestOptions(ctx context.Context, options ...RequestOption) context.Context {
+	requestOptions, _ := ctx.Value(requestOptionsKey{}).(requestOptions)
+	for _, option := range options {
+		option(&requestOptions)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, requestOptions)
+}
+
+// getRequestOptions returns the request options carried by a context, or the zero options.
+func getRequestOptions(ctx context.Context) requestOptions {
+	options, _ := ctx.Value(requestOptionsKey{}).(requestOptions)
+	return options
+}
+
+// commandOptionsInfo converts the options to the options of the core, or returns nil when they override nothing.
+func (options requestOptions) commandOptionsInfo() *C.CommandOptionsInfo {
+	if options.timeout <= 0 && options.readFrom == nil {
+		return nil
+	}
+	info := &C.CommandOptionsInfo{}
+	if options.timeout > 0 {
+		milliseconds := (options.timeout + time.Millisecond - 1) / time.Millisecond
+		info.has_timeout = C._Bool(true)
+		info.timeout = C.uint32_t(min(milliseconds, math.MaxUint32))
+	}
+	if options.readFrom != nil {
+		info.has_read_from = C._Bool(true)
+		info.read_from_replica = C._Bool(*options.readFrom != config.Primary)
+	}
+	return info
+}
@@ -0,0 +1,57 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestOptions(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, requestOptions{}, getRequestOptions(ctx))
+	assert.Nil(t, getRequestOptions(ctx).commandOptionsInfo())
+
+	ctx = WithRequestOptions(ctx, WithRequestTimeout(2*time.Second))
+	assert.Equal(t, 2*time.Second, getRequestOptions(ctx).timeout)
+	assert.Nil(t, getRequestOptions(ctx).readFrom)
+
+	// the options of the parent context are kept, unless they are overridden
+	child := WithRequestOptions(ctx, WithReadFrom(config.PreferReplica))
+	assert.Equal(t, 2*time.Second, getRequestOptions(child).timeout)
+	require.NotNil(t, getRequestOptions(child).readFrom)
+	assert.Equal(t, config.PreferReplica, *getRequestOptions(child).readFrom)
+	child = WithRequestOptions(child, WithRequestTimeout(time.Second), WithReadFrom(config.Primary))
+	assert.Equal(t, time.Second, getRequestOptions(child).timeout)
+	assert.Equal(t, config.Primary, *getRequestOptions(child).readFrom)
+	assert.Nil(t, getRequestOptions(ctx).readFrom)
+
+	// derived contexts carry the options
+	derived, cancel := context.WithCancel(child)
+	defer cancel()
+	assert.Equal(t, getRequestOptions(child), getRequestOptions(derived))
+}
+
+func TestCommandOptionsInfo(t *testing.T) {
+	info := requestOptions{timeout: 1500 * time.Microsecond}.commandOptionsInfo()
+	require.NotNil(t, info)
+	assert.True(t, bool(info.has_timeout))
+	// the timeout is rounded up to milliseconds
+	assert.Equal(t, uint32(2), uint32(info.timeout))
+	assert.False(t, bool(info.has_read_from))
+
+	primary, replica, az := config.Primary, config.PreferReplica, config.AzAffinity
+	info = requestOptions{readFrom: &primary}.commandOptionsInfo()
+	assert.False(t, bool(info.has_timeout))
+	assert.True(t, bool(info.has_read_from))
+	assert.False(t, bool(info.read_from_replica))
+	info = requestOptions{readFrom: &replica}.commandOptionsInfo()
+	assert.True(t, bool(info.read_from_replica))
+	info = requestOptions{readFrom: &az}.commandOptionsInfo()
+	assert.True(t, bool(info.read_from_replica))
+}
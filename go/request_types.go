@@ -0,0 +1,227 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import "strings"
+
+// requestTypeNames maps the request types used by the client to the names of the commands they are sent as.
+var requestTypeNames = map[C.RequestType]string{
+	C.BitCount:             "BITCOUNT",
+	C.BitField:             "BITFIELD",
+	C.BitFieldReadOnly:     "BITFIELD_RO",
+	C.BitOp:                "BITOP",
+	C.BitPos:               "BITPOS",
+	C.GetBit:               "GETBIT",
+	C.SetBit:               "SETBIT",
+	C.ClientGetName:        "CLIENT GETNAME",
+	C.ClientId:             "CLIENT ID",
+	C.ClientSetName:        "CLIENT SETNAME",
+	C.Echo:                 "ECHO",
+	C.Ping:                 "PING",
+	C.Select:               "SELECT",
+	C.Copy:                 "COPY",
+	C.Del:                  "DEL",
+	C.Dump:                 "DUMP",
+	C.Exists:               "EXISTS",
+	C.Expire:               "EXPIRE",
+	C.ExpireAt:             "EXPIREAT",
+	C.ExpireTime:           "EXPIRETIME",
+	C.Move:                 "MOVE",
+	C.ObjectEncoding:       "OBJECT ENCODING",
+	C.ObjectFreq:           "OBJECT FREQ",
+	C.ObjectIdleTime:       "OBJECT IDLETIME",
+	C.ObjectRefCount:       "OBJECT REFCOUNT",
+	C.Persist:              "PERSIST",
+	C.PExpire:              "PEXPIRE",
+	C.PExpireAt:            "PEXPIREAT",
+	C.PExpireTime:          "PEXPIRETIME",
+	C.PTTL:                 "PTTL",
+	C.RandomKey:            "RANDOMKEY",
+	C.Rename:               "RENAME",
+	C.RenameNX:             "RENAMENX",
+	C.Restore:              "RESTORE",
+	C.Scan:                 "SCAN",
+	C.Sort:                 "SORT",
+	C.SortReadOnly:         "SORT_RO",
+	C.Touch:                "TOUCH",
+	C.TTL:                  "TTL",
+	C.Type:                 "TYPE",
+	C.Unlink:               "UNLINK",
+	C.Wait:                 "WAIT",
+	C.GeoAdd:               "GEOADD",
+	C.GeoDist:              "GEODIST",
+	C.GeoHash:              "GEOHASH",
+	C.GeoPos:               "GEOPOS",
+	C.GeoSearch:            "GEOSEARCH",
+	C.GeoSearchStore:       "GEOSEARCHSTORE",
+	C.HDel:                 "HDEL",
+	C.HExists:              "HEXISTS",
+	C.HGet:                 "HGET",
+	C.HGetAll:              "HGETALL",
+	C.HIncrBy:              "HINCRBY",
+	C.HIncrByFloat:         "HINCRBYFLOAT",
+	C.HKeys:                "HKEYS",
+	C.HLen:                 "HLEN",
+	C.HMGet:                "HMGET",
+	C.HRandField:           "HRANDFIELD",
+	C.HScan:                "HSCAN",
+	C.HSet:                 "HSET",
+	C.HSetNX:               "HSETNX",
+	C.HStrlen:              "HSTRLEN",
+	C.HVals:                "HVALS",
+	C.PfAdd:                "PFADD",
+	C.PfCount:              "PFCOUNT",
+	C.PfMerge:              "PFMERGE",
+	C.BLMove:               "BLMOVE",
+	C.BLMPop:               "BLMPOP",
+	C.BLPop:                "BLPOP",
+	C.BRPop:                "BRPOP",
+	C.LIndex:               "LINDEX",
+	C.LInsert:              "LINSERT",
+	C.LLen:                 "LLEN",
+	C.LMove:                "LMOVE",
+	C.LMPop:                "LMPOP",
+	C.LPop:                 "LPOP",
+	C.LPos:                 "LPOS",
+	C.LPush:                "LPUSH",
+	C.LPushX:               "LPUSHX",
+	C.LRange:               "LRANGE",
+	C.LRem:                 "LREM",
+	C.LSet:                 "LSET",
+	C.LTrim:                "LTRIM",
+	C.RPop:                 "RPOP",
+	C.RPush:                "RPUSH",
+	C.RPushX:               "RPUSHX",
+	C.Publish:              "PUBLISH",
+	C.PubSubChannels:       "PUBSUB CHANNELS",
+	C.PubSubNumPat:         "PUBSUB NUMPAT",
+	C.PubSubNumSub:         "PUBSUB NUMSUB",
+	C.PubSubShardChannels:  "PUBSUB SHARDCHANNELS",
+	C.PubSubShardNumSub:    "PUBSUB SHARDNUMSUB",
+	C.SPublish:             "SPUBLISH",
+	C.FCall:                "FCALL",
+	C.FCallReadOnly:        "FCALL_RO",
+	C.FunctionDelete:       "FUNCTION DELETE",
+	C.FunctionDump:         "FUNCTION DUMP",
+	C.FunctionFlush:        "FUNCTION FLUSH",
+	C.FunctionKill:         "FUNCTION KILL",
+	C.FunctionList:         "FUNCTION LIST",
+	C.FunctionLoad:         "FUNCTION LOAD",
+	C.FunctionRestore:      "FUNCTION RESTORE",
+	C.FunctionStats:        "FUNCTION STATS",
+	C.ScriptExists:         "SCRIPT EXISTS",
+	C.ScriptFlush:          "SCRIPT FLUSH",
+	C.ScriptKill:           "SCRIPT KILL",
+	C.ScriptShow:           "SCRIPT SHOW",
+	C.ConfigGet:            "CONFIG GET",
+	C.ConfigResetStat:      "CONFIG RESETSTAT",
+	C.ConfigRewrite:        "CONFIG REWRITE",
+	C.ConfigSet:            "CONFIG SET",
+	C.DBSize:               "DBSIZE",
+	C.FlushAll:             "FLUSHALL",
+	C.FlushDB:              "FLUSHDB",
+	C.Info:                 "INFO",
+	C.LastSave:             "LASTSAVE",
+	C.Lolwut:               "LOLWUT",
+	C.Time:                 "TIME",
+	C.SAdd:                 "SADD",
+	C.SCard:                "SCARD",
+	C.SDiff:                "SDIFF",
+	C.SDiffStore:           "SDIFFSTORE",
+	C.SInter:               "SINTER",
+	C.SInterCard:           "SINTERCARD",
+	C.SInterStore:          "SINTERSTORE",
+	C.SIsMember:            "SISMEMBER",
+	C.SMembers:             "SMEMBERS",
+	C.SMIsMember:           "SMISMEMBER",
+	C.SMove:                "SMOVE",
+	C.SPop:                 "SPOP",
+	C.SRandMember:          "SRANDMEMBER",
+	C.SRem:                 "SREM",
+	C.SScan:                "SSCAN",
+	C.SUnion:               "SUNION",
+	C.SUnionStore:          "SUNIONSTORE",
+	C.BZMPop:               "BZMPOP",
+	C.BZPopMax:             "BZPOPMAX",
+	C.BZPopMin:             "BZPOPMIN",
+	C.ZAdd:                 "ZADD",
+	C.ZCard:                "ZCARD",
+	C.ZCount:               "ZCOUNT",
+	C.ZDiff:                "ZDIFF",
+	C.ZDiffStore:           "ZDIFFSTORE",
+	C.ZIncrBy:              "ZINCRBY",
+	C.ZInter:               "ZINTER",
+	C.ZInterCard:           "ZINTERCARD",
+	C.ZInterStore:          "ZINTERSTORE",
+	C.ZLexCount:            "ZLEXCOUNT",
+	C.ZMPop:                "ZMPOP",
+	C.ZMScore:              "ZMSCORE",
+	C.ZPopMax:              "ZPOPMAX",
+	C.ZPopMin:              "ZPOPMIN",
+	C.ZRandMember:          "ZRANDMEMBER",
+	C.ZRange:               "ZRANGE",
+	C.ZRangeStore:          "ZRANGESTORE",
+	C.ZRank:                "ZRANK",
+	C.ZRem:                 "ZREM",
+	C.ZRemRangeByLex:       "ZREMRANGEBYLEX",
+	C.ZRemRangeByRank:      "ZREMRANGEBYRANK",
+	C.ZRemRangeByScore:     "ZREMRANGEBYSCORE",
+	C.ZRevRank:             "ZREVRANK",
+	C.ZScan:                "ZSCAN",
+	C.ZScore:               "ZSCORE",
+	C.ZUnion:               "ZUNION",
+	C.ZUnionStore:          "ZUNIONSTORE",
+	C.XAck:                 "XACK",
+	C.XAdd:                 "XADD",
+	C.XAutoClaim:           "XAUTOCLAIM",
+	C.XClaim:               "XCLAIM",
+	C.XDel:                 "XDEL",
+	C.XGroupCreate:         "XGROUP CREATE",
+	C.XGroupCreateConsumer: "XGROUP CREATECONSUMER",
+	C.XGroupDelConsumer:    "XGROUP DELCONSUMER",
+	C.XGroupDestroy:        "XGROUP DESTROY",
+	C.XGroupSetId:          "XGROUP SETID",
+	C.XInfoConsumers:       "XINFO CONSUMERS",
+	C.XInfoGroups:          "XINFO GROUPS",
+	C.XInfoStream:          "XINFO STREAM",
+	C.XLen:                 "XLEN",
+	C.XPending:             "XPENDING",
+	C.XRange:               "XRANGE",
+	C.XRead:                "XREAD",
+	C.XReadGroup:           "XREADGROUP",
+	C.XRevRange:            "XREVRANGE",
+	C.XTrim:                "XTRIM",
+	C.Append:               "APPEND",
+	C.Decr:                 "DECR",
+	C.DecrBy:               "DECRBY",
+	C.Get:                  "GET",
+	C.GetDel:               "GETDEL",
+	C.GetEx:                "GETEX",
+	C.GetRange:             "GETRANGE",
+	C.Incr:                 "INCR",
+	C.IncrBy:               "INCRBY",
+	C.IncrByFloat:          "INCRBYFLOAT",
+	C.LCS:                  "LCS",
+	C.MGet:                 "MGET",
+	C.MSet:                 "MSET",
+	C.MSetNX:               "MSETNX",
+	C.Set:                  "SET",
+	C.SetRange:             "SETRANGE",
+	C.Strlen:               "STRLEN",
+	C.UnWatch:              "UNWATCH",
+	C.Watch:                "WATCH",
+}
+
+// commandName returns the command name of the given request. The name of a custom command is taken from its arguments.
+func commandName(requestType C.RequestType, args []string) string {
+	if requestType == C.CustomCommand {
+		if len(args) == 0 {
+			return ""
+		}
+		return strings.ToUpper(args[0])
+	}
+	return requestTypeNames[requestType]
+}
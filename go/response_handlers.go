@@ -330,7 +330,7 @@ func (node arrayConverter[T]) convert(data any) (any, error) {
 // TODO: convert sets
 
 func handleAnyArrayOrNilResponse(response *C.struct_CommandResponse) ([]any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -352,26 +352,33 @@ func handleAnyArrayOrNilResponse(response *C.struct_CommandResponse) ([]any, err
 }
 
 func handleInterfaceResponse(response *C.struct_CommandResponse) (any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return parseInterface(response)
 }
 
 func handleStringResponse(response *C.struct_CommandResponse) (string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	res, err := convertCharArrayToString(response, false)
 	return res.Value(), err
 }
 
 func handleStringOrNilResponse(response *C.struct_CommandResponse) (models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertCharArrayToString(response, true)
 }
 
 func handleOkResponse(response *C.struct_CommandResponse) (string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
+
+	// a middleware may rewrite a simple string reply to a plain string
+	if response != nil && response.response_type == uint32(C.String) {
+		if value, err := parseString(response); err == nil && value == OK {
+			return OK, nil
+		}
+	}
 
 	typeErr := checkResponseType(response, C.Ok, false)
 	if typeErr != nil {
@@ -382,7 +389,7 @@ func handleOkResponse(response *C.struct_CommandResponse) (string, error) {
 }
 
 func handleOkOrStringOrNilResponse(response *C.struct_CommandResponse) (models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	if response.response_type == uint32(C.Ok) {
 		return models.CreateStringResult("OK"), nil
@@ -392,7 +399,7 @@ func handleOkOrStringOrNilResponse(response *C.struct_CommandResponse) (models.R
 }
 
 func handle2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -419,7 +426,7 @@ func handle2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string
 }
 
 func handle2DFloat64OrNullArrayResponse(response *C.struct_CommandResponse) ([][]float64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -446,13 +453,13 @@ func handle2DFloat64OrNullArrayResponse(response *C.struct_CommandResponse) ([][
 }
 
 func handleAnyResponse(response *C.struct_CommandResponse) (any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return parseInterface(response)
 }
 
 func handleLocationArrayResponse(response *C.struct_CommandResponse) ([]options.Location, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -491,25 +498,25 @@ func handleLocationArrayResponse(response *C.struct_CommandResponse) ([]options.
 }
 
 func handleStringOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertStringOrNilArray(response)
 }
 
 func handleStringArrayResponse(response *C.struct_CommandResponse) ([]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertStringArray(response, false)
 }
 
 func handleStringArrayOrNilResponse(response *C.struct_CommandResponse) ([]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertStringArray(response, true)
 }
 
 func handleIntResponse(response *C.struct_CommandResponse) (int64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Int, false)
 	if typeErr != nil {
@@ -520,7 +527,7 @@ func handleIntResponse(response *C.struct_CommandResponse) (int64, error) {
 }
 
 func handleIntOrNilResponse(response *C.struct_CommandResponse) (models.Result[int64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Int, true)
 	if typeErr != nil {
@@ -535,7 +542,7 @@ func handleIntOrNilResponse(response *C.struct_CommandResponse) (models.Result[i
 }
 
 func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -554,7 +561,7 @@ func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error)
 }
 
 func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[int64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -580,7 +587,7 @@ func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.R
 }
 
 func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Float, false)
 	if typeErr != nil {
@@ -591,7 +598,7 @@ func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
 }
 
 func handleFloatOrNilResponse(response *C.struct_CommandResponse) (models.Result[float64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Float, true)
 	if typeErr != nil {
@@ -605,7 +612,7 @@ func handleFloatOrNilResponse(response *C.struct_CommandResponse) (models.Result
 
 // elements in the array could be `null`, but array isn't
 func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[float64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -633,7 +640,7 @@ func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]models
 func handleLongAndDoubleOrNullResponse(
 	response *C.struct_CommandResponse,
 ) (models.Result[int64], models.Result[float64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -659,7 +666,7 @@ func handleLongAndDoubleOrNullResponse(
 }
 
 func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Bool, false)
 	if typeErr != nil {
@@ -670,7 +677,7 @@ func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
 }
 
 func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -689,7 +696,7 @@ func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error)
 }
 
 func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[string]float64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -716,7 +723,7 @@ func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[stri
 }
 
 func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[string]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -745,7 +752,7 @@ func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[st
 func handleStringToStringArrayMapOrNilResponse(
 	response *C.struct_CommandResponse,
 ) (map[string][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, true)
 	if typeErr != nil {
@@ -778,7 +785,7 @@ func handleStringToStringArrayMapOrNilResponse(
 }
 
 func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]struct{}, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Sets, false)
 	if typeErr != nil {
@@ -800,7 +807,7 @@ func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]str
 func handleKeyWithMemberAndScoreResponse(
 	response *C.struct_CommandResponse,
 ) (models.Result[models.KeyWithMemberAndScore], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	if response == nil || response.response_type == uint32(C.Null) {
 		return models.CreateNilKeyWithMemberAndScoreResult(), nil
@@ -826,7 +833,7 @@ func handleKeyWithMemberAndScoreResponse(
 func handleKeyWithArrayOfMembersAndScoresResponse(
 	response *C.struct_CommandResponse,
 ) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	if response.response_type == uint32(C.Null) {
 		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), nil
@@ -870,7 +877,7 @@ func handleKeyWithArrayOfMembersAndScoresResponse(
 }
 
 func handleMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]models.MemberAndScore, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -891,7 +898,7 @@ func handleMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]mo
 }
 
 func handleScanResponse(response *C.struct_CommandResponse) (string, []string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -915,7 +922,7 @@ func handleScanResponse(response *C.struct_CommandResponse) (string, []string, e
 }
 
 func handleMapOfArrayOfStringArrayResponse(response *C.struct_CommandResponse) (map[string][][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -947,7 +954,7 @@ func handleMapOfArrayOfStringArrayResponse(response *C.struct_CommandResponse) (
 }
 
 func handleXRangeResponse(response *C.struct_CommandResponse) ([]models.XRangeResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	if response.response_type == uint32(C.Null) {
 		return nil, nil
@@ -992,7 +999,7 @@ func handleXRangeResponse(response *C.struct_CommandResponse) ([]models.XRangeRe
 }
 
 func handleXRevRangeResponse(response *C.struct_CommandResponse) ([]models.XRangeResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	if response.response_type == uint32(C.Null) {
 		return nil, nil
@@ -1037,7 +1044,7 @@ func handleXRevRangeResponse(response *C.struct_CommandResponse) ([]models.XRang
 }
 
 func handleXAutoClaimResponse(response *C.struct_CommandResponse) (models.XAutoClaimResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	var null models.XAutoClaimResponse // default response
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1092,7 +1099,7 @@ func handleXAutoClaimResponse(response *C.struct_CommandResponse) (models.XAutoC
 }
 
 func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (models.XAutoClaimJustIdResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	var null models.XAutoClaimJustIdResponse // default response
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1141,7 +1148,7 @@ func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (models.
 }
 
 func handleXReadResponse(response *C.struct_CommandResponse) (map[string]map[string][][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	data, err := parseMap(response)
 	if err != nil {
 		return nil, err
@@ -1175,7 +1182,7 @@ func handleXReadResponse(response *C.struct_CommandResponse) (map[string]map[str
 }
 
 func handleXReadGroupResponse(response *C.struct_CommandResponse) (map[string]map[string][][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	data, err := parseMap(response)
 	if err != nil {
 		return nil, err
@@ -1209,7 +1216,7 @@ func handleXReadGroupResponse(response *C.struct_CommandResponse) (map[string]ma
 }
 
 func handleXPendingSummaryResponse(response *C.struct_CommandResponse) (models.XPendingSummary, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -1261,7 +1268,7 @@ func handleXPendingSummaryResponse(response *C.struct_CommandResponse) (models.X
 func handleXPendingDetailResponse(response *C.struct_CommandResponse) ([]models.XPendingDetail, error) {
 	// response should be [][]any
 
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	// TODO: Not sure if this is correct for a nill response
 	if response == nil || response.response_type == uint32(C.Null) {
@@ -1305,7 +1312,7 @@ func handleXPendingDetailResponse(response *C.struct_CommandResponse) ([]models.
 }
 
 func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]models.XInfoConsumerInfo, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1348,7 +1355,7 @@ func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]models.
 }
 
 func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]models.XInfoGroupInfo, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1398,7 +1405,7 @@ func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]models.XIn
 }
 
 func handleStringToAnyMapResponse(response *C.struct_CommandResponse) (map[string]any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -1412,7 +1419,7 @@ func handleStringToAnyMapResponse(response *C.struct_CommandResponse) (map[strin
 }
 
 func handleRawStringArrayMapResponse(response *C.struct_CommandResponse) (map[string][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1439,7 +1446,7 @@ func handleRawStringArrayMapResponse(response *C.struct_CommandResponse) (map[st
 }
 
 func handleMapOfStringMapResponse(response *C.struct_CommandResponse) (map[string]map[string]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1488,7 +1495,7 @@ func handleTimeClusterResponse(response *C.struct_CommandResponse) (models.Clust
 }
 
 func handleStringIntMapResponse(response *C.struct_CommandResponse) (map[string]int64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -1652,7 +1659,7 @@ func handleFunctionListMultiNodeResponse(response *C.struct_CommandResponse) (ma
 }
 
 func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, reverse bool) ([]models.MemberAndScore, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -0,0 +1,145 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+)
+
+// retryBudgetWindow is the number of seconds of the sliding window of the retry budget.
+const retryBudgetWindow = 10
+
+// commandRetries retries the commands of a client according to its retry policy. It is shared by the client and its
+// views.
+type commandRetries struct {
+	policy *config.RetryPolicy
+	budget retryBudget
+}
+
+func newCommandRetries(policy *config.RetryPolicy) *commandRetries {
+	if policy == nil {
+		return nil
+	}
+	ratio, minPerSecond := policy.GetBudget()
+	return &commandRetries{policy: policy, budget: retryBudget{ratio: ratio, minPerSecond: minPerSecond}}
+}
+
+// retryBudget limits the retries within a sliding window to a ratio of the commands eligible to retries, in addition to a
+// minimum number of retries per second.
+type retryBudget struct {
+	ratio        float64
+	minPerSecond int
+
+	mu sync.Mutex
+	// commands and retries count the commands eligible to retries and their retries, per second of the window.
+	commands [retryBudgetWindow]int64
+	retries  [retryBudgetWindow]int64
+	// second is the Unix time of the newest second of the window.
+	second int64
+}
+
+// advance moves the window to the given time, clearing the seconds which left it. It must be called while holding the
+// lock, and returns the index of the current second.
+func (budget *retryBudget) advance(now time.Time) int {
+	second := now.Unix()
+	if second-budget.second >= retryBudgetWindow {
+		budget.commands = [retryBudgetWindow]int64{}
+		budget.retries = [retryBudgetWindow]int64{}
+	} else {
+		for elapsed := budget.second + 1; elapsed <= second; elapsed++ {
+			budget.commands[elapsed%retryBudgetWindow] = 0
+			budget.retries[elapsed%retryBudgetWindow] = 0
+		}
+	}
+	budget.second = max(budget.second, second)
+	return int(second % retryBudgetWindow)
+}
+
+// deposit records a command eligible to retries.
+func (budget *retryBudget) deposit(now time.Time) {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	budget.commands[budget.advance(now)]++
+}
+
+// withdraw records a retry, and reports whether the budget allows it.
+func (budget *retryBudget) withdraw(now time.Time) bool {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	index := budget.advance(now)
+	var commands, retries int64
+	for i := range retryBudgetWindow {
+		commands += budget.commands[i]
+		retries += budget.retries[i]
+	}
+	if float64(retries+1) > budget.ratio*float64(commands)+float64(budget.minPerSecond*retryBudgetWindow) {
+		return false
+	}
+	budget.retries[index]++
+	return true
+}
+
+// transientError reports whether a command failed with an error which may not occur again when it is retried: a timeout,
+// a disconnection, or a LOADING or TRYAGAIN error of the server.
+func transientError(err error) bool {
+	switch err := err.(type) {
+	case *errors.TimeoutError, *errors.DisconnectError, *errors.ConnectionError:
+		return true
+	case *errors.RequestError:
+		// the errors of the server are described by their kind, as in "... - BusyLoadingError: ..."
+		return strings.Contains(err.Msg, " - BusyLoadingError:") || strings.Contains(err.Msg, " - TryAgain:") ||
+			strings.HasPrefix(err.Msg, "LOADING ") || strings.HasPrefix(err.Msg, "TRYAGAIN ")
+	}
+	return false
+}
+
+// dispatchCommand sends a command, retrying it according to the retry policy of the client, and failing it fast when the
+// circuit breaker of its node is open. The commands of views bound to the dedicated connection of a transaction are sent
+// once.
+func (client *baseClient) dispatchCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if (client.retries == nil && client.breakers == nil) || client.requestTimeout > 0 {
+		return client.sendCommand(ctx, requestType, args, route)
+	}
+	retries := client.retries != nil && client.retries.policy.Retries(&config.CommandRequest{
+		Name:   commandName(requestType, args),
+		Args:   args,
+		Route:  route,
+		Custom: requestType == C.CustomCommand,
+	})
+	if retries {
+		client.retries.budget.deposit(time.Now())
+	}
+	for attempt := 1; ; attempt++ {
+		breaker := client.breakers.forCommand(ctx, requestType, args, route)
+		if err := breaker.allow(time.Now()); err != nil {
+			return nil, err
+		}
+		response, err := client.sendCommand(ctx, requestType, args, route)
+		breaker.record(err, time.Now())
+		if err == nil || !retries || !transientError(err) || attempt >= client.retries.policy.GetMaxAttempts() ||
+			!client.retries.budget.withdraw(time.Now()) {
+			return response, err
+		}
+		timer := time.NewTimer(client.retries.policy.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
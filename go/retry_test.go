@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBudget(t *testing.T) {
+	retries := newCommandRetries(config.NewRetryPolicy().WithBudget(0.5, 0))
+	budget := &retries.budget
+	now := time.Unix(1000, 0)
+	assert.False(t, budget.withdraw(now))
+
+	for range 4 {
+		budget.deposit(now)
+	}
+	// the retries are limited to half of the commands
+	assert.True(t, budget.withdraw(now))
+	assert.True(t, budget.withdraw(now.Add(time.Second)))
+	assert.False(t, budget.withdraw(now.Add(time.Second)))
+
+	// the commands and retries leave the window after 10 seconds
+	budget.deposit(now.Add(10 * time.Second))
+	budget.deposit(now.Add(10 * time.Second))
+	assert.False(t, budget.withdraw(now.Add(10*time.Second)))
+	assert.True(t, budget.withdraw(now.Add(11*time.Second)))
+	assert.False(t, budget.withdraw(now.Add(11*time.Second)))
+	assert.False(t, budget.withdraw(now.Add(30*time.Second)))
+
+	// the minimum number of retries per second applies without commands
+	minimum := newCommandRetries(config.NewRetryPolicy().WithBudget(0, 1))
+	for range retryBudgetWindow {
+		assert.True(t, minimum.budget.withdraw(now))
+	}
+	assert.False(t, minimum.budget.withdraw(now))
+}
+
+func TestTransientError(t *testing.T) {
+	assert.True(t, transientError(errors.GoError(2, "Request timed out")))
+	assert.True(t, transientError(errors.GoError(3, "Received connection error")))
+	assert.True(t, transientError(&errors.ConnectionError{Msg: "Connection refused"}))
+	assert.True(t, transientError(&errors.RequestError{
+		Msg: "An error was signalled by the server - BusyLoadingError: Valkey is loading the dataset in memory",
+	}))
+	assert.True(t, transientError(&errors.RequestError{Msg: "An error was signalled by the server - TryAgain: Multiple keys"}))
+	assert.True(t, transientError(&errors.RequestError{Msg: "LOADING Valkey is loading the dataset in memory"}))
+
+	assert.False(t, transientError(nil))
+	assert.False(t, transientError(context.Canceled))
+	assert.False(t, transientError(&errors.ClosingError{Msg: "The client is closed."}))
+	assert.False(t, transientError(&errors.RequestError{
+		Msg: "An error was signalled by the server - ResponseError: WRONGTYPE Operation against a key",
+	}))
+}
@@ -0,0 +1,352 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// switchMasterChannel is the channel on which the sentinels announce that a master was failed over.
+const switchMasterChannel = "+switch-master"
+
+// sentinelRetryDelay is the delay after which a failover which could not be followed is attempted again.
+const sentinelRetryDelay = time.Second
+
+// sentinelMonitor discovers the primary and the replicas of a client from the sentinels, and switches the connection of
+// the client to the new primary when the sentinels announce a failover, or when a periodic check finds that the primary
+// changed.
+type sentinelMonitor struct {
+	sentinel *config.SentinelConfiguration
+	useTLS   bool
+	// announced is signaled when a sentinel announces a failover, and done is closed when the monitor is closed.
+	announced chan struct{}
+	done      chan struct{}
+
+	mu sync.Mutex
+	// current is the index of the sentinel which announces the failovers to the monitor.
+	current int
+	// subscriber is the client connected to the current sentinel, or nil if it is not connected.
+	subscriber *Client
+	// client is the client whose connection is switched on failovers.
+	client  *baseClient
+	request *protobuf.ConnectionRequest
+	closed  bool
+}
+
+// createSentinelClient creates a client connected to the primary and the replicas discovered from the sentinels of the
+// configuration.
+func createSentinelClient(config *config.ClientConfiguration) (*baseClient, error) {
+	request, err := config.ToProtobuf()
+	if err != nil {
+		return nil, err
+	}
+	monitor := &sentinelMonitor{
+		sentinel:  config.GetSentinel(),
+		useTLS:    request.TlsMode != protobuf.TlsMode_NoTls,
+		announced: make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	addresses, err := monitor.discover(context.Background())
+	if err != nil {
+		monitor.close()
+		return nil, err
+	}
+	request.Addresses = addresses
+
+	client, err := createClientWithRequest(config, request)
+	if err != nil {
+		monitor.close()
+		return nil, err
+	}
+	monitor.mu.Lock()
+	monitor.client = client
+	monitor.request = request
+	monitor.mu.Unlock()
+	client.sentinel = monitor
+	go monitor.watch(monitor.sentinel.GetCheckInterval(), monitor.failover)
+	return client, nil
+}
+
+// discover returns the addresses of the primary and the replicas of the master, querying the sentinels in order until
+// one of them responds. The primary is the first address.
+func (monitor *sentinelMonitor) discover(ctx context.Context) ([]*protobuf.NodeAddress, error) {
+	addresses := monitor.sentinel.GetAddresses()
+	if len(addresses) == 0 {
+		return nil, &errors.ConfigurationError{Msg: "The sentinel configuration has no addresses"}
+	}
+
+	var lastErr error
+	for range addresses {
+		subscriber, err := monitor.connectSubscriber()
+		if err == nil {
+			var discovered []*protobuf.NodeAddress
+			if discovered, err = monitor.query(ctx, subscriber); err == nil {
+				return discovered, nil
+			}
+		}
+		lastErr = err
+		monitor.rotate(subscriber)
+	}
+	return nil, &errors.ConnectionError{
+		Msg: fmt.Sprintf("Failed to discover the master %s from the sentinels: %v", monitor.sentinel.GetMasterName(), lastErr),
+	}
+}
+
+// connectSubscriber returns the client of the current sentinel, connecting to it if needed.
+func (monitor *sentinelMonitor) connectSubscriber() (*Client, error) {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	if monitor.closed {
+		return nil, &errors.ClosingError{Msg: "The client is closed."}
+	}
+	if monitor.subscriber != nil {
+		return monitor.subscriber, nil
+	}
+
+	address := monitor.sentinel.GetAddresses()[monitor.current]
+	subscriptions := config.NewStandaloneSubscriptionConfig().
+		WithSubscription(config.ExactChannelMode, switchMasterChannel).
+		WithCallback(monitor.onMessage, nil)
+	sentinelConfig := config.NewClientConfiguration().
+		WithAddress(&address).
+		WithUseTLS(monitor.useTLS).
+		WithSubscriptionConfig(subscriptions)
+	if credentials := monitor.sentinel.GetCredentials(); credentials != nil {
+		sentinelConfig.WithCredentials(credentials)
+	}
+	subscriber, err := NewClient(sentinelConfig)
+	if err != nil {
+		return nil, err
+	}
+	monitor.subscriber = subscriber
+	return subscriber, nil
+}
+
+// rotate closes the client of a sentinel which failed to respond, so that the next sentinel is queried.
+func (monitor *sentinelMonitor) rotate(failed *Client) {
+	monitor.mu.Lock()
+	if failed == nil || monitor.subscriber == failed {
+		monitor.subscriber = nil
+		monitor.current = (monitor.current + 1) % len(monitor.sentinel.GetAddresses())
+	}
+	monitor.mu.Unlock()
+	if failed != nil {
+		failed.Close()
+	}
+}
+
+// query returns the addresses of the primary and of the replicas which are not down, as reported by a sentinel.
+func (monitor *sentinelMonitor) query(ctx context.Context, sentinel *Client) ([]*protobuf.NodeAddress, error) {
+	name := monitor.sentinel.GetMasterName()
+	master, err := sentinel.CustomCommand(ctx, []string{"SENTINEL", "GET-MASTER-ADDR-BY-NAME", name})
+	if err != nil {
+		return nil, err
+	}
+	hostAndPort, ok := master.([]any)
+	if !ok || len(hostAndPort) != 2 {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("The sentinel does not monitor the master %s", name)}
+	}
+	primary, err := nodeAddress(hostAndPort[0], hostAndPort[1])
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := sentinel.CustomCommand(ctx, []string{"SENTINEL", "REPLICAS", name})
+	if err != nil {
+		return nil, err
+	}
+	addresses := []*protobuf.NodeAddress{primary}
+	entries, _ := replicas.([]any)
+	for _, entry := range entries {
+		fields := replicaFields(entry)
+		if isDown(fields["flags"]) {
+			continue
+		}
+		if replica, err := nodeAddress(fields["ip"], fields["port"]); err == nil {
+			addresses = append(addresses, replica)
+		}
+	}
+	return addresses, nil
+}
+
+// replicaFields returns the fields of a replica reported by SENTINEL REPLICAS, which is a map with RESP3 and a flat list
+// of fields and values with RESP2.
+func replicaFields(entry any) map[string]any {
+	switch entry := entry.(type) {
+	case map[string]any:
+		return entry
+	case []any:
+		fields := make(map[string]any, len(entry)/2)
+		for i := 0; i+1 < len(entry); i += 2 {
+			if field, ok := entry[i].(string); ok {
+				fields[field] = entry[i+1]
+			}
+		}
+		return fields
+	}
+	return nil
+}
+
+// isDown returns whether the flags of a replica report that it is not reachable.
+func isDown(flags any) bool {
+	value, _ := flags.(string)
+	for _, flag := range strings.Split(value, ",") {
+		if flag == "s_down" || flag == "o_down" || flag == "disconnected" {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeAddress(host any, port any) (*protobuf.NodeAddress, error) {
+	hostValue, _ := host.(string)
+	portValue, _ := port.(string)
+	parsed, err := strconv.ParseUint(portValue, 10, 16)
+	if hostValue == "" || err != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("Invalid address reported by the sentinel: %v:%v", host, port)}
+	}
+	return &protobuf.NodeAddress{Host: hostValue, Port: uint32(parsed)}, nil
+}
+
+// onMessage signals the failover of the master announced by a sentinel, with a message of the form
+// "<master name> <old ip> <old port> <new ip> <new port>".
+func (monitor *sentinelMonitor) onMessage(message *models.PubSubMessage, _ any) {
+	fields := strings.Fields(message.Message)
+	if len(fields) != 5 || fields[0] != monitor.sentinel.GetMasterName() {
+		return
+	}
+	select {
+	case monitor.announced <- struct{}{}:
+	default:
+		// a failover is already pending
+	}
+}
+
+// watch follows the announced failovers, and checks the primary at every interval unless it is zero, until the monitor
+// is closed. The failovers are followed on this goroutine, so that they do not run concurrently, nor block the callback of
+// the subscriber, which a failover may close. A failover which could not be followed is attempted again after a delay.
+func (monitor *sentinelMonitor) watch(interval time.Duration, failover func() error) {
+	timer := time.NewTimer(interval)
+	if interval <= 0 && !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+	for {
+		select {
+		case <-monitor.done:
+			return
+		case <-monitor.announced:
+		case <-timer.C:
+		}
+		delay := interval
+		if err := failover(); err != nil {
+			log.Printf("Failed to follow the failover of the master %s: %v\n", monitor.sentinel.GetMasterName(), err)
+			delay = sentinelRetryDelay
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if delay > 0 {
+			timer.Reset(delay)
+		}
+	}
+}
+
+// failover connects the client to the primary and the replicas currently reported by the sentinels, if the primary
+// changed.
+func (monitor *sentinelMonitor) failover() error {
+	monitor.mu.Lock()
+	client := monitor.client
+	monitor.mu.Unlock()
+	if client == nil {
+		// the client is still being created, and connects to the addresses discovered after the failover
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	addresses, err := monitor.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	if monitor.closed || samePrimary(monitor.request.Addresses, addresses) {
+		return nil
+	}
+	request := proto.Clone(monitor.request).(*protobuf.ConnectionRequest)
+	request.Addresses = addresses
+	replacement, err := connect(request)
+	if err != nil {
+		return err
+	}
+	monitor.request = request
+	client.switchConnection(replacement, request.RequestTimeout)
+	if client.blocking != nil {
+		client.blocking.reset(addresses)
+	}
+	return nil
+}
+
+// samePrimary returns whether two lists of addresses, as returned by discover, have the same primary.
+func samePrimary(current []*protobuf.NodeAddress, discovered []*protobuf.NodeAddress) bool {
+	return len(current) > 0 && len(discovered) > 0 &&
+		current[0].Host == discovered[0].Host && current[0].Port == discovered[0].Port
+}
+
+// close closes the connection to the sentinel, after which failovers are no longer followed.
+func (monitor *sentinelMonitor) close() {
+	monitor.mu.Lock()
+	subscriber := monitor.subscriber
+	monitor.subscriber = nil
+	if !monitor.closed {
+		close(monitor.done)
+	}
+	monitor.closed = true
+	monitor.mu.Unlock()
+	if subscriber != nil {
+		subscriber.Close()
+	}
+}
+
+// switchConnection replaces the core connection of the client with the core connection of the replacement. The commands
+// in progress on the previous core connection may still complete, and it is closed once they timed out.
+func (client *baseClient) switchConnection(replacement *clientConnection, requestTimeoutMs uint32) {
+	client.mu.Lock()
+	previous := client.coreClient
+	if previous == nil {
+		client.mu.Unlock()
+		replacement.Close()
+		return
+	}
+	client.coreClient = replacement.coreClient
+	unregisterClient(uintptr(previous))
+	registerClient(client, uintptr(client.coreClient))
+	client.mu.Unlock()
+	client.lifecycle.reset()
+
+	grace := 2 * defaultRequestTimeout
+	if requestTimeoutMs != 0 {
+		grace = 2 * time.Duration(requestTimeoutMs) * time.Millisecond
+	}
+	time.AfterFunc(grace, func() { C.close_client(previous) })
+}
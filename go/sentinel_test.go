@@ -0,0 +1,117 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/protobuf"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaFields(t *testing.T) {
+	resp3 := map[string]any{"ip": "10.0.0.2", "port": "6380", "flags": "slave"}
+	assert.Equal(t, resp3, replicaFields(resp3))
+
+	resp2 := []any{"name", "10.0.0.2:6380", "ip", "10.0.0.2", "port", "6380", "flags", "slave,s_down"}
+	fields := replicaFields(resp2)
+	assert.Equal(t, "10.0.0.2", fields["ip"])
+	assert.Equal(t, "6380", fields["port"])
+	assert.True(t, isDown(fields["flags"]))
+
+	assert.False(t, isDown("slave"))
+	assert.True(t, isDown("slave,disconnected"))
+	assert.Nil(t, replicaFields("unexpected"))
+}
+
+func TestNodeAddress(t *testing.T) {
+	address, err := nodeAddress("10.0.0.1", "6379")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", address.Host)
+	assert.Equal(t, uint32(6379), address.Port)
+
+	for _, invalid := range [][2]any{{"", "6379"}, {"10.0.0.1", "port"}, {"10.0.0.1", nil}, {nil, "6379"}} {
+		_, err := nodeAddress(invalid[0], invalid[1])
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestBlockingPoolReset(t *testing.T) {
+	pool := newBlockingPool(&protobuf.ConnectionRequest{Addresses: []*protobuf.NodeAddress{{Host: "old", Port: 6379}}}, 1)
+	previous := pool.request
+	active := &clientConnection{}
+	pool.active[active] = false
+
+	pool.reset([]*protobuf.NodeAddress{{Host: "new", Port: 6379}})
+	assert.Equal(t, "new", pool.request.Addresses[0].Host)
+	assert.Equal(t, "old", previous.Addresses[0].Host)
+	// connections opened with the previous addresses are not reused
+	assert.True(t, pool.active[active])
+}
+
+func TestSamePrimary(t *testing.T) {
+	current := []*protobuf.NodeAddress{{Host: "10.0.0.1", Port: 6379}, {Host: "10.0.0.2", Port: 6379}}
+	assert.True(t, samePrimary(current, []*protobuf.NodeAddress{{Host: "10.0.0.1", Port: 6379}}))
+	assert.False(t, samePrimary(current, []*protobuf.NodeAddress{{Host: "10.0.0.2", Port: 6379}}))
+	assert.False(t, samePrimary(current, []*protobuf.NodeAddress{{Host: "10.0.0.1", Port: 6380}}))
+	assert.False(t, samePrimary(nil, current))
+}
+
+func newTestMonitor() *sentinelMonitor {
+	return &sentinelMonitor{
+		sentinel:  config.NewSentinelConfiguration("primary"),
+		announced: make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+func TestSentinelWatchRetries(t *testing.T) {
+	monitor := newTestMonitor()
+	defer monitor.close()
+	var calls atomic.Int32
+	go monitor.watch(0, func() error {
+		if calls.Add(1) == 1 {
+			return errors.New("the new primary is not reachable yet")
+		}
+		return nil
+	})
+
+	// messages about other masters are ignored
+	monitor.onMessage(models.NewPubSubMessage("other 10.0.0.1 6379 10.0.0.2 6379", switchMasterChannel), nil)
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, calls.Load())
+
+	// an announced failover which could not be followed is attempted again
+	monitor.onMessage(models.NewPubSubMessage("primary 10.0.0.1 6379 10.0.0.2 6379", switchMasterChannel), nil)
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, 2*sentinelRetryDelay, 10*time.Millisecond)
+	time.Sleep(sentinelRetryDelay + 100*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestSentinelWatchChecks(t *testing.T) {
+	monitor := newTestMonitor()
+	var calls atomic.Int32
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		monitor.watch(10*time.Millisecond, func() error {
+			calls.Add(1)
+			return nil
+		})
+	}()
+
+	// the primary is checked periodically, without announcements
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, 10*time.Millisecond)
+	monitor.close()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("the monitor was not stopped by close")
+	}
+}
@@ -0,0 +1,2112 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"context"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+)
+
+// The writes below are mirrored to the shadow client, and the sampled reads of a key are compared with the shadow client.
+// The blocking writes are only sent to the authoritative client, and their effect is mirrored with a non-blocking
+// command. The other commands are only sent to the authoritative client.
+
+func (client *Client) Set(ctx context.Context, key string, value string) (string, error) {
+	return execute(client, ctx, "SET", key, func(ctx context.Context, target backend) (string, error) {
+		return target.Set(ctx, key, value)
+	})
+}
+
+func (client *Client) SetWithOptions(
+	ctx context.Context,
+	key string,
+	value string,
+	options options.SetOptions,
+) (models.Result[string], error) {
+	return execute(client, ctx, "SET", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.SetWithOptions(ctx, key, value, options)
+	})
+}
+
+func (client *Client) Get(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "GET", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.Get(ctx, key)
+	})
+}
+
+func (client *Client) GetEx(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "GETEX", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.GetEx(ctx, key)
+	})
+}
+
+func (client *Client) GetExWithOptions(
+	ctx context.Context,
+	key string,
+	options options.GetExOptions,
+) (models.Result[string], error) {
+	return execute(client, ctx, "GETEX", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.GetExWithOptions(ctx, key, options)
+	})
+}
+
+func (client *Client) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	return execute(client, ctx, "MSET", "", func(ctx context.Context, target backend) (string, error) {
+		return target.MSet(ctx, keyValueMap)
+	})
+}
+
+func (client *Client) MGet(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	return execute(
+		client, ctx, "MGET", firstKey(keys),
+		func(ctx context.Context, target backend) ([]models.Result[string], error) {
+			return target.MGet(ctx, keys)
+		},
+	)
+}
+
+func (client *Client) MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error) {
+	return execute(client, ctx, "MSETNX", "", func(ctx context.Context, target backend) (bool, error) {
+		return target.MSetNX(ctx, keyValueMap)
+	})
+}
+
+func (client *Client) Incr(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "INCR", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.Incr(ctx, key)
+	})
+}
+
+func (client *Client) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	return execute(client, ctx, "INCRBY", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.IncrBy(ctx, key, amount)
+	})
+}
+
+func (client *Client) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
+	return execute(client, ctx, "INCRBYFLOAT", key, func(ctx context.Context, target backend) (float64, error) {
+		return target.IncrByFloat(ctx, key, amount)
+	})
+}
+
+func (client *Client) Decr(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "DECR", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.Decr(ctx, key)
+	})
+}
+
+func (client *Client) DecrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	return execute(client, ctx, "DECRBY", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.DecrBy(ctx, key, amount)
+	})
+}
+
+func (client *Client) Strlen(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "STRLEN", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.Strlen(ctx, key)
+	})
+}
+
+func (client *Client) SetRange(ctx context.Context, key string, offset int, value string) (int64, error) {
+	return execute(client, ctx, "SETRANGE", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SetRange(ctx, key, offset, value)
+	})
+}
+
+func (client *Client) GetRange(ctx context.Context, key string, start int, end int) (string, error) {
+	return execute(client, ctx, "GETRANGE", key, func(ctx context.Context, target backend) (string, error) {
+		return target.GetRange(ctx, key, start, end)
+	})
+}
+
+func (client *Client) Append(ctx context.Context, key string, value string) (int64, error) {
+	return execute(client, ctx, "APPEND", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.Append(ctx, key, value)
+	})
+}
+
+func (client *Client) LCS(ctx context.Context, key1 string, key2 string) (string, error) {
+	return execute(client, ctx, "LCS", key1, func(ctx context.Context, target backend) (string, error) {
+		return target.LCS(ctx, key1, key2)
+	})
+}
+
+func (client *Client) LCSLen(ctx context.Context, key1 string, key2 string) (int64, error) {
+	return execute(client, ctx, "LCS", key1, func(ctx context.Context, target backend) (int64, error) {
+		return target.LCSLen(ctx, key1, key2)
+	})
+}
+
+func (client *Client) LCSWithOptions(
+	ctx context.Context,
+	key1, key2 string,
+	opts options.LCSIdxOptions,
+) (map[string]any, error) {
+	return execute(client, ctx, "LCS", key1, func(ctx context.Context, target backend) (map[string]any, error) {
+		return target.LCSWithOptions(ctx, key1, key2, opts)
+	})
+}
+
+func (client *Client) GetDel(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "GETDEL", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.GetDel(ctx, key)
+	})
+}
+
+func (client *Client) HGet(ctx context.Context, key string, field string) (models.Result[string], error) {
+	return execute(client, ctx, "HGET", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.HGet(ctx, key, field)
+	})
+}
+
+func (client *Client) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return execute(client, ctx, "HGETALL", key, func(ctx context.Context, target backend) (map[string]string, error) {
+		return target.HGetAll(ctx, key)
+	})
+}
+
+func (client *Client) HMGet(ctx context.Context, key string, fields []string) ([]models.Result[string], error) {
+	return execute(client, ctx, "HMGET", key, func(ctx context.Context, target backend) ([]models.Result[string], error) {
+		return target.HMGet(ctx, key, fields)
+	})
+}
+
+func (client *Client) HSet(ctx context.Context, key string, values map[string]string) (int64, error) {
+	return execute(client, ctx, "HSET", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.HSet(ctx, key, values)
+	})
+}
+
+func (client *Client) HSetNX(ctx context.Context, key string, field string, value string) (bool, error) {
+	return execute(client, ctx, "HSETNX", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.HSetNX(ctx, key, field, value)
+	})
+}
+
+func (client *Client) HDel(ctx context.Context, key string, fields []string) (int64, error) {
+	return execute(client, ctx, "HDEL", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.HDel(ctx, key, fields)
+	})
+}
+
+func (client *Client) HLen(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "HLEN", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.HLen(ctx, key)
+	})
+}
+
+func (client *Client) HVals(ctx context.Context, key string) ([]string, error) {
+	return execute(client, ctx, "HVALS", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.HVals(ctx, key)
+	})
+}
+
+func (client *Client) HExists(ctx context.Context, key string, field string) (bool, error) {
+	return execute(client, ctx, "HEXISTS", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.HExists(ctx, key, field)
+	})
+}
+
+func (client *Client) HKeys(ctx context.Context, key string) ([]string, error) {
+	return execute(client, ctx, "HKEYS", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.HKeys(ctx, key)
+	})
+}
+
+func (client *Client) HStrLen(ctx context.Context, key string, field string) (int64, error) {
+	return execute(client, ctx, "HSTRLEN", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.HStrLen(ctx, key, field)
+	})
+}
+
+func (client *Client) HIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error) {
+	return execute(client, ctx, "HINCRBY", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.HIncrBy(ctx, key, field, increment)
+	})
+}
+
+func (client *Client) HIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error) {
+	return execute(client, ctx, "HINCRBYFLOAT", key, func(ctx context.Context, target backend) (float64, error) {
+		return target.HIncrByFloat(ctx, key, field, increment)
+	})
+}
+
+func (client *Client) HScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.authoritative.HScan(ctx, key, cursor)
+}
+
+func (client *Client) HRandField(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "HRANDFIELD", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.HRandField(ctx, key)
+	})
+}
+
+func (client *Client) HRandFieldWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return execute(client, ctx, "HRANDFIELD", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.HRandFieldWithCount(ctx, key, count)
+	})
+}
+
+func (client *Client) HRandFieldWithCountWithValues(ctx context.Context, key string, count int64) ([][]string, error) {
+	return execute(client, ctx, "HRANDFIELD", key, func(ctx context.Context, target backend) ([][]string, error) {
+		return target.HRandFieldWithCountWithValues(ctx, key, count)
+	})
+}
+
+func (client *Client) HScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.HashScanOptions,
+) (string, []string, error) {
+	return client.authoritative.HScanWithOptions(ctx, key, cursor, options)
+}
+
+func (client *Client) LPush(ctx context.Context, key string, elements []string) (int64, error) {
+	return execute(client, ctx, "LPUSH", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.LPush(ctx, key, elements)
+	})
+}
+
+func (client *Client) LPop(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "LPOP", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.LPop(ctx, key)
+	})
+}
+
+func (client *Client) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return execute(client, ctx, "LPOP", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.LPopCount(ctx, key, count)
+	})
+}
+
+func (client *Client) LPos(ctx context.Context, key string, element string) (models.Result[int64], error) {
+	return execute(client, ctx, "LPOS", key, func(ctx context.Context, target backend) (models.Result[int64], error) {
+		return target.LPos(ctx, key, element)
+	})
+}
+
+func (client *Client) LPosWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	options options.LPosOptions,
+) (models.Result[int64], error) {
+	return execute(client, ctx, "LPOS", key, func(ctx context.Context, target backend) (models.Result[int64], error) {
+		return target.LPosWithOptions(ctx, key, element, options)
+	})
+}
+
+func (client *Client) LPosCount(ctx context.Context, key string, element string, count int64) ([]int64, error) {
+	return execute(client, ctx, "LPOS", key, func(ctx context.Context, target backend) ([]int64, error) {
+		return target.LPosCount(ctx, key, element, count)
+	})
+}
+
+func (client *Client) LPosCountWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	count int64,
+	options options.LPosOptions,
+) ([]int64, error) {
+	return execute(client, ctx, "LPOS", key, func(ctx context.Context, target backend) ([]int64, error) {
+		return target.LPosCountWithOptions(ctx, key, element, count, options)
+	})
+}
+
+func (client *Client) RPush(ctx context.Context, key string, elements []string) (int64, error) {
+	return execute(client, ctx, "RPUSH", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.RPush(ctx, key, elements)
+	})
+}
+
+func (client *Client) LRange(ctx context.Context, key string, start int64, end int64) ([]string, error) {
+	return execute(client, ctx, "LRANGE", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.LRange(ctx, key, start, end)
+	})
+}
+
+func (client *Client) LIndex(ctx context.Context, key string, index int64) (models.Result[string], error) {
+	return execute(client, ctx, "LINDEX", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.LIndex(ctx, key, index)
+	})
+}
+
+func (client *Client) LTrim(ctx context.Context, key string, start int64, end int64) (string, error) {
+	return execute(client, ctx, "LTRIM", key, func(ctx context.Context, target backend) (string, error) {
+		return target.LTrim(ctx, key, start, end)
+	})
+}
+
+func (client *Client) LLen(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "LLEN", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.LLen(ctx, key)
+	})
+}
+
+func (client *Client) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
+	return execute(client, ctx, "LREM", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.LRem(ctx, key, count, element)
+	})
+}
+
+func (client *Client) RPop(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "RPOP", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.RPop(ctx, key)
+	})
+}
+
+func (client *Client) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return execute(client, ctx, "RPOP", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.RPopCount(ctx, key, count)
+	})
+}
+
+func (client *Client) LInsert(
+	ctx context.Context,
+	key string,
+	insertPosition constants.InsertPosition,
+	pivot string,
+	element string,
+) (int64, error) {
+	return execute(client, ctx, "LINSERT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.LInsert(ctx, key, insertPosition, pivot, element)
+	})
+}
+
+func (client *Client) BLPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	return executeBlocking(client, ctx, "BLPOP",
+		func(ctx context.Context, target backend) ([]string, error) {
+			return target.BLPop(ctx, keys, timeoutSecs)
+		},
+		func(popped []string) (string, func(ctx context.Context, target backend) error) {
+			if len(popped) == 0 {
+				return "", nil
+			}
+			return popped[0], func(ctx context.Context, target backend) error {
+				return discard(target.LPop(ctx, popped[0]))
+			}
+		},
+	)
+}
+
+func (client *Client) BRPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	return executeBlocking(client, ctx, "BRPOP",
+		func(ctx context.Context, target backend) ([]string, error) {
+			return target.BRPop(ctx, keys, timeoutSecs)
+		},
+		func(popped []string) (string, func(ctx context.Context, target backend) error) {
+			if len(popped) == 0 {
+				return "", nil
+			}
+			return popped[0], func(ctx context.Context, target backend) error {
+				return discard(target.RPop(ctx, popped[0]))
+			}
+		},
+	)
+}
+
+func (client *Client) RPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	return execute(client, ctx, "RPUSHX", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.RPushX(ctx, key, elements)
+	})
+}
+
+func (client *Client) LPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	return execute(client, ctx, "LPUSHX", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.LPushX(ctx, key, elements)
+	})
+}
+
+func (client *Client) LMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+) (map[string][]string, error) {
+	return execute(
+		client, ctx, "LMPOP", firstKey(keys),
+		func(ctx context.Context, target backend) (map[string][]string, error) {
+			return target.LMPop(ctx, keys, listDirection)
+		},
+	)
+}
+
+func (client *Client) LMPopCount(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+) (map[string][]string, error) {
+	return execute(
+		client, ctx, "LMPOP", firstKey(keys),
+		func(ctx context.Context, target backend) (map[string][]string, error) {
+			return target.LMPopCount(ctx, keys, listDirection, count)
+		},
+	)
+}
+
+func (client *Client) BLMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	timeoutSecs float64,
+) (map[string][]string, error) {
+	return executeBlocking(client, ctx, "BLMPOP",
+		func(ctx context.Context, target backend) (map[string][]string, error) {
+			return target.BLMPop(ctx, keys, listDirection, timeoutSecs)
+		},
+		listPopEffect(listDirection),
+	)
+}
+
+func (client *Client) BLMPopCount(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+	timeoutSecs float64,
+) (map[string][]string, error) {
+	return executeBlocking(client, ctx, "BLMPOP",
+		func(ctx context.Context, target backend) (map[string][]string, error) {
+			return target.BLMPopCount(ctx, keys, listDirection, count, timeoutSecs)
+		},
+		listPopEffect(listDirection),
+	)
+}
+
+func (client *Client) LSet(ctx context.Context, key string, index int64, element string) (string, error) {
+	return execute(client, ctx, "LSET", key, func(ctx context.Context, target backend) (string, error) {
+		return target.LSet(ctx, key, index, element)
+	})
+}
+
+func (client *Client) LMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+) (models.Result[string], error) {
+	return execute(client, ctx, "LMOVE", source, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.LMove(ctx, source, destination, whereFrom, whereTo)
+	})
+}
+
+func (client *Client) BLMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+	timeoutSecs float64,
+) (models.Result[string], error) {
+	return executeBlocking(client, ctx, "BLMOVE",
+		func(ctx context.Context, target backend) (models.Result[string], error) {
+			return target.BLMove(ctx, source, destination, whereFrom, whereTo, timeoutSecs)
+		},
+		func(moved models.Result[string]) (string, func(ctx context.Context, target backend) error) {
+			if moved.IsNil() {
+				return "", nil
+			}
+			return source, func(ctx context.Context, target backend) error {
+				return discard(target.LMove(ctx, source, destination, whereFrom, whereTo))
+			}
+		},
+	)
+}
+
+func (client *Client) SAdd(ctx context.Context, key string, members []string) (int64, error) {
+	return execute(client, ctx, "SADD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SAdd(ctx, key, members)
+	})
+}
+
+func (client *Client) SRem(ctx context.Context, key string, members []string) (int64, error) {
+	return execute(client, ctx, "SREM", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SRem(ctx, key, members)
+	})
+}
+
+func (client *Client) SMembers(ctx context.Context, key string) (map[string]struct{}, error) {
+	return execute(client, ctx, "SMEMBERS", key, func(ctx context.Context, target backend) (map[string]struct{}, error) {
+		return target.SMembers(ctx, key)
+	})
+}
+
+func (client *Client) SCard(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "SCARD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SCard(ctx, key)
+	})
+}
+
+func (client *Client) SIsMember(ctx context.Context, key string, member string) (bool, error) {
+	return execute(client, ctx, "SISMEMBER", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.SIsMember(ctx, key, member)
+	})
+}
+
+func (client *Client) SDiff(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return execute(
+		client, ctx, "SDIFF", firstKey(keys),
+		func(ctx context.Context, target backend) (map[string]struct{}, error) {
+			return target.SDiff(ctx, keys)
+		},
+	)
+}
+
+func (client *Client) SDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return execute(client, ctx, "SDIFFSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.SDiffStore(ctx, destination, keys)
+	})
+}
+
+func (client *Client) SInter(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return execute(
+		client, ctx, "SINTER", firstKey(keys),
+		func(ctx context.Context, target backend) (map[string]struct{}, error) {
+			return target.SInter(ctx, keys)
+		},
+	)
+}
+
+func (client *Client) SInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return execute(client, ctx, "SINTERSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.SInterStore(ctx, destination, keys)
+	})
+}
+
+func (client *Client) SInterCard(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "SINTERCARD", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.SInterCard(ctx, keys)
+	})
+}
+
+func (client *Client) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
+	return execute(client, ctx, "SINTERCARD", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.SInterCardLimit(ctx, keys, limit)
+	})
+}
+
+func (client *Client) SRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "SRANDMEMBER", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.SRandMember(ctx, key)
+	})
+}
+
+func (client *Client) SRandMemberCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return execute(client, ctx, "SRANDMEMBER", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.SRandMemberCount(ctx, key, count)
+	})
+}
+
+func (client *Client) SPop(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "SPOP", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.SPop(ctx, key)
+	})
+}
+
+func (client *Client) SPopCount(ctx context.Context, key string, count int64) (map[string]struct{}, error) {
+	return execute(client, ctx, "SPOP", key, func(ctx context.Context, target backend) (map[string]struct{}, error) {
+		return target.SPopCount(ctx, key, count)
+	})
+}
+
+func (client *Client) SMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
+	return execute(client, ctx, "SMISMEMBER", key, func(ctx context.Context, target backend) ([]bool, error) {
+		return target.SMIsMember(ctx, key, members)
+	})
+}
+
+func (client *Client) SUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return execute(client, ctx, "SUNIONSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.SUnionStore(ctx, destination, keys)
+	})
+}
+
+func (client *Client) SUnion(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	return execute(
+		client, ctx, "SUNION", firstKey(keys),
+		func(ctx context.Context, target backend) (map[string]struct{}, error) {
+			return target.SUnion(ctx, keys)
+		},
+	)
+}
+
+func (client *Client) SScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.authoritative.SScan(ctx, key, cursor)
+}
+
+func (client *Client) SScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.BaseScanOptions,
+) (string, []string, error) {
+	return client.authoritative.SScanWithOptions(ctx, key, cursor, options)
+}
+
+func (client *Client) SMove(ctx context.Context, source string, destination string, member string) (bool, error) {
+	return execute(client, ctx, "SMOVE", source, func(ctx context.Context, target backend) (bool, error) {
+		return target.SMove(ctx, source, destination, member)
+	})
+}
+
+func (client *Client) XAdd(ctx context.Context, key string, values [][]string) (models.Result[string], error) {
+	return execute(client, ctx, "XADD", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.XAdd(ctx, key, values)
+	})
+}
+
+func (client *Client) XAddWithOptions(
+	ctx context.Context,
+	key string,
+	values [][]string,
+	options options.XAddOptions,
+) (models.Result[string], error) {
+	return execute(client, ctx, "XADD", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.XAddWithOptions(ctx, key, values, options)
+	})
+}
+
+func (client *Client) XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error) {
+	return execute(client, ctx, "XTRIM", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.XTrim(ctx, key, options)
+	})
+}
+
+func (client *Client) XLen(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "XLEN", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.XLen(ctx, key)
+	})
+}
+
+func (client *Client) XAutoClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (models.XAutoClaimResponse, error) {
+	return execute(
+		client, ctx, "XAUTOCLAIM", key,
+		func(ctx context.Context, target backend) (models.XAutoClaimResponse, error) {
+			return target.XAutoClaim(ctx, key, group, consumer, minIdleTime, start)
+		},
+	)
+}
+
+func (client *Client) XAutoClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimResponse, error) {
+	return execute(
+		client, ctx, "XAUTOCLAIM", key,
+		func(ctx context.Context, target backend) (models.XAutoClaimResponse, error) {
+			return target.XAutoClaimWithOptions(ctx, key, group, consumer, minIdleTime, start, options)
+		},
+	)
+}
+
+func (client *Client) XAutoClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (models.XAutoClaimJustIdResponse, error) {
+	return execute(
+		client, ctx, "XAUTOCLAIM", key,
+		func(ctx context.Context, target backend) (models.XAutoClaimJustIdResponse, error) {
+			return target.XAutoClaimJustId(ctx, key, group, consumer, minIdleTime, start)
+		},
+	)
+}
+
+func (client *Client) XAutoClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimJustIdResponse, error) {
+	return execute(
+		client, ctx, "XAUTOCLAIM", key,
+		func(ctx context.Context, target backend) (models.XAutoClaimJustIdResponse, error) {
+			return target.XAutoClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, start, options)
+		},
+	)
+}
+
+func (client *Client) XReadGroup(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+) (map[string]map[string][][]string, error) {
+	return execute(
+		client, ctx, "XREADGROUP", "",
+		func(ctx context.Context, target backend) (map[string]map[string][][]string, error) {
+			return target.XReadGroup(ctx, group, consumer, keysAndIds)
+		},
+	)
+}
+
+func (client *Client) XReadGroupWithOptions(
+	ctx context.Context,
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+	options options.XReadGroupOptions,
+) (map[string]map[string][][]string, error) {
+	return executeBlocking(client, ctx, "XREADGROUP",
+		func(ctx context.Context, target backend) (map[string]map[string][][]string, error) {
+			return target.XReadGroupWithOptions(ctx, group, consumer, keysAndIds, options)
+		},
+		func(read map[string]map[string][][]string) (string, func(ctx context.Context, target backend) error) {
+			if len(read) == 0 {
+				return "", nil
+			}
+			// the entries are read again without blocking
+			nonBlocking := options
+			nonBlocking.SetBlock(-1)
+			return "", func(ctx context.Context, target backend) error {
+				return discard(target.XReadGroupWithOptions(ctx, group, consumer, keysAndIds, nonBlocking))
+			}
+		},
+	)
+}
+
+func (client *Client) XRead(ctx context.Context, keysAndIds map[string]string) (map[string]map[string][][]string, error) {
+	return execute(
+		client, ctx, "XREAD", "",
+		func(ctx context.Context, target backend) (map[string]map[string][][]string, error) {
+			return target.XRead(ctx, keysAndIds)
+		},
+	)
+}
+
+func (client *Client) XReadWithOptions(
+	ctx context.Context,
+	keysAndIds map[string]string,
+	options options.XReadOptions,
+) (map[string]map[string][][]string, error) {
+	return execute(
+		client, ctx, "XREAD", "",
+		func(ctx context.Context, target backend) (map[string]map[string][][]string, error) {
+			return target.XReadWithOptions(ctx, keysAndIds, options)
+		},
+	)
+}
+
+func (client *Client) XDel(ctx context.Context, key string, ids []string) (int64, error) {
+	return execute(client, ctx, "XDEL", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.XDel(ctx, key, ids)
+	})
+}
+
+func (client *Client) XPending(ctx context.Context, key string, group string) (models.XPendingSummary, error) {
+	return execute(client, ctx, "XPENDING", key, func(ctx context.Context, target backend) (models.XPendingSummary, error) {
+		return target.XPending(ctx, key, group)
+	})
+}
+
+func (client *Client) XPendingWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	options options.XPendingOptions,
+) ([]models.XPendingDetail, error) {
+	return execute(client, ctx, "XPENDING", key, func(ctx context.Context, target backend) ([]models.XPendingDetail, error) {
+		return target.XPendingWithOptions(ctx, key, group, options)
+	})
+}
+
+func (client *Client) XGroupSetId(ctx context.Context, key string, group string, id string) (string, error) {
+	return execute(client, ctx, "XGROUP SETID", key, func(ctx context.Context, target backend) (string, error) {
+		return target.XGroupSetId(ctx, key, group, id)
+	})
+}
+
+func (client *Client) XGroupSetIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupSetIdOptions,
+) (string, error) {
+	return execute(client, ctx, "XGROUP SETID", key, func(ctx context.Context, target backend) (string, error) {
+		return target.XGroupSetIdWithOptions(ctx, key, group, id, opts)
+	})
+}
+
+func (client *Client) XGroupCreate(ctx context.Context, key string, group string, id string) (string, error) {
+	return execute(client, ctx, "XGROUP CREATE", key, func(ctx context.Context, target backend) (string, error) {
+		return target.XGroupCreate(ctx, key, group, id)
+	})
+}
+
+func (client *Client) XGroupCreateWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupCreateOptions,
+) (string, error) {
+	return execute(client, ctx, "XGROUP CREATE", key, func(ctx context.Context, target backend) (string, error) {
+		return target.XGroupCreateWithOptions(ctx, key, group, id, opts)
+	})
+}
+
+func (client *Client) XGroupDestroy(ctx context.Context, key string, group string) (bool, error) {
+	return execute(client, ctx, "XGROUP DESTROY", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.XGroupDestroy(ctx, key, group)
+	})
+}
+
+func (client *Client) XGroupCreateConsumer(ctx context.Context, key string, group string, consumer string) (bool, error) {
+	return execute(client, ctx, "XGROUP CREATECONSUMER", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.XGroupCreateConsumer(ctx, key, group, consumer)
+	})
+}
+
+func (client *Client) XGroupDelConsumer(ctx context.Context, key string, group string, consumer string) (int64, error) {
+	return execute(client, ctx, "XGROUP DELCONSUMER", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.XGroupDelConsumer(ctx, key, group, consumer)
+	})
+}
+
+func (client *Client) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
+	return execute(client, ctx, "XACK", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.XAck(ctx, key, group, ids)
+	})
+}
+
+func (client *Client) XClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) (map[string][][]string, error) {
+	return execute(client, ctx, "XCLAIM", key, func(ctx context.Context, target backend) (map[string][][]string, error) {
+		return target.XClaim(ctx, key, group, consumer, minIdleTime, ids)
+	})
+}
+
+func (client *Client) XClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	options options.XClaimOptions,
+) (map[string][][]string, error) {
+	return execute(client, ctx, "XCLAIM", key, func(ctx context.Context, target backend) (map[string][][]string, error) {
+		return target.XClaimWithOptions(ctx, key, group, consumer, minIdleTime, ids, options)
+	})
+}
+
+func (client *Client) XClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) ([]string, error) {
+	return execute(client, ctx, "XCLAIM", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.XClaimJustId(ctx, key, group, consumer, minIdleTime, ids)
+	})
+}
+
+func (client *Client) XClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	options options.XClaimOptions,
+) ([]string, error) {
+	return execute(client, ctx, "XCLAIM", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.XClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, ids, options)
+	})
+}
+
+func (client *Client) XInfoStream(ctx context.Context, key string) (map[string]any, error) {
+	return execute(client, ctx, "XINFO STREAM", key, func(ctx context.Context, target backend) (map[string]any, error) {
+		return target.XInfoStream(ctx, key)
+	})
+}
+
+func (client *Client) XInfoStreamFullWithOptions(
+	ctx context.Context,
+	key string,
+	options *options.XInfoStreamOptions,
+) (map[string]any, error) {
+	return execute(client, ctx, "XINFO STREAM", key, func(ctx context.Context, target backend) (map[string]any, error) {
+		return target.XInfoStreamFullWithOptions(ctx, key, options)
+	})
+}
+
+func (client *Client) XInfoConsumers(ctx context.Context, key string, group string) ([]models.XInfoConsumerInfo, error) {
+	return execute(
+		client, ctx, "XINFO CONSUMERS", key,
+		func(ctx context.Context, target backend) ([]models.XInfoConsumerInfo, error) {
+			return target.XInfoConsumers(ctx, key, group)
+		},
+	)
+}
+
+func (client *Client) XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error) {
+	return execute(
+		client, ctx, "XINFO GROUPS", key,
+		func(ctx context.Context, target backend) ([]models.XInfoGroupInfo, error) {
+			return target.XInfoGroups(ctx, key)
+		},
+	)
+}
+
+func (client *Client) XRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.XRangeResponse, error) {
+	return execute(client, ctx, "XRANGE", key, func(ctx context.Context, target backend) ([]models.XRangeResponse, error) {
+		return target.XRange(ctx, key, start, end)
+	})
+}
+
+func (client *Client) XRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	options options.XRangeOptions,
+) ([]models.XRangeResponse, error) {
+	return execute(client, ctx, "XRANGE", key, func(ctx context.Context, target backend) ([]models.XRangeResponse, error) {
+		return target.XRangeWithOptions(ctx, key, start, end, options)
+	})
+}
+
+func (client *Client) XRevRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.XRangeResponse, error) {
+	return execute(client, ctx, "XREVRANGE", key, func(ctx context.Context, target backend) ([]models.XRangeResponse, error) {
+		return target.XRevRange(ctx, key, start, end)
+	})
+}
+
+func (client *Client) XRevRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	options options.XRangeOptions,
+) ([]models.XRangeResponse, error) {
+	return execute(client, ctx, "XREVRANGE", key, func(ctx context.Context, target backend) ([]models.XRangeResponse, error) {
+		return target.XRevRangeWithOptions(ctx, key, start, end, options)
+	})
+}
+
+func (client *Client) ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error) {
+	return execute(client, ctx, "ZADD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZAdd(ctx, key, membersScoreMap)
+	})
+}
+
+func (client *Client) ZAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersScoreMap map[string]float64,
+	opts options.ZAddOptions,
+) (int64, error) {
+	return execute(client, ctx, "ZADD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZAddWithOptions(ctx, key, membersScoreMap, opts)
+	})
+}
+
+func (client *Client) ZAddIncr(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+) (models.Result[float64], error) {
+	return execute(client, ctx, "ZADD", key, func(ctx context.Context, target backend) (models.Result[float64], error) {
+		return target.ZAddIncr(ctx, key, member, increment)
+	})
+}
+
+func (client *Client) ZAddIncrWithOptions(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+	opts options.ZAddOptions,
+) (models.Result[float64], error) {
+	return execute(client, ctx, "ZADD", key, func(ctx context.Context, target backend) (models.Result[float64], error) {
+		return target.ZAddIncrWithOptions(ctx, key, member, increment, opts)
+	})
+}
+
+func (client *Client) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+	return execute(client, ctx, "ZINCRBY", key, func(ctx context.Context, target backend) (float64, error) {
+		return target.ZIncrBy(ctx, key, increment, member)
+	})
+}
+
+func (client *Client) ZPopMin(ctx context.Context, key string) (map[string]float64, error) {
+	return execute(client, ctx, "ZPOPMIN", key, func(ctx context.Context, target backend) (map[string]float64, error) {
+		return target.ZPopMin(ctx, key)
+	})
+}
+
+func (client *Client) ZPopMinWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	return execute(client, ctx, "ZPOPMIN", key, func(ctx context.Context, target backend) (map[string]float64, error) {
+		return target.ZPopMinWithOptions(ctx, key, options)
+	})
+}
+
+func (client *Client) ZPopMax(ctx context.Context, key string) (map[string]float64, error) {
+	return execute(client, ctx, "ZPOPMAX", key, func(ctx context.Context, target backend) (map[string]float64, error) {
+		return target.ZPopMax(ctx, key)
+	})
+}
+
+func (client *Client) ZPopMaxWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	return execute(client, ctx, "ZPOPMAX", key, func(ctx context.Context, target backend) (map[string]float64, error) {
+		return target.ZPopMaxWithOptions(ctx, key, options)
+	})
+}
+
+func (client *Client) ZRem(ctx context.Context, key string, members []string) (int64, error) {
+	return execute(client, ctx, "ZREM", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZRem(ctx, key, members)
+	})
+}
+
+func (client *Client) ZCard(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "ZCARD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZCard(ctx, key)
+	})
+}
+
+func (client *Client) BZPopMin(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	return executeBlocking(client, ctx, "BZPOPMIN",
+		func(ctx context.Context, target backend) (models.Result[models.KeyWithMemberAndScore], error) {
+			return target.BZPopMin(ctx, keys, timeoutSecs)
+		},
+		sortedSetPopEffect,
+	)
+}
+
+func (client *Client) BZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return executeBlocking(client, ctx, "BZMPOP",
+		func(ctx context.Context, target backend) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+			return target.BZMPop(ctx, keys, scoreFilter, timeoutSecs)
+		},
+		sortedSetMultiPopEffect,
+	)
+}
+
+func (client *Client) BZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeoutSecs float64,
+	options options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return executeBlocking(client, ctx, "BZMPOP",
+		func(ctx context.Context, target backend) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+			return target.BZMPopWithOptions(ctx, keys, scoreFilter, timeoutSecs, options)
+		},
+		sortedSetMultiPopEffect,
+	)
+}
+
+func (client *Client) ZRange(ctx context.Context, key string, rangeQuery options.ZRangeQuery) ([]string, error) {
+	return execute(client, ctx, "ZRANGE", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.ZRange(ctx, key, rangeQuery)
+	})
+}
+
+func (client *Client) BZPopMax(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	return executeBlocking(client, ctx, "BZPOPMAX",
+		func(ctx context.Context, target backend) (models.Result[models.KeyWithMemberAndScore], error) {
+			return target.BZPopMax(ctx, keys, timeoutSecs)
+		},
+		sortedSetPopEffect,
+	)
+}
+
+func (client *Client) ZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return execute(
+		client, ctx, "ZMPOP", firstKey(keys),
+		func(ctx context.Context, target backend) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+			return target.ZMPop(ctx, keys, scoreFilter)
+		},
+	)
+}
+
+func (client *Client) ZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	opts options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	return execute(
+		client, ctx, "ZMPOP", firstKey(keys),
+		func(ctx context.Context, target backend) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+			return target.ZMPopWithOptions(ctx, keys, scoreFilter, opts)
+		},
+	)
+}
+
+func (client *Client) ZRangeWithScores(
+	ctx context.Context,
+	key string,
+	rangeQuery options.ZRangeQueryWithScores,
+) ([]models.MemberAndScore, error) {
+	return execute(client, ctx, "ZRANGE", key, func(ctx context.Context, target backend) ([]models.MemberAndScore, error) {
+		return target.ZRangeWithScores(ctx, key, rangeQuery)
+	})
+}
+
+func (client *Client) ZRangeStore(
+	ctx context.Context,
+	destination string,
+	key string,
+	rangeQuery options.ZRangeQuery,
+) (int64, error) {
+	return execute(client, ctx, "ZRANGESTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZRangeStore(ctx, destination, key, rangeQuery)
+	})
+}
+
+func (client *Client) ZRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	return execute(client, ctx, "ZRANK", key, func(ctx context.Context, target backend) (models.Result[int64], error) {
+		return target.ZRank(ctx, key, member)
+	})
+}
+
+func (client *Client) ZRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (models.Result[int64], models.Result[float64], error) {
+	return client.authoritative.ZRankWithScore(ctx, key, member)
+}
+
+func (client *Client) ZRevRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	return execute(client, ctx, "ZREVRANK", key, func(ctx context.Context, target backend) (models.Result[int64], error) {
+		return target.ZRevRank(ctx, key, member)
+	})
+}
+
+func (client *Client) ZRevRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (models.Result[int64], models.Result[float64], error) {
+	return client.authoritative.ZRevRankWithScore(ctx, key, member)
+}
+
+func (client *Client) ZScore(ctx context.Context, key string, member string) (models.Result[float64], error) {
+	return execute(client, ctx, "ZSCORE", key, func(ctx context.Context, target backend) (models.Result[float64], error) {
+		return target.ZScore(ctx, key, member)
+	})
+}
+
+func (client *Client) ZCount(ctx context.Context, key string, rangeOptions options.ZCountRange) (int64, error) {
+	return execute(client, ctx, "ZCOUNT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZCount(ctx, key, rangeOptions)
+	})
+}
+
+func (client *Client) ZScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	return client.authoritative.ZScan(ctx, key, cursor)
+}
+
+func (client *Client) ZScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor string,
+	options options.ZScanOptions,
+) (string, []string, error) {
+	return client.authoritative.ZScanWithOptions(ctx, key, cursor, options)
+}
+
+func (client *Client) ZRemRangeByLex(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
+	return execute(client, ctx, "ZREMRANGEBYLEX", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZRemRangeByLex(ctx, key, rangeQuery)
+	})
+}
+
+func (client *Client) ZRemRangeByRank(ctx context.Context, key string, start int64, stop int64) (int64, error) {
+	return execute(client, ctx, "ZREMRANGEBYRANK", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZRemRangeByRank(ctx, key, start, stop)
+	})
+}
+
+func (client *Client) ZRemRangeByScore(ctx context.Context, key string, rangeQuery options.RangeByScore) (int64, error) {
+	return execute(client, ctx, "ZREMRANGEBYSCORE", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZRemRangeByScore(ctx, key, rangeQuery)
+	})
+}
+
+func (client *Client) ZDiff(ctx context.Context, keys []string) ([]string, error) {
+	return execute(client, ctx, "ZDIFF", firstKey(keys), func(ctx context.Context, target backend) ([]string, error) {
+		return target.ZDiff(ctx, keys)
+	})
+}
+
+func (client *Client) ZDiffWithScores(ctx context.Context, keys []string) ([]models.MemberAndScore, error) {
+	return execute(
+		client, ctx, "ZDIFF", firstKey(keys),
+		func(ctx context.Context, target backend) ([]models.MemberAndScore, error) {
+			return target.ZDiffWithScores(ctx, keys)
+		},
+	)
+}
+
+func (client *Client) ZRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "ZRANDMEMBER", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.ZRandMember(ctx, key)
+	})
+}
+
+func (client *Client) ZRandMemberWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	return execute(client, ctx, "ZRANDMEMBER", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.ZRandMemberWithCount(ctx, key, count)
+	})
+}
+
+func (client *Client) ZRandMemberWithCountWithScores(
+	ctx context.Context,
+	key string,
+	count int64,
+) ([]models.MemberAndScore, error) {
+	return execute(
+		client, ctx, "ZRANDMEMBER", key,
+		func(ctx context.Context, target backend) ([]models.MemberAndScore, error) {
+			return target.ZRandMemberWithCountWithScores(ctx, key, count)
+		},
+	)
+}
+
+func (client *Client) ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error) {
+	return execute(client, ctx, "ZMSCORE", key, func(ctx context.Context, target backend) ([]models.Result[float64], error) {
+		return target.ZMScore(ctx, key, members)
+	})
+}
+
+func (client *Client) ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	return execute(client, ctx, "ZDIFFSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZDiffStore(ctx, destination, keys)
+	})
+}
+
+func (client *Client) ZInter(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	return execute(client, ctx, "ZINTER", "", func(ctx context.Context, target backend) ([]string, error) {
+		return target.ZInter(ctx, keys)
+	})
+}
+
+func (client *Client) ZInterWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options options.ZInterOptions,
+) ([]models.MemberAndScore, error) {
+	return execute(client, ctx, "ZINTER", "", func(ctx context.Context, target backend) ([]models.MemberAndScore, error) {
+		return target.ZInterWithScores(ctx, keysOrWeightedKeys, options)
+	})
+}
+
+func (client *Client) ZInterStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return execute(client, ctx, "ZINTERSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZInterStore(ctx, destination, keysOrWeightedKeys)
+	})
+}
+
+func (client *Client) ZInterStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options options.ZInterOptions,
+) (int64, error) {
+	return execute(client, ctx, "ZINTERSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZInterStoreWithOptions(ctx, destination, keysOrWeightedKeys, options)
+	})
+}
+
+func (client *Client) ZUnion(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	return execute(client, ctx, "ZUNION", "", func(ctx context.Context, target backend) ([]string, error) {
+		return target.ZUnion(ctx, keys)
+	})
+}
+
+func (client *Client) ZUnionWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	options *options.ZUnionOptions,
+) ([]models.MemberAndScore, error) {
+	return execute(client, ctx, "ZUNION", "", func(ctx context.Context, target backend) ([]models.MemberAndScore, error) {
+		return target.ZUnionWithScores(ctx, keysOrWeightedKeys, options)
+	})
+}
+
+func (client *Client) ZUnionStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return execute(client, ctx, "ZUNIONSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZUnionStore(ctx, destination, keysOrWeightedKeys)
+	})
+}
+
+func (client *Client) ZUnionStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zUnionOptions *options.ZUnionOptions,
+) (int64, error) {
+	return execute(client, ctx, "ZUNIONSTORE", destination, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZUnionStoreWithOptions(ctx, destination, keysOrWeightedKeys, zUnionOptions)
+	})
+}
+
+func (client *Client) ZInterCard(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "ZINTERCARD", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.ZInterCard(ctx, keys)
+	})
+}
+
+func (client *Client) ZInterCardWithOptions(
+	ctx context.Context,
+	keys []string,
+	options *options.ZInterCardOptions,
+) (int64, error) {
+	return execute(client, ctx, "ZINTERCARD", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.ZInterCardWithOptions(ctx, keys, options)
+	})
+}
+
+func (client *Client) ZLexCount(ctx context.Context, key string, rangeQuery *options.RangeByLex) (int64, error) {
+	return execute(client, ctx, "ZLEXCOUNT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ZLexCount(ctx, key, rangeQuery)
+	})
+}
+
+func (client *Client) PfAdd(ctx context.Context, key string, elements []string) (int64, error) {
+	return execute(client, ctx, "PFADD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.PfAdd(ctx, key, elements)
+	})
+}
+
+func (client *Client) PfCount(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "PFCOUNT", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.PfCount(ctx, keys)
+	})
+}
+
+func (client *Client) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	return execute(client, ctx, "PFMERGE", destination, func(ctx context.Context, target backend) (string, error) {
+		return target.PfMerge(ctx, destination, sourceKeys)
+	})
+}
+
+func (client *Client) Del(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "DEL", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.Del(ctx, keys)
+	})
+}
+
+func (client *Client) Exists(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "EXISTS", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.Exists(ctx, keys)
+	})
+}
+
+func (client *Client) Expire(ctx context.Context, key string, seconds int64) (bool, error) {
+	return execute(client, ctx, "EXPIRE", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.Expire(ctx, key, seconds)
+	})
+}
+
+func (client *Client) ExpireWithOptions(
+	ctx context.Context,
+	key string,
+	seconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return execute(client, ctx, "EXPIRE", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.ExpireWithOptions(ctx, key, seconds, expireCondition)
+	})
+}
+
+func (client *Client) ExpireAt(ctx context.Context, key string, unixTimestampInSeconds int64) (bool, error) {
+	return execute(client, ctx, "EXPIREAT", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.ExpireAt(ctx, key, unixTimestampInSeconds)
+	})
+}
+
+func (client *Client) ExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	unixTimestampInSeconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return execute(client, ctx, "EXPIREAT", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.ExpireAtWithOptions(ctx, key, unixTimestampInSeconds, expireCondition)
+	})
+}
+
+func (client *Client) PExpire(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	return execute(client, ctx, "PEXPIRE", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.PExpire(ctx, key, milliseconds)
+	})
+}
+
+func (client *Client) PExpireWithOptions(
+	ctx context.Context,
+	key string,
+	milliseconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return execute(client, ctx, "PEXPIRE", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.PExpireWithOptions(ctx, key, milliseconds, expireCondition)
+	})
+}
+
+func (client *Client) PExpireAt(ctx context.Context, key string, unixTimestampInMilliSeconds int64) (bool, error) {
+	return execute(client, ctx, "PEXPIREAT", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.PExpireAt(ctx, key, unixTimestampInMilliSeconds)
+	})
+}
+
+func (client *Client) PExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	unixTimestampInMilliSeconds int64,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	return execute(client, ctx, "PEXPIREAT", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.PExpireAtWithOptions(ctx, key, unixTimestampInMilliSeconds, expireCondition)
+	})
+}
+
+func (client *Client) ExpireTime(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "EXPIRETIME", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.ExpireTime(ctx, key)
+	})
+}
+
+func (client *Client) PExpireTime(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "PEXPIRETIME", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.PExpireTime(ctx, key)
+	})
+}
+
+func (client *Client) TTL(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "TTL", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.TTL(ctx, key)
+	})
+}
+
+func (client *Client) PTTL(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "PTTL", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.PTTL(ctx, key)
+	})
+}
+
+func (client *Client) Unlink(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "UNLINK", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.Unlink(ctx, keys)
+	})
+}
+
+func (client *Client) Touch(ctx context.Context, keys []string) (int64, error) {
+	return execute(client, ctx, "TOUCH", firstKey(keys), func(ctx context.Context, target backend) (int64, error) {
+		return target.Touch(ctx, keys)
+	})
+}
+
+func (client *Client) Type(ctx context.Context, key string) (string, error) {
+	return execute(client, ctx, "TYPE", key, func(ctx context.Context, target backend) (string, error) {
+		return target.Type(ctx, key)
+	})
+}
+
+func (client *Client) Rename(ctx context.Context, key string, newKey string) (string, error) {
+	return execute(client, ctx, "RENAME", key, func(ctx context.Context, target backend) (string, error) {
+		return target.Rename(ctx, key, newKey)
+	})
+}
+
+func (client *Client) RenameNX(ctx context.Context, key string, newKey string) (bool, error) {
+	return execute(client, ctx, "RENAMENX", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.RenameNX(ctx, key, newKey)
+	})
+}
+
+func (client *Client) Persist(ctx context.Context, key string) (bool, error) {
+	return execute(client, ctx, "PERSIST", key, func(ctx context.Context, target backend) (bool, error) {
+		return target.Persist(ctx, key)
+	})
+}
+
+func (client *Client) Restore(ctx context.Context, key string, ttl int64, value string) (string, error) {
+	return execute(client, ctx, "RESTORE", key, func(ctx context.Context, target backend) (string, error) {
+		return target.Restore(ctx, key, ttl, value)
+	})
+}
+
+func (client *Client) RestoreWithOptions(
+	ctx context.Context,
+	key string,
+	ttl int64,
+	value string,
+	option options.RestoreOptions,
+) (string, error) {
+	return execute(client, ctx, "RESTORE", key, func(ctx context.Context, target backend) (string, error) {
+		return target.RestoreWithOptions(ctx, key, ttl, value, option)
+	})
+}
+
+func (client *Client) ObjectEncoding(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(
+		client, ctx, "OBJECT ENCODING", key,
+		func(ctx context.Context, target backend) (models.Result[string], error) {
+			return target.ObjectEncoding(ctx, key)
+		},
+	)
+}
+
+func (client *Client) Dump(ctx context.Context, key string) (models.Result[string], error) {
+	return execute(client, ctx, "DUMP", key, func(ctx context.Context, target backend) (models.Result[string], error) {
+		return target.Dump(ctx, key)
+	})
+}
+
+func (client *Client) ObjectFreq(ctx context.Context, key string) (models.Result[int64], error) {
+	return execute(client, ctx, "OBJECT FREQ", key, func(ctx context.Context, target backend) (models.Result[int64], error) {
+		return target.ObjectFreq(ctx, key)
+	})
+}
+
+func (client *Client) ObjectIdleTime(ctx context.Context, key string) (models.Result[int64], error) {
+	return execute(
+		client, ctx, "OBJECT IDLETIME", key,
+		func(ctx context.Context, target backend) (models.Result[int64], error) {
+			return target.ObjectIdleTime(ctx, key)
+		},
+	)
+}
+
+func (client *Client) ObjectRefCount(ctx context.Context, key string) (models.Result[int64], error) {
+	return execute(
+		client, ctx, "OBJECT REFCOUNT", key,
+		func(ctx context.Context, target backend) (models.Result[int64], error) {
+			return target.ObjectRefCount(ctx, key)
+		},
+	)
+}
+
+func (client *Client) Sort(ctx context.Context, key string) ([]models.Result[string], error) {
+	return execute(client, ctx, "SORT", key, func(ctx context.Context, target backend) ([]models.Result[string], error) {
+		return target.Sort(ctx, key)
+	})
+}
+
+func (client *Client) SortWithOptions(
+	ctx context.Context,
+	key string,
+	sortOptions options.SortOptions,
+) ([]models.Result[string], error) {
+	return execute(client, ctx, "SORT", key, func(ctx context.Context, target backend) ([]models.Result[string], error) {
+		return target.SortWithOptions(ctx, key, sortOptions)
+	})
+}
+
+func (client *Client) SortStore(ctx context.Context, key string, destination string) (int64, error) {
+	return execute(client, ctx, "SORT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SortStore(ctx, key, destination)
+	})
+}
+
+func (client *Client) SortStoreWithOptions(
+	ctx context.Context,
+	key string,
+	destination string,
+	sortOptions options.SortOptions,
+) (int64, error) {
+	return execute(client, ctx, "SORT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SortStoreWithOptions(ctx, key, destination, sortOptions)
+	})
+}
+
+func (client *Client) SortReadOnly(ctx context.Context, key string) ([]models.Result[string], error) {
+	return execute(client, ctx, "SORT_RO", key, func(ctx context.Context, target backend) ([]models.Result[string], error) {
+		return target.SortReadOnly(ctx, key)
+	})
+}
+
+func (client *Client) SortReadOnlyWithOptions(
+	ctx context.Context,
+	key string,
+	sortOptions options.SortOptions,
+) ([]models.Result[string], error) {
+	return execute(client, ctx, "SORT_RO", key, func(ctx context.Context, target backend) ([]models.Result[string], error) {
+		return target.SortReadOnlyWithOptions(ctx, key, sortOptions)
+	})
+}
+
+func (client *Client) Wait(ctx context.Context, numberOfReplicas int64, timeout int64) (int64, error) {
+	return execute(client, ctx, "WAIT", "", func(ctx context.Context, target backend) (int64, error) {
+		return target.Wait(ctx, numberOfReplicas, timeout)
+	})
+}
+
+func (client *Client) Copy(ctx context.Context, source string, destination string) (bool, error) {
+	return execute(client, ctx, "COPY", source, func(ctx context.Context, target backend) (bool, error) {
+		return target.Copy(ctx, source, destination)
+	})
+}
+
+func (client *Client) CopyWithOptions(
+	ctx context.Context,
+	source string,
+	destination string,
+	option options.CopyOptions,
+) (bool, error) {
+	return execute(client, ctx, "COPY", source, func(ctx context.Context, target backend) (bool, error) {
+		return target.CopyWithOptions(ctx, source, destination, option)
+	})
+}
+
+func (client *Client) UpdateConnectionPassword(ctx context.Context, password string, immediateAuth bool) (string, error) {
+	return client.authoritative.UpdateConnectionPassword(ctx, password, immediateAuth)
+}
+
+func (client *Client) ResetConnectionPassword(ctx context.Context) (string, error) {
+	return client.authoritative.ResetConnectionPassword(ctx)
+}
+
+func (client *Client) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	return execute(client, ctx, "SETBIT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.SetBit(ctx, key, offset, value)
+	})
+}
+
+func (client *Client) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	return execute(client, ctx, "GETBIT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.GetBit(ctx, key, offset)
+	})
+}
+
+func (client *Client) BitCount(ctx context.Context, key string) (int64, error) {
+	return execute(client, ctx, "BITCOUNT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.BitCount(ctx, key)
+	})
+}
+
+func (client *Client) BitCountWithOptions(ctx context.Context, key string, options options.BitCountOptions) (int64, error) {
+	return execute(client, ctx, "BITCOUNT", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.BitCountWithOptions(ctx, key, options)
+	})
+}
+
+func (client *Client) BitPos(ctx context.Context, key string, bit int64) (int64, error) {
+	return execute(client, ctx, "BITPOS", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.BitPos(ctx, key, bit)
+	})
+}
+
+func (client *Client) BitPosWithOptions(
+	ctx context.Context,
+	key string,
+	bit int64,
+	options options.BitPosOptions,
+) (int64, error) {
+	return execute(client, ctx, "BITPOS", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.BitPosWithOptions(ctx, key, bit, options)
+	})
+}
+
+func (client *Client) BitField(
+	ctx context.Context,
+	key string,
+	subCommands []options.BitFieldSubCommands,
+) ([]models.Result[int64], error) {
+	return execute(client, ctx, "BITFIELD", key, func(ctx context.Context, target backend) ([]models.Result[int64], error) {
+		return target.BitField(ctx, key, subCommands)
+	})
+}
+
+func (client *Client) BitFieldRO(
+	ctx context.Context,
+	key string,
+	commands []options.BitFieldROCommands,
+) ([]models.Result[int64], error) {
+	return execute(
+		client, ctx, "BITFIELD_RO", key,
+		func(ctx context.Context, target backend) ([]models.Result[int64], error) {
+			return target.BitFieldRO(ctx, key, commands)
+		},
+	)
+}
+
+func (client *Client) BitOp(
+	ctx context.Context,
+	bitwiseOperation options.BitOpType,
+	destination string,
+	keys []string,
+) (int64, error) {
+	return execute(client, ctx, "BITOP", "", func(ctx context.Context, target backend) (int64, error) {
+		return target.BitOp(ctx, bitwiseOperation, destination, keys)
+	})
+}
+
+func (client *Client) GeoAdd(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+) (int64, error) {
+	return execute(client, ctx, "GEOADD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.GeoAdd(ctx, key, membersToGeospatialData)
+	})
+}
+
+func (client *Client) GeoAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+	options options.GeoAddOptions,
+) (int64, error) {
+	return execute(client, ctx, "GEOADD", key, func(ctx context.Context, target backend) (int64, error) {
+		return target.GeoAddWithOptions(ctx, key, membersToGeospatialData, options)
+	})
+}
+
+func (client *Client) GeoHash(ctx context.Context, key string, members []string) ([]string, error) {
+	return execute(client, ctx, "GEOHASH", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.GeoHash(ctx, key, members)
+	})
+}
+
+func (client *Client) GeoPos(ctx context.Context, key string, members []string) ([][]float64, error) {
+	return execute(client, ctx, "GEOPOS", key, func(ctx context.Context, target backend) ([][]float64, error) {
+		return target.GeoPos(ctx, key, members)
+	})
+}
+
+func (client *Client) GeoDist(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+) (models.Result[float64], error) {
+	return execute(client, ctx, "GEODIST", key, func(ctx context.Context, target backend) (models.Result[float64], error) {
+		return target.GeoDist(ctx, key, member1, member2)
+	})
+}
+
+func (client *Client) GeoDistWithUnit(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+	unit constants.GeoUnit,
+) (models.Result[float64], error) {
+	return execute(client, ctx, "GEODIST", key, func(ctx context.Context, target backend) (models.Result[float64], error) {
+		return target.GeoDistWithUnit(ctx, key, member1, member2, unit)
+	})
+}
+
+func (client *Client) GeoSearch(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) ([]string, error) {
+	return execute(client, ctx, "GEOSEARCH", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.GeoSearch(ctx, key, searchFrom, searchByShape)
+	})
+}
+
+func (client *Client) GeoSearchWithInfoOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return execute(client, ctx, "GEOSEARCH", key, func(ctx context.Context, target backend) ([]options.Location, error) {
+		return target.GeoSearchWithInfoOptions(ctx, key, searchFrom, searchByShape, infoOptions)
+	})
+}
+
+func (client *Client) GeoSearchWithResultOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) ([]string, error) {
+	return execute(client, ctx, "GEOSEARCH", key, func(ctx context.Context, target backend) ([]string, error) {
+		return target.GeoSearchWithResultOptions(ctx, key, searchFrom, searchByShape, resultOptions)
+	})
+}
+
+func (client *Client) GeoSearchWithFullOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return execute(client, ctx, "GEOSEARCH", key, func(ctx context.Context, target backend) ([]options.Location, error) {
+		return target.GeoSearchWithFullOptions(ctx, key, searchFrom, searchByShape, resultOptions, infoOptions)
+	})
+}
+
+func (client *Client) GeoSearchStore(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) (int64, error) {
+	return execute(client, ctx, "GEOSEARCHSTORE", destinationKey, func(ctx context.Context, target backend) (int64, error) {
+		return target.GeoSearchStore(ctx, destinationKey, sourceKey, searchFrom, searchByShape)
+	})
+}
+
+func (client *Client) GeoSearchStoreWithInfoOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	storeInfoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return execute(client, ctx, "GEOSEARCHSTORE", destinationKey, func(ctx context.Context, target backend) (int64, error) {
+		return target.GeoSearchStoreWithInfoOptions(ctx, destinationKey, sourceKey, searchFrom, searchByShape, storeInfoOptions)
+	})
+}
+
+func (client *Client) GeoSearchStoreWithResultOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) (int64, error) {
+	return execute(client, ctx, "GEOSEARCHSTORE", destinationKey, func(ctx context.Context, target backend) (int64, error) {
+		return target.GeoSearchStoreWithResultOptions(ctx, destinationKey, sourceKey, searchFrom, searchByShape, resultOptions)
+	})
+}
+
+func (client *Client) GeoSearchStoreWithFullOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	storeInfoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return execute(client, ctx, "GEOSEARCHSTORE", destinationKey, func(ctx context.Context, target backend) (int64, error) {
+		return target.GeoSearchStoreWithFullOptions(
+			ctx,
+			destinationKey,
+			sourceKey,
+			searchFrom,
+			searchByShape,
+			resultOptions,
+			storeInfoOptions,
+		)
+	})
+}
+
+func (client *Client) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
+	return execute(client, ctx, "FUNCTION LOAD", "", func(ctx context.Context, target backend) (string, error) {
+		return target.FunctionLoad(ctx, libraryCode, replace)
+	})
+}
+
+func (client *Client) FunctionFlush(ctx context.Context) (string, error) {
+	return execute(client, ctx, "FUNCTION FLUSH", "", func(ctx context.Context, target backend) (string, error) {
+		return target.FunctionFlush(ctx)
+	})
+}
+
+func (client *Client) FunctionFlushSync(ctx context.Context) (string, error) {
+	return execute(client, ctx, "FUNCTION FLUSH", "", func(ctx context.Context, target backend) (string, error) {
+		return target.FunctionFlushSync(ctx)
+	})
+}
+
+func (client *Client) FunctionFlushAsync(ctx context.Context) (string, error) {
+	return execute(client, ctx, "FUNCTION FLUSH", "", func(ctx context.Context, target backend) (string, error) {
+		return target.FunctionFlushAsync(ctx)
+	})
+}
+
+func (client *Client) FCall(ctx context.Context, function string) (any, error) {
+	return execute(client, ctx, "FCALL", "", func(ctx context.Context, target backend) (any, error) {
+		return target.FCall(ctx, function)
+	})
+}
+
+func (client *Client) FCallReadOnly(ctx context.Context, function string) (any, error) {
+	return execute(client, ctx, "FCALL_RO", "", func(ctx context.Context, target backend) (any, error) {
+		return target.FCallReadOnly(ctx, function)
+	})
+}
+
+func (client *Client) FCallWithKeysAndArgs(ctx context.Context, function string, keys []string, args []string) (any, error) {
+	return execute(client, ctx, "FCALL", "", func(ctx context.Context, target backend) (any, error) {
+		return target.FCallWithKeysAndArgs(ctx, function, keys, args)
+	})
+}
+
+func (client *Client) FCallReadOnlyWithKeysAndArgs(
+	ctx context.Context,
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	return execute(client, ctx, "FCALL_RO", "", func(ctx context.Context, target backend) (any, error) {
+		return target.FCallReadOnlyWithKeysAndArgs(ctx, function, keys, args)
+	})
+}
+
+func (client *Client) InvokeScript(ctx context.Context, script options.Script) (any, error) {
+	return execute(client, ctx, "EVALSHA", "", func(ctx context.Context, target backend) (any, error) {
+		return target.InvokeScript(ctx, script)
+	})
+}
+
+func (client *Client) InvokeScriptWithOptions(
+	ctx context.Context,
+	script options.Script,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return execute(client, ctx, "EVALSHA", "", func(ctx context.Context, target backend) (any, error) {
+		return target.InvokeScriptWithOptions(ctx, script, scriptOptions)
+	})
+}
+
+func (client *Client) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
+	return execute(client, ctx, "SCRIPT EXISTS", "", func(ctx context.Context, target backend) ([]bool, error) {
+		return target.ScriptExists(ctx, sha1s)
+	})
+}
+
+func (client *Client) ScriptFlush(ctx context.Context) (string, error) {
+	return execute(client, ctx, "SCRIPT FLUSH", "", func(ctx context.Context, target backend) (string, error) {
+		return target.ScriptFlush(ctx)
+	})
+}
+
+func (client *Client) ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error) {
+	return execute(client, ctx, "SCRIPT FLUSH", "", func(ctx context.Context, target backend) (string, error) {
+		return target.ScriptFlushWithMode(ctx, mode)
+	})
+}
+
+func (client *Client) ScriptShow(ctx context.Context, sha1 string) (string, error) {
+	return execute(client, ctx, "SCRIPT SHOW", "", func(ctx context.Context, target backend) (string, error) {
+		return target.ScriptShow(ctx, sha1)
+	})
+}
+
+func (client *Client) ScriptKill(ctx context.Context) (string, error) {
+	return execute(client, ctx, "SCRIPT KILL", "", func(ctx context.Context, target backend) (string, error) {
+		return target.ScriptKill(ctx)
+	})
+}
+
+func (client *Client) PubSubChannels(ctx context.Context) ([]string, error) {
+	return execute(client, ctx, "PUBSUB CHANNELS", "", func(ctx context.Context, target backend) ([]string, error) {
+		return target.PubSubChannels(ctx)
+	})
+}
+
+func (client *Client) PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	return execute(client, ctx, "PUBSUB CHANNELS", "", func(ctx context.Context, target backend) ([]string, error) {
+		return target.PubSubChannelsWithPattern(ctx, pattern)
+	})
+}
+
+func (client *Client) PubSubNumPat(ctx context.Context) (int64, error) {
+	return execute(client, ctx, "PUBSUB NUMPAT", "", func(ctx context.Context, target backend) (int64, error) {
+		return target.PubSubNumPat(ctx)
+	})
+}
+
+func (client *Client) PubSubNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	return execute(client, ctx, "PUBSUB NUMSUB", "", func(ctx context.Context, target backend) (map[string]int64, error) {
+		return target.PubSubNumSub(ctx, channels...)
+	})
+}
+
+func (client *Client) Watch(ctx context.Context, keys []string) (string, error) {
+	return client.authoritative.Watch(ctx, keys)
+}
+
+func (client *Client) Unwatch(ctx context.Context) (string, error) {
+	return client.authoritative.Unwatch(ctx)
+}
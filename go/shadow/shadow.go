@@ -0,0 +1,477 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package shadow implements a client which mirrors the writes of an authoritative Valkey GLIDE client to a shadow client,
+// for the migration from one deployment to another, for example from a standalone server to a cluster.
+//
+// A [Client] implements [interfaces.BaseClientCommands]. Every command is sent to the authoritative client, whose results
+// are returned. The writes which succeeded are mirrored to the shadow client, in the background or before returning, and
+// a sample of the reads of keys are sent to the shadow client as well, and their results are compared:
+//
+//	client := shadow.NewClient(current, next, shadow.NewConfiguration().
+//		WithSampleRate(0.05).
+//		WithDivergenceHandler(func(divergence shadow.Divergence) {
+//			log.Printf("%s %s diverged: %v != %v", divergence.Command, divergence.Key, divergence.Authoritative,
+//				divergence.Shadow)
+//		}))
+//	defer client.Close()
+//
+// The shadow client is expected to hold the same data as the authoritative client, for example after the data was copied
+// to it while the writes were already mirrored. Writes whose effect is not deterministic, such as SPOP or XADD with
+// generated IDs, may diverge, which is reported by the reads of their keys. The blocking commands, such as BLPOP, are not
+// sent to the shadow client, which could block on them; their effect is mirrored with non-blocking commands instead, for
+// example an LPOP of the key from which BLPOP popped an element.
+package shadow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"sync"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	glideerrors "github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+)
+
+var _ interfaces.BaseClientCommands = (*Client)(nil)
+
+// ErrQueueFull is reported by the error handler of a [Client] for the writes which were not mirrored, because too many
+// writes were waiting to be mirrored.
+var ErrQueueFull = errors.New("shadow: the mirroring queue is full")
+
+// backend is the type of the authoritative and of the shadow clients.
+type backend = interfaces.BaseClientCommands
+
+// Mode is the mode of the mirroring of the writes of a [Client].
+type Mode int
+
+const (
+	// Asynchronous - The writes are mirrored in the background, in the order in which they were sent to the
+	// authoritative client. The sampled reads are sent to the shadow client and compared in the background as well,
+	// after the writes mirrored before them.
+	Asynchronous Mode = iota
+	// Synchronous - The writes are mirrored before returning.
+	Synchronous
+)
+
+func (mode Mode) String() string {
+	switch mode {
+	case Asynchronous:
+		return "asynchronous"
+	case Synchronous:
+		return "synchronous"
+	}
+	return "unknown"
+}
+
+// Divergence is a read whose result differs between the authoritative and the shadow clients.
+type Divergence struct {
+	// Command is the upper-case command name, including the container command for subcommands, for example "GET" or
+	// "OBJECT ENCODING".
+	Command string
+	// Key is the first key of the command.
+	Key string
+	// Authoritative and AuthoritativeErr are the result of the authoritative client, which was returned to the caller.
+	Authoritative    any
+	AuthoritativeErr error
+	// Shadow and ShadowErr are the result of the shadow client.
+	Shadow    any
+	ShadowErr error
+}
+
+// MirrorError is a command which failed on the shadow client, other than with an error of the server.
+type MirrorError struct {
+	// Command is the upper-case command name, including the container command for subcommands.
+	Command string
+	// Key is the first key of the command, or empty for commands without keys, such as MSET or FUNCTION LOAD.
+	Key string
+	Err error
+}
+
+func (e *MirrorError) Error() string {
+	return fmt.Sprintf("shadow: %s %s: %v", e.Command, e.Key, e.Err)
+}
+
+func (e *MirrorError) Unwrap() error {
+	return e.Err
+}
+
+// Configuration configures the mirroring of the writes and the comparison of the reads of a [Client].
+type Configuration struct {
+	mode              Mode
+	queueSize         int
+	sampleRate        float64
+	divergenceHandler func(divergence Divergence)
+	errorHandler      func(err *MirrorError)
+}
+
+// NewConfiguration returns a [Configuration] which mirrors the writes asynchronously, with up to 1024 writes waiting to be
+// mirrored, and compares 1% of the reads.
+func NewConfiguration() *Configuration {
+	return &Configuration{mode: Asynchronous, queueSize: 1024, sampleRate: 0.01}
+}
+
+// WithMode sets whether the writes are mirrored in the background or before returning.
+func (configuration *Configuration) WithMode(mode Mode) *Configuration {
+	configuration.mode = mode
+	return configuration
+}
+
+// WithQueueSize sets the number of writes and sampled reads which may wait to be sent to the shadow client in asynchronous
+// mode. The writes issued while the queue is full are not mirrored, and are reported with [ErrQueueFull]. The sampled
+// reads issued while the queue is full are not compared.
+func (configuration *Configuration) WithQueueSize(queueSize int) *Configuration {
+	configuration.queueSize = queueSize
+	return configuration
+}
+
+// WithSampleRate sets the ratio of the reads of keys which are compared, from 0 for none to 1 for all the reads.
+func (configuration *Configuration) WithSampleRate(sampleRate float64) *Configuration {
+	configuration.sampleRate = sampleRate
+	return configuration
+}
+
+// WithDivergenceHandler sets the handler of the reads whose result differs between the clients. It is called by the
+// goroutine of the read, or by the goroutine which compares the reads in asynchronous mode, and should return quickly.
+func (configuration *Configuration) WithDivergenceHandler(handler func(divergence Divergence)) *Configuration {
+	configuration.divergenceHandler = handler
+	return configuration
+}
+
+// WithErrorHandler sets the handler of the commands which failed on the shadow client, other than with an error of the
+// server, and of the writes which were not mirrored. It is called by the goroutine of the command, or by the goroutine
+// which mirrors the writes in asynchronous mode, and should return quickly.
+func (configuration *Configuration) WithErrorHandler(handler func(err *MirrorError)) *Configuration {
+	configuration.errorHandler = handler
+	return configuration
+}
+
+// Client sends commands to an authoritative client, and mirrors its writes to a shadow client. It is safe for concurrent
+// use.
+type Client struct {
+	authoritative backend
+	shadow        backend
+	configuration *Configuration
+
+	mu     sync.Mutex
+	closed bool
+	// queue holds the commands waiting to be sent to the shadow client in asynchronous mode.
+	queue   chan func()
+	stopped chan struct{}
+}
+
+// NewClient creates a client which sends the commands to the authoritative client, and mirrors its writes to the shadow
+// client. A nil configuration uses the defaults of [NewConfiguration]. Either client may be a standalone or a cluster
+// client.
+//
+// Closing the client closes both clients, once the pending writes were mirrored.
+func NewClient(
+	authoritative interfaces.BaseClientCommands,
+	shadow interfaces.BaseClientCommands,
+	configuration *Configuration,
+) *Client {
+	if configuration == nil {
+		configuration = NewConfiguration()
+	}
+	client := &Client{authoritative: authoritative, shadow: shadow, configuration: configuration}
+	if configuration.mode == Asynchronous {
+		client.queue = make(chan func(), max(configuration.queueSize, 0))
+		client.stopped = make(chan struct{})
+		go client.mirrorQueued()
+	}
+	return client
+}
+
+// Authoritative returns the authoritative client, for example to send the commands which are specific to a standalone
+// or to a cluster client.
+func (client *Client) Authoritative() interfaces.BaseClientCommands {
+	return client.authoritative
+}
+
+// Shadow returns the shadow client.
+func (client *Client) Shadow() interfaces.BaseClientCommands {
+	return client.shadow
+}
+
+// mirrorQueued sends the queued commands to the shadow client, until the client is closed.
+func (client *Client) mirrorQueued() {
+	defer close(client.stopped)
+	for send := range client.queue {
+		send()
+	}
+}
+
+// enqueue queues a command for the shadow client, and reports whether it was queued.
+func (client *Client) enqueue(send func()) bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.closed {
+		return false
+	}
+	select {
+	case client.queue <- send:
+		return true
+	default:
+		return false
+	}
+}
+
+// mirror mirrors a write to the shadow client.
+func (client *Client) mirror(ctx context.Context, name string, key string, send func(ctx context.Context) error) {
+	if client.configuration.mode == Synchronous {
+		client.report(name, key, send(ctx))
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	if !client.enqueue(func() { client.report(name, key, send(ctx)) }) {
+		client.report(name, key, ErrQueueFull)
+	}
+}
+
+// sample sends a read to the shadow client and compares its result, after the writes mirrored before it. In asynchronous
+// mode, the read is compared in the background, and is not compared if the queue is full.
+func (client *Client) sample(ctx context.Context, compare func(ctx context.Context)) {
+	if client.configuration.mode == Synchronous {
+		compare(ctx)
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	client.enqueue(func() { compare(ctx) })
+}
+
+// report calls the error handler if a command failed on the shadow client, other than with an error of the server.
+func (client *Client) report(name string, key string, err error) {
+	if _, isServerError := err.(*glideerrors.RequestError); err == nil || isServerError {
+		return
+	}
+	if client.configuration.errorHandler != nil {
+		client.configuration.errorHandler(&MirrorError{Command: name, Key: key, Err: err})
+	}
+}
+
+// readOnly is the command policy which rejects the commands which may write, and identifies the reads.
+var readOnly = config.NewCommandPolicy().WithReadOnly(true)
+
+// uncompared are the reads whose result may differ between identical deployments, because they are random, depend on
+// the time, or on the internal state of the server.
+var uncompared = map[string]bool{
+	"DUMP": true, "EXPIRETIME": true, "HRANDFIELD": true, "OBJECT ENCODING": true, "OBJECT FREQ": true,
+	"OBJECT IDLETIME": true, "OBJECT REFCOUNT": true, "PEXPIRETIME": true, "PTTL": true, "SRANDMEMBER": true, "TTL": true,
+	"XINFO CONSUMERS": true, "XINFO GROUPS": true, "XINFO STREAM": true, "ZRANDMEMBER": true,
+}
+
+// sampled reports whether a read is compared.
+func (client *Client) sampled() bool {
+	return client.configuration.sampleRate > 0 && rand.Float64() < client.configuration.sampleRate
+}
+
+// execute sends a command to the authoritative client, and mirrors it to the shadow client if it is a write which
+// succeeded, or compares its result with the shadow client if it is a sampled read of a key.
+func execute[T any](
+	client *Client,
+	ctx context.Context,
+	name string,
+	key string,
+	send func(ctx context.Context, target backend) (T, error),
+) (T, error) {
+	value, err := send(ctx, client.authoritative)
+	if readOnly.Check(&config.CommandRequest{Name: name}) != nil {
+		if err == nil {
+			client.mirror(ctx, name, key, func(ctx context.Context) error {
+				_, err := send(ctx, client.shadow)
+				return err
+			})
+		}
+		return value, err
+	}
+	if key == "" || uncompared[name] || !client.sampled() {
+		return value, err
+	}
+	authoritative := value
+	if client.configuration.mode == Asynchronous {
+		// the caller may modify the value while it is compared
+		if copied, ok := snapshot(reflect.ValueOf(&value).Elem()).Interface().(T); ok {
+			authoritative = copied
+		}
+	}
+	client.sample(ctx, func(ctx context.Context) {
+		shadowValue, shadowErr := send(ctx, client.shadow)
+		client.compare(Divergence{
+			Command:          name,
+			Key:              key,
+			Authoritative:    authoritative,
+			AuthoritativeErr: err,
+			Shadow:           shadowValue,
+			ShadowErr:        shadowErr,
+		})
+	})
+	return value, err
+}
+
+// snapshot returns a deep copy of the slices, maps and pointers of a value. The unexported fields of structs are copied
+// as they are.
+func snapshot(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			copied.Index(i).Set(snapshot(value.Index(i)))
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for entries := value.MapRange(); entries.Next(); {
+			copied.SetMapIndex(entries.Key(), snapshot(entries.Value()))
+		}
+		return copied
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		if value.Kind() == reflect.Pointer {
+			copied.Set(reflect.New(value.Type().Elem()))
+			copied.Elem().Set(snapshot(value.Elem()))
+		} else {
+			copied.Set(snapshot(value.Elem()))
+		}
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := range value.NumField() {
+			if copied.Field(i).CanSet() {
+				copied.Field(i).Set(snapshot(value.Field(i)))
+			}
+		}
+		return copied
+	}
+	return value
+}
+
+// executeBlocking sends a blocking command to the authoritative client only, since the shadow client could block on it
+// for long, or indefinitely, and hold up the writes mirrored after it. The effect of the command, if any, returns the key
+// and the non-blocking command which mirror it, for example an LPOP of the key from which a BLPOP popped an element.
+func executeBlocking[T any](
+	client *Client,
+	ctx context.Context,
+	name string,
+	send func(ctx context.Context, target backend) (T, error),
+	effect func(value T) (string, func(ctx context.Context, target backend) error),
+) (T, error) {
+	value, err := send(ctx, client.authoritative)
+	if err != nil {
+		return value, err
+	}
+	if key, mirror := effect(value); mirror != nil {
+		client.mirror(ctx, name, key, func(ctx context.Context) error {
+			return mirror(ctx, client.shadow)
+		})
+	}
+	return value, nil
+}
+
+// listPopEffect mirrors the elements popped by BLMPOP with LMPOP.
+func listPopEffect(
+	listDirection constants.ListDirection,
+) func(popped map[string][]string) (string, func(ctx context.Context, target backend) error) {
+	return func(popped map[string][]string) (string, func(ctx context.Context, target backend) error) {
+		for key, elements := range popped {
+			return key, func(ctx context.Context, target backend) error {
+				return discard(target.LMPopCount(ctx, []string{key}, listDirection, int64(len(elements))))
+			}
+		}
+		return "", nil
+	}
+}
+
+// sortedSetPopEffect mirrors the member popped by BZPOPMIN or BZPOPMAX with ZREM.
+func sortedSetPopEffect(
+	popped models.Result[models.KeyWithMemberAndScore],
+) (string, func(ctx context.Context, target backend) error) {
+	if popped.IsNil() {
+		return "", nil
+	}
+	return popped.Value().Key, func(ctx context.Context, target backend) error {
+		return discard(target.ZRem(ctx, popped.Value().Key, []string{popped.Value().Member}))
+	}
+}
+
+// sortedSetMultiPopEffect mirrors the members popped by BZMPOP with ZREM.
+func sortedSetMultiPopEffect(
+	popped models.Result[models.KeyWithArrayOfMembersAndScores],
+) (string, func(ctx context.Context, target backend) error) {
+	if popped.IsNil() {
+		return "", nil
+	}
+	members := make([]string, 0, len(popped.Value().MembersAndScores))
+	for _, member := range popped.Value().MembersAndScores {
+		members = append(members, member.Member)
+	}
+	return popped.Value().Key, func(ctx context.Context, target backend) error {
+		return discard(target.ZRem(ctx, popped.Value().Key, members))
+	}
+}
+
+// discard returns the error of a command whose reply is not used.
+func discard[T any](_ T, err error) error {
+	return err
+}
+
+// compare reports a read whose result differs between the clients. The reads which failed with an error other than an
+// error of the server are not compared, and the reads which failed on both clients are not divergent.
+func (client *Client) compare(read Divergence) {
+	if _, isServerError := read.AuthoritativeErr.(*glideerrors.RequestError); read.AuthoritativeErr != nil && !isServerError {
+		return
+	}
+	if _, isServerError := read.ShadowErr.(*glideerrors.RequestError); read.ShadowErr != nil && !isServerError {
+		client.report(read.Command, read.Key, read.ShadowErr)
+		return
+	}
+	switch {
+	case read.AuthoritativeErr != nil && read.ShadowErr != nil:
+		return
+	case read.AuthoritativeErr == nil && read.ShadowErr == nil && reflect.DeepEqual(read.Authoritative, read.Shadow):
+		return
+	}
+	if client.configuration.divergenceHandler != nil {
+		client.configuration.divergenceHandler(read)
+	}
+}
+
+// firstKey returns the first of the keys of a command, or an empty string.
+func firstKey(keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0]
+}
+
+// Close closes the authoritative and the shadow clients, once the pending writes were mirrored.
+func (client *Client) Close() {
+	client.mu.Lock()
+	if client.closed {
+		client.mu.Unlock()
+		return
+	}
+	client.closed = true
+	if client.queue != nil {
+		close(client.queue)
+	}
+	client.mu.Unlock()
+	if client.stopped != nil {
+		<-client.stopped
+	}
+	client.authoritative.Close()
+	client.shadow.Close()
+}
@@ -0,0 +1,329 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/constants"
+	"github.com/itayporezky/valkey-glide/go/v4/glidetest"
+	"github.com/itayporezky/valkey-glide/go/v4/interfaces"
+	glideerrors "github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/itayporezky/valkey-glide/go/v4/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// handlers records the divergences and the errors reported by a client.
+type handlers struct {
+	mu          sync.Mutex
+	divergences []Divergence
+	errors      []*MirrorError
+}
+
+func (handlers *handlers) configure(configuration *Configuration) *Configuration {
+	return configuration.
+		WithDivergenceHandler(func(divergence Divergence) {
+			handlers.mu.Lock()
+			defer handlers.mu.Unlock()
+			handlers.divergences = append(handlers.divergences, divergence)
+		}).
+		WithErrorHandler(func(err *MirrorError) {
+			handlers.mu.Lock()
+			defer handlers.mu.Unlock()
+			handlers.errors = append(handlers.errors, err)
+		})
+}
+
+// drain waits until the commands queued before it were sent to the shadow client.
+func drain(client *Client) {
+	if client.queue == nil {
+		return
+	}
+	done := make(chan struct{})
+	if client.enqueue(func() { close(done) }) {
+		<-done
+	}
+}
+
+// blocking is a shadow client whose SET and GET block until they are released.
+type blocking struct {
+	interfaces.BaseClientCommands
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (client *blocking) Set(ctx context.Context, key string, value string) (string, error) {
+	client.entered <- struct{}{}
+	<-client.release
+	return client.BaseClientCommands.Set(ctx, key, value)
+}
+
+func (client *blocking) Get(ctx context.Context, key string) (models.Result[string], error) {
+	client.entered <- struct{}{}
+	<-client.release
+	return client.BaseClientCommands.Get(ctx, key)
+}
+
+// stalling is a shadow client whose blocking commands never return.
+type stalling struct {
+	interfaces.BaseClientCommands
+}
+
+func (client *stalling) BLPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	select {}
+}
+
+func (client *stalling) BZPopMin(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	select {}
+}
+
+func (client *stalling) BLMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+	timeoutSecs float64,
+) (models.Result[string], error) {
+	select {}
+}
+
+func TestMirrorsWrites(t *testing.T) {
+	for _, mode := range []Mode{Synchronous, Asynchronous} {
+		t.Run(mode.String(), func(t *testing.T) {
+			ctx := context.Background()
+			authoritative, shadow := glidetest.NewServer(), glidetest.NewServer()
+			client := NewClient(authoritative.NewClient(), shadow.NewClusterClient(), NewConfiguration().WithMode(mode))
+
+			_, err := client.Set(ctx, "key", "value")
+			require.NoError(t, err)
+			for range 100 {
+				_, err = client.Incr(ctx, "counter")
+				require.NoError(t, err)
+			}
+			_, err = client.HSet(ctx, "hash", map[string]string{"field": "value"})
+			require.NoError(t, err)
+			// writes which failed on the authoritative client are not mirrored
+			_, err = client.LPush(ctx, "key", []string{"element"})
+			require.Error(t, err)
+			client.Close()
+
+			reader := shadow.NewClient()
+			value, err := reader.Get(ctx, "key")
+			require.NoError(t, err)
+			assert.Equal(t, "value", value.Value())
+			value, err = reader.Get(ctx, "counter")
+			require.NoError(t, err)
+			assert.Equal(t, "100", value.Value())
+			fields, err := reader.HGetAll(ctx, "hash")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"field": "value"}, fields)
+		})
+	}
+}
+
+func TestMirrorsBlockingCommands(t *testing.T) {
+	for _, mode := range []Mode{Synchronous, Asynchronous} {
+		t.Run(mode.String(), func(t *testing.T) {
+			ctx := context.Background()
+			authoritative, shadow := glidetest.NewServer(), glidetest.NewServer()
+			client := NewClient(authoritative.NewClient(), &stalling{shadow.NewClient()}, NewConfiguration().WithMode(mode))
+
+			_, err := client.RPush(ctx, "list", []string{"a", "b", "c"})
+			require.NoError(t, err)
+			_, err = client.ZAdd(ctx, "zset", map[string]float64{"one": 1, "two": 2})
+			require.NoError(t, err)
+
+			// the effects of the blocking commands are mirrored without blocking
+			popped, err := client.BLPop(ctx, []string{"empty", "list"}, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"list", "a"}, popped)
+			member, err := client.BZPopMin(ctx, []string{"zset"}, 0)
+			require.NoError(t, err)
+			assert.Equal(t, "one", member.Value().Member)
+			moved, err := client.BLMove(ctx, "list", "other", constants.Right, constants.Left, 0)
+			require.NoError(t, err)
+			assert.Equal(t, "c", moved.Value())
+			popped, err = client.BLPop(ctx, []string{"empty"}, 0)
+			require.NoError(t, err)
+			assert.Nil(t, popped)
+			client.Close()
+
+			reader := shadow.NewClient()
+			elements, err := reader.LRange(ctx, "list", 0, -1)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"b"}, elements)
+			elements, err = reader.LRange(ctx, "other", 0, -1)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"c"}, elements)
+			members, err := reader.ZRange(ctx, "zset", options.NewRangeByIndexQuery(0, -1))
+			require.NoError(t, err)
+			assert.Equal(t, []string{"two"}, members)
+		})
+	}
+}
+
+func TestReadsFromAuthoritative(t *testing.T) {
+	ctx := context.Background()
+	authoritative, shadow := glidetest.NewServer(), glidetest.NewServer()
+	client := NewClient(authoritative.NewClient(), shadow.NewClient(), NewConfiguration().WithSampleRate(0))
+	defer client.Close()
+	_, err := authoritative.NewClient().Set(ctx, "key", "authoritative")
+	require.NoError(t, err)
+	_, err = shadow.NewClient().Set(ctx, "key", "shadow")
+	require.NoError(t, err)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "authoritative", value.Value())
+}
+
+func TestReportsDivergences(t *testing.T) {
+	for _, mode := range []Mode{Synchronous, Asynchronous} {
+		t.Run(mode.String(), func(t *testing.T) {
+			ctx := context.Background()
+			authoritative, shadow := glidetest.NewServer(), glidetest.NewServer()
+			reported := &handlers{}
+			client := NewClient(authoritative.NewClient(), shadow.NewClient(),
+				reported.configure(NewConfiguration().WithMode(mode).WithSampleRate(1)))
+			defer client.Close()
+
+			// the sampled reads observe the writes mirrored before them
+			_, err := client.Set(ctx, "same", "value")
+			require.NoError(t, err)
+			value, err := client.Get(ctx, "same")
+			require.NoError(t, err)
+			assert.Equal(t, "value", value.Value())
+			_, err = client.Get(ctx, "missing")
+			require.NoError(t, err)
+			drain(client)
+			assert.Empty(t, reported.divergences)
+
+			_, err = authoritative.NewClient().Set(ctx, "key", "authoritative")
+			require.NoError(t, err)
+			_, err = shadow.NewClient().SAdd(ctx, "key", []string{"member"})
+			require.NoError(t, err)
+			value, err = client.Get(ctx, "key")
+			require.NoError(t, err)
+			assert.Equal(t, "authoritative", value.Value())
+			// the reads whose result depends on the time are not compared
+			_, err = client.TTL(ctx, "key")
+			require.NoError(t, err)
+
+			drain(client)
+			require.Len(t, reported.divergences, 1)
+			divergence := reported.divergences[0]
+			assert.Equal(t, "GET", divergence.Command)
+			assert.Equal(t, "key", divergence.Key)
+			assert.Equal(t, value, divergence.Authoritative)
+			assert.NoError(t, divergence.AuthoritativeErr)
+			assert.IsType(t, &glideerrors.RequestError{}, divergence.ShadowErr)
+			assert.Empty(t, reported.errors)
+		})
+	}
+}
+
+func TestComparesInBackground(t *testing.T) {
+	ctx := context.Background()
+	authoritative := glidetest.NewClient()
+	shadow := &blocking{
+		BaseClientCommands: glidetest.NewClient(),
+		entered:            make(chan struct{}, 1),
+		release:            make(chan struct{}),
+	}
+	reported := &handlers{}
+	client := NewClient(authoritative, shadow, reported.configure(NewConfiguration().WithSampleRate(1)))
+	_, err := authoritative.Set(ctx, "key", "value")
+	require.NoError(t, err)
+
+	// the sampled read returns the authoritative value while the shadow client stalls
+	start := time.Now()
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+	<-shadow.entered
+	reported.mu.Lock()
+	assert.Empty(t, reported.divergences)
+	reported.mu.Unlock()
+
+	close(shadow.release)
+	client.Close()
+	require.Len(t, reported.divergences, 1)
+	assert.Equal(t, "GET", reported.divergences[0].Command)
+	assert.Equal(t, value, reported.divergences[0].Authoritative)
+	assert.True(t, reported.divergences[0].Shadow.(models.Result[string]).IsNil())
+}
+
+func TestSnapshot(t *testing.T) {
+	value := map[string][]string{"key": {"a", "b"}}
+	copied := snapshot(reflect.ValueOf(value)).Interface().(map[string][]string)
+	value["key"][0] = "modified"
+	value["other"] = nil
+	assert.Equal(t, map[string][]string{"key": {"a", "b"}}, copied)
+
+	result := models.CreateStringResult("value")
+	assert.Equal(t, result, snapshot(reflect.ValueOf(result)).Interface())
+	var empty any
+	assert.True(t, snapshot(reflect.ValueOf(&empty).Elem()).IsNil())
+}
+
+func TestReportsErrors(t *testing.T) {
+	ctx := context.Background()
+	shadow := glidetest.NewClient()
+	shadow.Close()
+	reported := &handlers{}
+	client := NewClient(glidetest.NewClient(), shadow,
+		reported.configure(NewConfiguration().WithMode(Synchronous).WithSampleRate(1)))
+	defer client.Close()
+
+	_, err := client.Set(ctx, "key", "value")
+	require.NoError(t, err)
+	_, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+
+	require.Len(t, reported.errors, 2)
+	assert.Equal(t, "SET", reported.errors[0].Command)
+	assert.Equal(t, "key", reported.errors[0].Key)
+	assert.IsType(t, &glideerrors.ClosingError{}, reported.errors[0].Err)
+	assert.Equal(t, "GET", reported.errors[1].Command)
+	assert.Empty(t, reported.divergences)
+}
+
+func TestQueueFull(t *testing.T) {
+	ctx := context.Background()
+	shadow := &blocking{
+		BaseClientCommands: glidetest.NewClient(),
+		entered:            make(chan struct{}, 2),
+		release:            make(chan struct{}),
+	}
+	reported := &handlers{}
+	client := NewClient(glidetest.NewClient(), shadow, reported.configure(NewConfiguration().WithQueueSize(1)))
+
+	_, err := client.Set(ctx, "first", "value")
+	require.NoError(t, err)
+	<-shadow.entered
+	_, err = client.Set(ctx, "second", "value")
+	require.NoError(t, err)
+	_, err = client.Set(ctx, "third", "value")
+	require.NoError(t, err)
+
+	reported.mu.Lock()
+	require.Len(t, reported.errors, 1)
+	assert.Equal(t, "third", reported.errors[0].Key)
+	assert.ErrorIs(t, reported.errors[0], ErrQueueFull)
+	reported.mu.Unlock()
+
+	close(shadow.release)
+	client.Close()
+}
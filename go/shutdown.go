@@ -0,0 +1,125 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+)
+
+// CloseWithContext closes the client gracefully. Unlike [Client.Close], which fails the requests in progress with a
+// closing error, it waits for them to complete.
+//
+// The client is unsubscribed from its pub/sub channels and patterns, and new requests are rejected with a closing error.
+// The requests and batches in progress, including those sent over the dedicated connections of blocking commands, are
+// then awaited until they complete or the context is done, after which the client is closed.
+//
+// Parameters:
+//
+//	ctx - The context bounding the wait for the requests in progress, typically with a deadline.
+//
+// Return value:
+//
+// The number of requests which were still in progress when the context was done, and which fail with a closing error.
+// These requests may or may not have been applied by the server. When some requests were abandoned, the error of the
+// context is returned as well.
+func (client *Client) CloseWithContext(ctx context.Context) (int, error) {
+	return client.shutdown(ctx, func() {
+		client.unsubscribe(ctx, C.Unsubscribe, nil)
+		client.unsubscribe(ctx, C.PUnsubscribe, nil)
+	})
+}
+
+// CloseWithContext closes the client gracefully. Unlike [ClusterClient.Close], which fails the requests in progress with
+// a closing error, it waits for them to complete.
+//
+// The client is unsubscribed from its pub/sub channels, patterns and shard channels, and new requests are rejected with a
+// closing error. The requests and batches in progress, including those sent over the dedicated connections of blocking
+// commands, are then awaited until they complete or the context is done, after which the client is closed.
+//
+// Parameters:
+//
+//	ctx - The context bounding the wait for the requests in progress, typically with a deadline.
+//
+// Return value:
+//
+// The number of requests which were still in progress when the context was done, and which fail with a closing error.
+// These requests may or may not have been applied by the server. When some requests were abandoned, the error of the
+// context is returned as well.
+func (client *ClusterClient) CloseWithContext(ctx context.Context) (int, error) {
+	return client.shutdown(ctx, func() {
+		client.unsubscribe(ctx, C.Unsubscribe, config.AllNodes)
+		client.unsubscribe(ctx, C.PUnsubscribe, config.AllNodes)
+		client.unsubscribe(ctx, C.SUnsubscribe, config.AllPrimaries)
+	})
+}
+
+// unsubscribe sends an unsubscription command without arguments, which unsubscribes the client from all the channels of
+// its kind. Failures are ignored, since the subscriptions end anyway when the client is closed.
+func (client *baseClient) unsubscribe(ctx context.Context, requestType C.RequestType, route config.Route) {
+	if response, err := client.executeCommandWithRoute(ctx, requestType, []string{}, route); err == nil {
+		freeCommandResponse(response)
+	}
+}
+
+// shutdown unsubscribes a client with subscriptions, waits for the requests in progress of its connections, and closes
+// them. It returns the number of requests which were abandoned when the context was done.
+func (client *baseClient) shutdown(ctx context.Context, unsubscribe func()) (int, error) {
+	if client.getMessageHandler() != nil {
+		unsubscribe()
+	}
+	if client.sentinel != nil {
+		client.sentinel.close()
+	}
+
+	connections := []*clientConnection{client.clientConnection}
+	if client.blocking != nil {
+		connections = append(connections, client.blocking.stop()...)
+	}
+	drained := make([]<-chan struct{}, 0, len(connections))
+	for _, connection := range connections {
+		drained = append(drained, connection.beginClosing())
+	}
+	for _, done := range drained {
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+	}
+
+	abandoned := 0
+	for _, connection := range connections {
+		abandoned += connection.pendingRequests()
+		connection.Close()
+	}
+	if abandoned > 0 {
+		return abandoned, ctx.Err()
+	}
+	return 0, nil
+}
+
+// beginClosing rejects the new requests of the connection, and returns a channel which is closed once the requests in
+// progress completed.
+func (connection *clientConnection) beginClosing() <-chan struct{} {
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	connection.closing = true
+	if connection.drained == nil {
+		connection.drained = make(chan struct{})
+	}
+	if len(connection.pending) == 0 {
+		connection.signalDrained()
+	}
+	return connection.drained
+}
+
+// pendingRequests returns the number of requests in progress.
+func (connection *clientConnection) pendingRequests() int {
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	return len(connection.pending)
+}
@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown_WaitsForPendingRequests(t *testing.T) {
+	connection := &clientConnection{pending: make(map[unsafe.Pointer]struct{})}
+	request := make(chan payload, 1)
+	connection.pending[unsafe.Pointer(&request)] = struct{}{}
+
+	drained := connection.beginClosing()
+	select {
+	case <-drained:
+		t.Fatal("drained while a request is pending")
+	default:
+	}
+	connection.removePending(unsafe.Pointer(&request))
+	<-drained
+
+	assert.Zero(t, connection.pendingRequests())
+}
+
+func TestShutdown_AbandonsRequestsAfterDeadline(t *testing.T) {
+	client := &baseClient{clientConnection: &clientConnection{pending: make(map[unsafe.Pointer]struct{})}}
+	request := make(chan payload, 1)
+	client.pending[unsafe.Pointer(&request)] = struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	abandoned, err := client.shutdown(ctx, func() { t.Fatal("unsubscribed a client without subscriptions") })
+
+	assert.Equal(t, 1, abandoned)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, client.closing)
+}
+
+func TestShutdown_WithoutPendingRequests(t *testing.T) {
+	client := &baseClient{clientConnection: &clientConnection{pending: make(map[unsafe.Pointer]struct{})}}
+
+	abandoned, err := client.shutdown(context.Background(), func() {})
+
+	assert.Zero(t, abandoned)
+	assert.NoError(t, err)
+}
+
+func TestShutdown_UnsubscribesThroughMiddlewares(t *testing.T) {
+	unsubscriptions := 0
+	middleware := &config.Middleware{
+		// the responses of the unsubscriptions are allocated on the Go side, and must not be released by the core
+		BeforeCommand: func(ctx context.Context, request *config.CommandRequest) *config.CommandResult {
+			return &config.CommandResult{Value: []any{"unsubscribe", nil, int64(0)}}
+		},
+		AfterCommand: func(ctx context.Context, request *config.CommandRequest, result *config.CommandResult) {
+			unsubscriptions++
+		},
+	}
+	client := &Client{&baseClient{
+		clientConnection: &clientConnection{
+			pending:        make(map[unsafe.Pointer]struct{}),
+			messageHandler: NewMessageHandler(nil, nil),
+		},
+		middlewares: []*config.Middleware{middleware},
+	}}
+
+	abandoned, err := client.CloseWithContext(context.Background())
+
+	assert.Zero(t, abandoned)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, unsubscriptions)
+	assert.Zero(t, liveGoResponses.Load())
+}
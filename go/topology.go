@@ -0,0 +1,164 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+)
+
+// slotCount is the number of hash slots of a cluster.
+const slotCount = 16384
+
+// crc16Table is the lookup table of CRC16-CCITT (XMODEM), the checksum used to map the keys to hash slots.
+var crc16Table = func() (table [256]uint16) {
+	for i := range table {
+		crc := uint16(i) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}()
+
+// KeySlot returns the hash slot of a key in a cluster, as computed by the servers and by the client when routing the
+// requests. When the key contains a hash tag, a non-empty substring between the first "{" and the following "}", only the
+// hash tag is hashed, so that keys sharing a hash tag map to the same slot.
+//
+// KeySlot is computed locally, and may be used to group the keys by slot, for example before building a
+// pipeline.ClusterBatch whose commands are required to map to a single slot.
+//
+// Parameters:
+//
+//	key - The key.
+//
+// Return value:
+//
+// The hash slot of the key, between 0 and 16383.
+func KeySlot(key string) int32 {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	var crc uint16
+	for i := 0; i < len(key); i++ {
+		crc = crc<<8 ^ crc16Table[byte(crc>>8)^key[i]]
+	}
+	return int32(crc % slotCount)
+}
+
+// Topology returns a snapshot of the slot map of the cluster, as currently known by the client: its shards, with the
+// addresses of their primary and replicas and the slot ranges they serve. The slot map is read from the state of the client,
+// and no command is sent to the servers. It is updated by the client when the topology of the cluster changes, see
+// [models.TopologyRefreshed].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+// The topology of the cluster.
+func (client *ClusterClient) Topology(ctx context.Context) (models.ClusterTopology, error) {
+	response, err := client.submitOperation(ctx, "Topology", func(channel C.uintptr_t) {
+		C.get_topology(client.coreClient, channel)
+	})
+	if err != nil {
+		return models.ClusterTopology{}, err
+	}
+	return handleTopologyResponse(response)
+}
+
+// NodeForKey returns the address of the primary serving a key, according to the slot map of the cluster as currently
+// known by the client. See [ClusterClient.Topology].
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key.
+//
+// Return value:
+//
+// The address of the primary serving the slot of the key, in the "host:port" format.
+func (client *ClusterClient) NodeForKey(ctx context.Context, key string) (string, error) {
+	topology, err := client.Topology(ctx)
+	if err != nil {
+		return "", err
+	}
+	slot := KeySlot(key)
+	shard, ok := topology.ShardForSlot(slot)
+	if !ok {
+		return "", &errors.RequestError{Msg: fmt.Sprintf("The slot %d of the key is not served by any known node", slot)}
+	}
+	return shard.Primary, nil
+}
+
+func handleTopologyResponse(response *C.struct_CommandResponse) (models.ClusterTopology, error) {
+	defer freeCommandResponse(response)
+
+	typeErr := checkResponseType(response, C.Array, false)
+	if typeErr != nil {
+		return models.ClusterTopology{}, typeErr
+	}
+	ranges, err := parseArray(response)
+	if err != nil {
+		return models.ClusterTopology{}, err
+	}
+	return topologyFromSlotRanges(ranges.([]any))
+}
+
+// topologyFromSlotRanges groups the slot ranges of the core, in the format of CLUSTER SLOTS, by shard.
+func topologyFromSlotRanges(ranges []any) (models.ClusterTopology, error) {
+	unexpected := &errors.RequestError{Msg: "Unexpected topology format"}
+	topology := models.ClusterTopology{}
+	shards := make(map[string]int)
+	for _, item := range ranges {
+		slotRange, ok := item.([]any)
+		if !ok || len(slotRange) != 4 {
+			return models.ClusterTopology{}, unexpected
+		}
+		start, startOk := slotRange[0].(int64)
+		end, endOk := slotRange[1].(int64)
+		primary, primaryOk := slotRange[2].(string)
+		replicas, replicasOk := slotRange[3].([]any)
+		if !startOk || !endOk || !primaryOk || !replicasOk {
+			return models.ClusterTopology{}, unexpected
+		}
+
+		index, found := shards[primary]
+		if !found {
+			shard := models.ClusterShard{Primary: primary, Replicas: []string{}}
+			for _, replica := range replicas {
+				address, ok := replica.(string)
+				if !ok {
+					return models.ClusterTopology{}, unexpected
+				}
+				shard.Replicas = append(shard.Replicas, address)
+			}
+			index = len(topology.Shards)
+			shards[primary] = index
+			topology.Shards = append(topology.Shards, shard)
+		}
+		shard := &topology.Shards[index]
+		// the slots moved one by one are kept as separate ranges by the core
+		if last := len(shard.SlotRanges) - 1; last >= 0 && shard.SlotRanges[last].End+1 == int32(start) {
+			shard.SlotRanges[last].End = int32(end)
+		} else {
+			shard.SlotRanges = append(shard.SlotRanges, models.SlotRange{Start: int32(start), End: int32(end)})
+		}
+	}
+	return topology, nil
+}
@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/itayporezky/valkey-glide/go/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySlot(t *testing.T) {
+	assert.Equal(t, int32(12739), KeySlot("123456789"))
+	assert.Equal(t, int32(12182), KeySlot("foo"))
+	assert.Equal(t, int32(0), KeySlot(""))
+
+	// only the hash tag is hashed
+	assert.Equal(t, KeySlot("user1000"), KeySlot("{user1000}.following"))
+	assert.Equal(t, KeySlot("{user1000}.following"), KeySlot("{user1000}.followers"))
+	assert.Equal(t, KeySlot("bar"), KeySlot("foo{bar}{zap}"))
+	assert.Equal(t, KeySlot("{bar"), KeySlot("foo{{bar}}zap"))
+	// an empty or unterminated hash tag is not a hash tag
+	assert.NotEqual(t, KeySlot("foo{}{bar}"), KeySlot("bar"))
+	assert.NotEqual(t, KeySlot("foo{bar"), KeySlot("bar"))
+}
+
+func TestTopologyFromSlotRanges(t *testing.T) {
+	topology, err := topologyFromSlotRanges([]any{
+		[]any{int64(0), int64(99), "node1:6379", []any{"replica1:6379"}},
+		[]any{int64(100), int64(100), "node2:6379", []any{}},
+		[]any{int64(101), int64(8000), "node1:6379", []any{"replica1:6379"}},
+		[]any{int64(8001), int64(16383), "node2:6379", []any{}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.ClusterTopology{Shards: []models.ClusterShard{
+		{
+			Primary:    "node1:6379",
+			Replicas:   []string{"replica1:6379"},
+			SlotRanges: []models.SlotRange{{Start: 0, End: 99}, {Start: 101, End: 8000}},
+		},
+		{
+			Primary:    "node2:6379",
+			Replicas:   []string{},
+			SlotRanges: []models.SlotRange{{Start: 100, End: 100}, {Start: 8001, End: 16383}},
+		},
+	}}, topology)
+
+	shard, ok := topology.ShardForSlot(100)
+	assert.True(t, ok)
+	assert.Equal(t, "node2:6379", shard.Primary)
+	shard, ok = topology.ShardForSlot(5000)
+	assert.True(t, ok)
+	assert.Equal(t, "node1:6379", shard.Primary)
+
+	// the slots moved one by one are merged into a range
+	topology, err = topologyFromSlotRanges([]any{
+		[]any{int64(0), int64(10), "node1:6379", []any{}},
+		[]any{int64(11), int64(11), "node1:6379", []any{}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []models.SlotRange{{Start: 0, End: 11}}, topology.Shards[0].SlotRanges)
+	_, ok = topology.ShardForSlot(12)
+	assert.False(t, ok)
+
+	_, err = topologyFromSlotRanges([]any{[]any{int64(0), int64(10), "node1:6379"}})
+	assert.Error(t, err)
+}
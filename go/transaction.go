@@ -0,0 +1,230 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/internal/errors"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+)
+
+// StandaloneTransaction is an attempt of an optimistic transaction of a [Client]. Its client sends commands over the
+// dedicated connection on which the keys of the transaction are watched, and its batch queues the commands which are
+// executed atomically once the transaction function returns.
+type StandaloneTransaction struct {
+	*Client
+	Batch *pipeline.StandaloneBatch
+}
+
+// ClusterTransaction is an attempt of an optimistic transaction of a [ClusterClient]. Its client sends commands over the
+// dedicated connection on which the keys of the transaction are watched, and its batch queues the commands which are
+// executed atomically once the transaction function returns.
+type ClusterTransaction struct {
+	*ClusterClient
+	Batch *pipeline.ClusterBatch
+}
+
+// Transaction runs an optimistic transaction, with the default [pipeline.TransactionOptions].
+//
+// See [Client.TransactionWithOptions] for details.
+func (client *Client) Transaction(
+	ctx context.Context,
+	keys []string,
+	fn func(tx *StandaloneTransaction) error,
+) ([]any, error) {
+	return client.TransactionWithOptions(ctx, keys, fn, *pipeline.NewTransactionOptions())
+}
+
+// TransactionWithOptions runs an optimistic transaction over a dedicated connection, so that the commands of other
+// goroutines do not affect the keys watched by the transaction.
+//
+// Every attempt watches the keys, and calls fn, which reads the keys with the client of the transaction and queues writes
+// into its batch. The batch is then executed atomically, unless fn returns an error, which is returned as it is. When a
+// watched key is modified before the batch is executed, the execution is aborted and the transaction is attempted again,
+// after a backoff delay, until it succeeds or the maximum number of attempts is reached. fn must therefore not have side
+// effects other than the commands of the transaction.
+//
+// The client of the transaction sends its reads to the primaries, regardless of the read strategy of the client, since
+// replicas may return values older than the ones the execution is checked against. It must not be used after fn returns,
+// nor be closed. The dedicated connection is taken from the pool of blocking connections of the client, see
+// [Client.Blocking]. When all the connections are in use, the transaction fails with a timeout error if none is released
+// within the request timeout of the client.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the transaction.
+//	keys - The keys to watch.
+//	fn - The function reading the keys and queuing the commands of the transaction.
+//	options - The options of the transaction.
+//
+// Return value:
+//
+// The results of the commands of the batch, as returned by [Client.Exec], or a [pipeline.TransactionAbortedError] if
+// every attempt of the transaction was aborted.
+func (client *Client) TransactionWithOptions(
+	ctx context.Context,
+	keys []string,
+	fn func(tx *StandaloneTransaction) error,
+	options pipeline.TransactionOptions,
+) ([]any, error) {
+	return client.runTransaction(ctx, keys, options, func(view *baseClient) (pipeline.Batch, error) {
+		tx := &StandaloneTransaction{&Client{view}, pipeline.NewStandaloneBatch(true)}
+		err := fn(tx)
+		return tx.Batch.Batch, err
+	})
+}
+
+// Transaction runs an optimistic transaction, with the default [pipeline.TransactionOptions].
+//
+// See [ClusterClient.TransactionWithOptions] for details.
+func (client *ClusterClient) Transaction(
+	ctx context.Context,
+	keys []string,
+	fn func(tx *ClusterTransaction) error,
+) ([]any, error) {
+	return client.TransactionWithOptions(ctx, keys, fn, *pipeline.NewTransactionOptions())
+}
+
+// TransactionWithOptions runs an optimistic transaction over a dedicated connection, so that the commands of other
+// goroutines do not affect the keys watched by the transaction.
+//
+// Every attempt watches the keys, and calls fn, which reads the keys with the client of the transaction and queues writes
+// into its batch. The batch is then executed atomically, unless fn returns an error, which is returned as it is. When a
+// watched key is modified before the batch is executed, the execution is aborted and the transaction is attempted again,
+// after a backoff delay, until it succeeds or the maximum number of attempts is reached. fn must therefore not have side
+// effects other than the commands of the transaction.
+//
+// The watched keys and the keys of the batch must map to the same hash slot, since the batch is routed to the slot owner
+// of its first key.
+//
+// The client of the transaction sends its reads to the primaries, regardless of the read strategy of the client, since
+// replicas may return values older than the ones the execution is checked against. It must not be used after fn returns,
+// nor be closed. The dedicated connection is taken from the pool of blocking connections of the client, see
+// [ClusterClient.Blocking]. When all the connections are in use, the transaction fails with a timeout error if none is
+// released within the request timeout of the client.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the transaction.
+//	keys - The keys to watch.
+//	fn - The function reading the keys and queuing the commands of the transaction.
+//	options - The options of the transaction.
+//
+// Return value:
+//
+// The results of the commands of the batch, as returned by [ClusterClient.Exec], or a [pipeline.TransactionAbortedError]
+// if every attempt of the transaction was aborted.
+func (client *ClusterClient) TransactionWithOptions(
+	ctx context.Context,
+	keys []string,
+	fn func(tx *ClusterTransaction) error,
+	options pipeline.TransactionOptions,
+) ([]any, error) {
+	return client.runTransaction(ctx, keys, options, func(view *baseClient) (pipeline.Batch, error) {
+		tx := &ClusterTransaction{&ClusterClient{view}, pipeline.NewClusterBatch(true)}
+		err := fn(tx)
+		return tx.Batch.Batch, err
+	})
+}
+
+// runTransaction attempts a transaction until its batch is executed, or the maximum number of attempts is reached.
+func (client *baseClient) runTransaction(
+	ctx context.Context,
+	keys []string,
+	options pipeline.TransactionOptions,
+	attempt func(view *baseClient) (pipeline.Batch, error),
+) ([]any, error) {
+	if client.blocking == nil {
+		return nil, &errors.RequestError{Msg: "Transactions can not be nested"}
+	}
+	for attempts := 1; ; attempts++ {
+		values, executed, err := client.attemptTransaction(ctx, keys, options, attempt)
+		if err != nil || executed {
+			return values, err
+		}
+		if attempts >= options.MaxAttempts {
+			return nil, &pipeline.TransactionAbortedError{Attempts: attempts}
+		}
+
+		timer := time.NewTimer(transactionBackoff(options, attempts))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// attemptTransaction watches the keys over a dedicated connection and executes the batch of the attempt, returning whether
+// the execution was not aborted.
+func (client *baseClient) attemptTransaction(
+	ctx context.Context,
+	keys []string,
+	options pipeline.TransactionOptions,
+	attempt func(view *baseClient) (pipeline.Batch, error),
+) (values []any, executed bool, err error) {
+	// unlike the blocking commands, a transaction fails when no connection is released within the request timeout
+	connection, err := client.blocking.acquire(ctx, client.blocking.requestTimeout)
+	if err != nil {
+		return nil, false, err
+	}
+	reuse := false
+	defer func() { client.blocking.release(connection, reuse) }()
+
+	view := *client
+	view.clientConnection = connection
+	view.dedicated = false
+
+	if len(keys) > 0 {
+		if _, err := view.Watch(ctx, keys); err != nil {
+			reuse = reusable(err)
+			return nil, false, err
+		}
+	}
+	batch, err := attempt(&view)
+	if err != nil || len(batch.Commands) == 0 {
+		// the connection is reused by other transactions, which must not be affected by the watched keys
+		if _, unwatchErr := view.executeCommand(ctx, C.UnWatch, []string{}); unwatchErr != nil {
+			reuse = reusable(unwatchErr)
+			return nil, false, unwatchErr
+		}
+		reuse = true
+		if err != nil {
+			return nil, false, err
+		}
+		return []any{}, true, nil
+	}
+
+	timeout := uint32(connection.requestTimeout.Milliseconds())
+	values, err = view.executeBatch(ctx, batch, options.RaiseOnError, &pipeline.BatchOptions{Timeout: &timeout})
+	// the keys may still be watched if the batch was not executed
+	reuse = err == nil
+	return values, values != nil, err
+}
+
+// transactionContext returns the context of a command sent by the client of a transaction, which reads from the primaries
+// where the keys of the transaction are watched.
+func transactionContext(ctx context.Context) context.Context {
+	return WithRequestOptions(ctx, WithReadFrom(config.Primary))
+}
+
+// transactionBackoff returns the delay after the given number of aborted attempts of a transaction.
+func transactionBackoff(options pipeline.TransactionOptions, attempts int) time.Duration {
+	backoff := options.MinBackoff
+	for i := 1; i < attempts && backoff < options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, options.MaxBackoff)
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
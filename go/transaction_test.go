@@ -0,0 +1,43 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/itayporezky/valkey-glide/go/v4/config"
+	"github.com/itayporezky/valkey-glide/go/v4/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionBackoff(t *testing.T) {
+	options := *pipeline.NewTransactionOptions().WithBackoff(100*time.Millisecond, 300*time.Millisecond)
+	for _, expected := range []struct {
+		attempts int
+		backoff  time.Duration
+	}{{1, 100 * time.Millisecond}, {2, 200 * time.Millisecond}, {3, 300 * time.Millisecond}, {10, 300 * time.Millisecond}} {
+		backoff := transactionBackoff(options, expected.attempts)
+		assert.GreaterOrEqual(t, backoff, expected.backoff/2, expected.attempts)
+		assert.LessOrEqual(t, backoff, expected.backoff, expected.attempts)
+	}
+	assert.Zero(t, transactionBackoff(*pipeline.NewTransactionOptions().WithBackoff(0, 0), 3))
+}
+
+func TestTransactionContext(t *testing.T) {
+	// the reads of a transaction are sent to the primaries, even when the context prefers replicas
+	ctx := WithRequestOptions(context.Background(), WithRequestTimeout(time.Second), WithReadFrom(config.PreferReplica))
+	options := getRequestOptions(transactionContext(ctx))
+	assert.Equal(t, time.Second, options.timeout)
+	require.NotNil(t, options.readFrom)
+	assert.Equal(t, config.Primary, *options.readFrom)
+	info := options.commandOptionsInfo()
+	assert.True(t, bool(info.has_read_from))
+	assert.False(t, bool(info.read_from_replica))
+
+	options = getRequestOptions(transactionContext(context.Background()))
+	require.NotNil(t, options.readFrom)
+	assert.Equal(t, config.Primary, *options.readFrom)
+}